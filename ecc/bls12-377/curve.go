@@ -0,0 +1,58 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12377
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+// Curve is a zero-sized handle on this package, satisfying
+// ecc.Curve[G1Affine, G1Jac, G2Affine, G2Jac, GT].
+//
+// It is meant to be used as a type argument (or argument) to generic code
+// written against the constraints of package ecc.
+type Curve struct{}
+
+// ID returns the ecc.ID of the curve
+func (Curve) ID() ecc.ID {
+	return ID
+}
+
+// Generators returns the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func (Curve) Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
+	return Generators()
+}
+
+// Pair calculates the reduced pairing for a set of points
+func (Curve) Pair(P []G1Affine, Q []G2Affine) (GT, error) {
+	return Pair(P, Q)
+}
+
+// PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
+func (Curve) PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
+	return PairingCheck(P, Q)
+}
+
+// MillerLoop computes the multi-Miller loop
+func (Curve) MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
+	return MillerLoop(P, Q)
+}
+
+// FinalExponentiation computes the exponentiation (∏ᵢ zᵢ)ᵈ
+func (Curve) FinalExponentiation(z *GT, _z ...*GT) GT {
+	return FinalExponentiation(z, _z...)
+}
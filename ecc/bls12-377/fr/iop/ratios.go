@@ -19,7 +19,6 @@ package iop
 import (
 	"errors"
 	"math/big"
-	"math/bits"
 	"runtime"
 	"sync"
 
@@ -37,7 +36,7 @@ var (
 	ErrInconsistentFormat         = errors.New("the format of the polynomials must be the same")
 	ErrInconsistentSize           = errors.New("the sizes of the polynomial must be the same as the size of the domain")
 	ErrNumberPolynomials          = errors.New("the number of polynomials in the denominator and the numerator must be the same")
-	ErrSizeNotPowerOfTwo          = errors.New("the size of the polynomials must be a power of two")
+	ErrSizeNotPowerOfTwo          = errors.New("the size of the polynomials must be a power of two, or a supported mixed-radix size")
 	ErrInconsistentSizeDomain     = errors.New("the size of the domain must be consistent with the size of the polynomials")
 	ErrIncorrectNumberOfVariables = errors.New("the number of variables is incorrect")
 )
@@ -92,13 +91,12 @@ func BuildRatioShuffledVectors(numerator, denominator []*Polynomial, beta fr.Ele
 	t[0].SetOne()
 	var a, b, c, d fr.Element
 
-	nn := uint64(64 - bits.TrailingZeros(uint(n)))
 	for i := 0; i < n-1; i++ {
 
 		b.SetOne()
 		d.SetOne()
 
-		iRev := bits.Reverse64(uint64(i)) >> nn
+		iRev := fft.BitReverseIndex(uint64(n), uint64(i))
 
 		for j := 0; j < nbPolynomials; j++ {
 
@@ -184,12 +182,11 @@ func BuildRatioCopyConstraint(
 
 	parallel.Execute(n-1, func(start, end int) {
 		var a, b, c, d fr.Element
-		nn := uint64(64 - bits.TrailingZeros(uint(n)))
 		for i := start; i < end; i++ {
 			b.SetOne()
 			d.SetOne()
 
-			iRev := int(bits.Reverse64(uint64(i)) >> nn)
+			iRev := int(fft.BitReverseIndex(uint64(n), uint64(i)))
 
 			for j, p := range entries {
 				idx := i
@@ -263,7 +260,7 @@ func putInExpectedFormFromLagrangeRegular(p *Polynomial, domain *fft.Domain, exp
 	if expectedForm.Basis == Canonical {
 		domain.FFTInverse(p.Coefficients(), fft.DIF)
 		if expectedForm.Layout == Regular {
-			fft.BitReverse(p.Coefficients())
+			fft.BitReverseInverse(p.Coefficients())
 		}
 		return
 	}
@@ -302,17 +299,18 @@ func checkSize(pols ...[]*Polynomial) error {
 }
 
 // buildDomain builds the fft domain necessary to do FFTs.
-// n is the cardinality of the domain, it must be a power of 2.
+// n is the cardinality of the domain, it must be a power of 2,
+// or of the form 2ᵃ⋅3ᵇ⋅5ᶜ (see fft.NewDomainMixedRadix).
 func buildDomain(n int, domain *fft.Domain) (*fft.Domain, error) {
 
-	// check if the sizes are a power of 2
-	if n&(n-1) != 0 {
+	// check if the sizes are supported
+	if _, err := fft.GeneratorMixedRadix(uint64(n)); err != nil {
 		return nil, ErrSizeNotPowerOfTwo
 	}
 
 	// if the domain doesn't exist we create it.
 	if domain == nil {
-		domain = fft.NewDomain(uint64(n))
+		domain = fft.NewDomainMixedRadix(uint64(n))
 	}
 
 	// in case domain was not nil, it must match the size of the polynomials.
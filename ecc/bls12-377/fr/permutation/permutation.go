@@ -20,7 +20,6 @@ import (
 	"crypto/sha256"
 	"errors"
 	"math/big"
-	"math/bits"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
@@ -31,7 +30,7 @@ import (
 
 var (
 	ErrIncompatibleSize = errors.New("t1 and t2 should be of the same size")
-	ErrSize             = errors.New("t1 and t2 should be of size a power of 2")
+	ErrSize             = errors.New("t1 and t2 should be of size 2ᵃ⋅3ᵇ⋅5ᶜ, the cardinality of a mixed-radix domain")
 	ErrPermutationProof = errors.New("permutation proof verification failed")
 	ErrGenerator        = errors.New("wrong generator")
 )
@@ -68,17 +67,16 @@ func evaluateAccumulationPolynomialBitReversed(lt1, lt2 []fr.Element, epsilon fr
 	d := make([]fr.Element, s)
 	z[0].SetOne()
 	d[0].SetOne()
-	nn := uint64(64 - bits.TrailingZeros64(uint64(s)))
 	var t fr.Element
 	for i := 0; i < s-1; i++ {
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
-		_ii := int(bits.Reverse64(uint64((i+1)%s)) >> nn)
+		_i := int(fft.BitReverseIndex(uint64(s), uint64(i)))
+		_ii := int(fft.BitReverseIndex(uint64(s), uint64((i+1)%s)))
 		z[_ii].Mul(&z[_i], t.Sub(&epsilon, &lt1[i]))
 		d[i+1].Mul(&d[i], t.Sub(&epsilon, &lt2[i]))
 	}
 	d = fr.BatchInvert(d)
 	for i := 0; i < s-1; i++ {
-		_ii := int(bits.Reverse64(uint64((i+1)%s)) >> nn)
+		_ii := int(fft.BitReverseIndex(uint64(s), uint64((i+1)%s)))
 		z[_ii].Mul(&z[_ii], &d[i+1])
 	}
 
@@ -91,10 +89,9 @@ func evaluateFirstPartNumReverse(lt1, lt2, lz []fr.Element, epsilon fr.Element)
 	s := len(lt1)
 	res := make([]fr.Element, s)
 	var a, b fr.Element
-	nn := uint64(64 - bits.TrailingZeros64(uint64(s)))
 	for i := 0; i < s; i++ {
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
-		_ii := int(bits.Reverse64(uint64((i+1)%s)) >> nn)
+		_i := int(fft.BitReverseIndex(uint64(s), uint64(i)))
+		_ii := int(fft.BitReverseIndex(uint64(s), uint64((i+1)%s)))
 		a.Sub(&epsilon, &lt2[_i])
 		a.Mul(&lz[_ii], &a)
 		b.Sub(&epsilon, &lt1[_i])
@@ -120,9 +117,8 @@ func evaluateSecondPartNumReverse(lz []fr.Element, d *fft.Domain) []fr.Element {
 	}
 	u = fr.BatchInvert(u)
 	res := make([]fr.Element, s)
-	nn := uint64(64 - bits.TrailingZeros64(uint64(s)))
 	for i := 0; i < s; i++ {
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
+		_i := int(fft.BitReverseIndex(uint64(s), uint64(i)))
 		res[_i].Sub(&lz[_i], &o).
 			Mul(&res[_i], &u[i]).
 			Mul(&res[_i], &tn)
@@ -131,7 +127,8 @@ func evaluateSecondPartNumReverse(lz []fr.Element, d *fft.Domain) []fr.Element {
 }
 
 // Prove generates a proof that t1 and t2 are the same but permuted.
-// The size of t1 and t2 should be the same and a power of 2.
+// The size of t1 and t2 should be the same, and the cardinality of a mixed-radix domain
+// (see fft.NewDomainMixedRadix), in particular a power of 2 is accepted.
 func Prove(pk kzg.ProvingKey, t1, t2 []fr.Element) (Proof, error) {
 
 	// res
@@ -144,7 +141,7 @@ func Prove(pk kzg.ProvingKey, t1, t2 []fr.Element) (Proof, error) {
 	}
 
 	// create the domains
-	d := fft.NewDomain(uint64(len(t1)))
+	d := fft.NewDomainMixedRadix(uint64(len(t1)))
 	if d.Cardinality != uint64(len(t1)) {
 		return proof, ErrSize
 	}
@@ -165,8 +162,8 @@ func Prove(pk kzg.ProvingKey, t1, t2 []fr.Element) (Proof, error) {
 	copy(ct2, t2)
 	d.FFTInverse(ct1, fft.DIF)
 	d.FFTInverse(ct2, fft.DIF)
-	fft.BitReverse(ct1)
-	fft.BitReverse(ct2)
+	fft.BitReverseInverse(ct1)
+	fft.BitReverseInverse(ct2)
 	proof.t1, err = kzg.Commit(ct1, pk)
 	if err != nil {
 		return proof, err
@@ -343,14 +340,25 @@ func Verify(vk kzg.VerifyingKey, proof Proof) error {
 		return err
 	}
 
-	// check the generator is correct
+	// check the generator is correct: proof.size = 2ᵃ⋅3ᵇ⋅5ᶜ is the order of proof.g
 	var checkOrder fr.Element
-	checkOrder.Exp(proof.g, big.NewInt(int64(proof.size/2)))
-	if checkOrder.Equal(&one) {
+	checkOrder.Exp(proof.g, big.NewInt(int64(proof.size)))
+	if !checkOrder.Equal(&one) {
 		return ErrGenerator
 	}
-	checkOrder.Square(&checkOrder)
-	if !checkOrder.Equal(&one) {
+	s := proof.size
+	for _, q := range []int{2, 3, 5} {
+		if s%q != 0 {
+			continue
+		}
+		for ; s%q == 0; s /= q {
+		}
+		checkOrder.Exp(proof.g, big.NewInt(int64(proof.size/q)))
+		if checkOrder.Equal(&one) {
+			return ErrGenerator
+		}
+	}
+	if s != 1 {
 		return ErrGenerator
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/kzg"
 )
 
@@ -70,6 +71,38 @@ func TestProof(t *testing.T) {
 
 }
 
+func TestProofMixedRadix(t *testing.T) {
+
+	kzgSrs, err := kzg.NewSRS(64, big.NewInt(13))
+	assert.NoError(t, err)
+
+	// 12 if 3 divides r-1, 16 otherwise
+	n := int(fft.NewDomainMixedRadix(12).Cardinality)
+	a := make([]fr.Element, n)
+	b := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		a[i].SetUint64(uint64(4*i + 1))
+	}
+	for i := 0; i < n; i++ {
+		b[i].Set(&a[(5*i)%n])
+	}
+
+	// correct proof
+	proof, err := Prove(kzgSrs.Pk, a, b)
+	assert.NoError(t, err)
+	assert.NoError(t, Verify(kzgSrs.Vk, proof))
+
+	// wrong proof
+	a[0].SetRandom()
+	proof, err = Prove(kzgSrs.Pk, a, b)
+	assert.NoError(t, err)
+	assert.Error(t, Verify(kzgSrs.Vk, proof))
+
+	// a size which is not the cardinality of a domain
+	_, err = Prove(kzgSrs.Pk, a[:7], b[:7])
+	assert.Equal(t, ErrSize, err)
+}
+
 func BenchmarkProver(b *testing.B) {
 
 	srsSize := 1 << 15
@@ -0,0 +1,27 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package reedsolomon provides a Reed-Solomon code over fr, evaluating the polynomials on a
+// multiplicative subgroup with the fft package.
+//
+// A message of k elements is the list of the evaluations, in bit-reversed order, of a polynomial of
+// degree < k on the subgroup of order k, and its codeword the list of the evaluations of the same
+// polynomial, in bit-reversed order, on the subgroup of order n = k⋅blowup. The code is systematic:
+// the first k elements of a codeword are the message.
+//
+// A codeword can be recovered from any k of its elements (RecoverErasures), or from a word with
+// up to (n-k)/2 errors (Decode).
+package reedsolomon
@@ -45,3 +45,31 @@ func TestDomainSerialization(t *testing.T) {
 		t.Fatal("Domain.SetBytes(Bytes()) failed")
 	}
 }
+
+func TestDomainMixedRadixSerialization(t *testing.T) {
+
+	domain := NewDomainMixedRadix(3 << 6)
+	var reconstructed Domain
+
+	var buf bytes.Buffer
+	if _, err := domain.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reconstructed.ReadFrom(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(domain, &reconstructed) {
+		t.Fatal("Domain.SetBytes(Bytes()) failed")
+	}
+}
+
+func TestNewDomainMixedRadix(t *testing.T) {
+	for _, m := range []uint64{1, 3, 7, 100, 1000, 3 << 10} {
+		domain := NewDomainMixedRadix(m)
+		n := domain.Cardinality
+		if n < m || n > NewDomain(m).Cardinality {
+			t.Fatalf("NewDomainMixedRadix(%d) has an unexpected cardinality %d", m, n)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls12-378"
+)
+
+// FFTG1 computes the discrete Fourier transform of the points of a and stores the result in a,
+// with the same conventions as FFT: the multiplications by the twiddle factors become scalar multiplications.
+// It computes the evaluations [p(ωⁱ)] from the commitments [pᵢ] to the coefficients of p, and is used to derive
+// a Lagrange SRS from a canonical one, or to extend commitments to a larger domain.
+//
+// The domain must not be mixed-radix. The transform runs one layer at a time, and the scalar multiplications
+// of a layer are batched in affine coordinates (see curve.BatchScalarMultiplicationAffineG1).
+func (domain *Domain) FFTG1(a []curve.G1Affine, decimation Decimation, opts ...Option) {
+	opt := options(opts...)
+
+	// if coset != 0, scale by coset table
+	if opt.coset {
+		if decimation == DIT {
+			scaleG1(a, domain.CosetTableReversed, nil, opt.nbTasks)
+		} else {
+			scaleG1(a, domain.CosetTable, nil, opt.nbTasks)
+		}
+	}
+
+	fftG1(domain, a, domain.Twiddles, decimation, opt.nbTasks)
+}
+
+// FFTInverseG1 computes the inverse discrete Fourier transform of the points of a and stores the result in a,
+// with the same conventions as FFTInverse (see FFTG1).
+func (domain *Domain) FFTInverseG1(a []curve.G1Affine, decimation Decimation, opts ...Option) {
+	opt := options(opts...)
+
+	fftG1(domain, a, domain.TwiddlesInv, decimation, opt.nbTasks)
+
+	// scale by CardinalityInv, and by the coset table if coset != 0
+	switch {
+	case !opt.coset:
+		scaleG1(a, nil, &domain.CardinalityInv, opt.nbTasks)
+	case decimation == DIT:
+		scaleG1(a, domain.CosetTableInv, &domain.CardinalityInv, opt.nbTasks)
+	default:
+		scaleG1(a, domain.CosetTableInvReversed, &domain.CardinalityInv, opt.nbTasks)
+	}
+}
+
+func fftG1(domain *Domain, a []curve.G1Affine, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	if domain.isMixedRadix() {
+		panic("FFTG1 is not implemented on mixed-radix domains")
+	}
+
+	// the layer with butterflies of width m uses the twiddle factors of stage log₂(n/2m)
+	n := len(a)
+	switch decimation {
+	case DIF:
+		for m := n >> 1; m >= 1; m >>= 1 {
+			stage := bits.TrailingZeros64(uint64(n / (2 * m)))
+			butterfliesG1(a, m, nbTasks)
+			twiddleG1(a, twiddles[stage], m, nbTasks)
+		}
+	case DIT:
+		for m := 1; m < n; m <<= 1 {
+			stage := bits.TrailingZeros64(uint64(n / (2 * m)))
+			twiddleG1(a, twiddles[stage], m, nbTasks)
+			butterfliesG1(a, m, nbTasks)
+		}
+	default:
+		panic("not implemented")
+	}
+}
+
+// butterfliesG1 sets (a[i], a[i+m]) ← (a[i] + a[i+m], a[i] - a[i+m]) in each block of 2m points of a
+func butterfliesG1(a []curve.G1Affine, m int, nbTasks int) {
+	const batchSize = 1024
+	parallel.Execute(len(a)/2, func(start, end int) {
+		p := make([]curve.G1Jac, 2*batchSize)
+		r := make([]curve.G1Affine, 2*batchSize)
+		var neg curve.G1Affine
+		for ; start < end; start += batchSize {
+			size := end - start
+			if size > batchSize {
+				size = batchSize
+			}
+			for j := 0; j < size; j++ {
+				i := (start+j)/m*2*m + (start+j)%m
+				neg.Neg(&a[i+m])
+				p[2*j].FromAffine(&a[i])
+				p[2*j].AddMixed(&a[i+m])
+				p[2*j+1].FromAffine(&a[i])
+				p[2*j+1].AddMixed(&neg)
+			}
+			toAffineG1(r[:2*size], p[:2*size])
+			for j := 0; j < size; j++ {
+				i := (start+j)/m*2*m + (start+j)%m
+				a[i], a[i+m] = r[2*j], r[2*j+1]
+			}
+		}
+	}, nbTasks)
+}
+
+// twiddleG1 multiplies a[i+m] by twiddles[i] in each block of 2m points of a; twiddles[0] = 1 is skipped
+func twiddleG1(a []curve.G1Affine, twiddles []fr.Element, m int, nbTasks int) {
+	if m == 1 {
+		return
+	}
+	parallel.Execute(len(a)/(2*m)*(m-1), func(start, end int) {
+		points := make([]curve.G1Affine, end-start)
+		scalars := make([]fr.Element, end-start)
+		for j := start; j < end; j++ {
+			i := 1 + j%(m-1)
+			points[j-start] = a[j/(m-1)*2*m+m+i]
+			scalars[j-start] = twiddles[i]
+		}
+		curve.BatchScalarMultiplicationAffineG1(points, scalars)
+		for j := start; j < end; j++ {
+			a[j/(m-1)*2*m+m+1+j%(m-1)] = points[j-start]
+		}
+	}, nbTasks)
+}
+
+// scaleG1 multiplies a[i] by table[i]⋅factor, table and factor being optional
+func scaleG1(a []curve.G1Affine, table []fr.Element, factor *fr.Element, nbTasks int) {
+	parallel.Execute(len(a), func(start, end int) {
+		scalars := make([]fr.Element, end-start)
+		for i := start; i < end; i++ {
+			s := &scalars[i-start]
+			s.SetOne()
+			if table != nil {
+				s.Set(&table[i])
+			}
+			if factor != nil {
+				s.Mul(s, factor)
+			}
+		}
+		curve.BatchScalarMultiplicationAffineG1(a[start:end], scalars)
+	}, nbTasks)
+}
+
+// toAffineG1 sets a to the points of p, in affine coordinates, performing a single field inversion
+// (Montgomery batch inversion trick)
+func toAffineG1(a []curve.G1Affine, p []curve.G1Jac) {
+	if len(p) == 0 {
+		return
+	}
+
+	// a[i].X holds the product of the Z coordinates of the points before i
+	acc := p[0].Z
+	acc.SetOne()
+	for i := range p {
+		if p[i].Z.IsZero() {
+			continue
+		}
+		a[i].X = acc
+		acc.Mul(&acc, &p[i].Z)
+	}
+	acc.Inverse(&acc)
+
+	// a[i].X holds the inverse of the Z coordinate of p[i]
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i].Z.IsZero() {
+			continue
+		}
+		a[i].X.Mul(&a[i].X, &acc)
+		acc.Mul(&acc, &p[i].Z)
+	}
+
+	for i := range p {
+		if p[i].Z.IsZero() {
+			// (X=0, Y=0) is infinity point in affine
+			a[i].X.SetZero()
+			a[i].Y.SetZero()
+			continue
+		}
+		zInv, zInvSquare := a[i].X, a[i].X
+		zInvSquare.Square(&zInv)
+		a[i].X.Mul(&p[i].X, &zInvSquare)
+		a[i].Y.Mul(&p[i].Y, &zInvSquare).Mul(&a[i].Y, &zInv)
+	}
+}
+
+// FFTG2 computes the discrete Fourier transform of the points of a and stores the result in a,
+// with the same conventions as FFT: the multiplications by the twiddle factors become scalar multiplications.
+// It computes the evaluations [p(ωⁱ)] from the commitments [pᵢ] to the coefficients of p, and is used to derive
+// a Lagrange SRS from a canonical one, or to extend commitments to a larger domain.
+//
+// The domain must not be mixed-radix. The transform runs one layer at a time, and the scalar multiplications
+// of a layer are batched in affine coordinates (see curve.BatchScalarMultiplicationAffineG2).
+func (domain *Domain) FFTG2(a []curve.G2Affine, decimation Decimation, opts ...Option) {
+	opt := options(opts...)
+
+	// if coset != 0, scale by coset table
+	if opt.coset {
+		if decimation == DIT {
+			scaleG2(a, domain.CosetTableReversed, nil, opt.nbTasks)
+		} else {
+			scaleG2(a, domain.CosetTable, nil, opt.nbTasks)
+		}
+	}
+
+	fftG2(domain, a, domain.Twiddles, decimation, opt.nbTasks)
+}
+
+// FFTInverseG2 computes the inverse discrete Fourier transform of the points of a and stores the result in a,
+// with the same conventions as FFTInverse (see FFTG2).
+func (domain *Domain) FFTInverseG2(a []curve.G2Affine, decimation Decimation, opts ...Option) {
+	opt := options(opts...)
+
+	fftG2(domain, a, domain.TwiddlesInv, decimation, opt.nbTasks)
+
+	// scale by CardinalityInv, and by the coset table if coset != 0
+	switch {
+	case !opt.coset:
+		scaleG2(a, nil, &domain.CardinalityInv, opt.nbTasks)
+	case decimation == DIT:
+		scaleG2(a, domain.CosetTableInv, &domain.CardinalityInv, opt.nbTasks)
+	default:
+		scaleG2(a, domain.CosetTableInvReversed, &domain.CardinalityInv, opt.nbTasks)
+	}
+}
+
+func fftG2(domain *Domain, a []curve.G2Affine, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	if domain.isMixedRadix() {
+		panic("FFTG2 is not implemented on mixed-radix domains")
+	}
+
+	// the layer with butterflies of width m uses the twiddle factors of stage log₂(n/2m)
+	n := len(a)
+	switch decimation {
+	case DIF:
+		for m := n >> 1; m >= 1; m >>= 1 {
+			stage := bits.TrailingZeros64(uint64(n / (2 * m)))
+			butterfliesG2(a, m, nbTasks)
+			twiddleG2(a, twiddles[stage], m, nbTasks)
+		}
+	case DIT:
+		for m := 1; m < n; m <<= 1 {
+			stage := bits.TrailingZeros64(uint64(n / (2 * m)))
+			twiddleG2(a, twiddles[stage], m, nbTasks)
+			butterfliesG2(a, m, nbTasks)
+		}
+	default:
+		panic("not implemented")
+	}
+}
+
+// butterfliesG2 sets (a[i], a[i+m]) ← (a[i] + a[i+m], a[i] - a[i+m]) in each block of 2m points of a
+func butterfliesG2(a []curve.G2Affine, m int, nbTasks int) {
+	const batchSize = 1024
+	parallel.Execute(len(a)/2, func(start, end int) {
+		p := make([]curve.G2Jac, 2*batchSize)
+		r := make([]curve.G2Affine, 2*batchSize)
+		var neg curve.G2Affine
+		for ; start < end; start += batchSize {
+			size := end - start
+			if size > batchSize {
+				size = batchSize
+			}
+			for j := 0; j < size; j++ {
+				i := (start+j)/m*2*m + (start+j)%m
+				neg.Neg(&a[i+m])
+				p[2*j].FromAffine(&a[i])
+				p[2*j].AddMixed(&a[i+m])
+				p[2*j+1].FromAffine(&a[i])
+				p[2*j+1].AddMixed(&neg)
+			}
+			toAffineG2(r[:2*size], p[:2*size])
+			for j := 0; j < size; j++ {
+				i := (start+j)/m*2*m + (start+j)%m
+				a[i], a[i+m] = r[2*j], r[2*j+1]
+			}
+		}
+	}, nbTasks)
+}
+
+// twiddleG2 multiplies a[i+m] by twiddles[i] in each block of 2m points of a; twiddles[0] = 1 is skipped
+func twiddleG2(a []curve.G2Affine, twiddles []fr.Element, m int, nbTasks int) {
+	if m == 1 {
+		return
+	}
+	parallel.Execute(len(a)/(2*m)*(m-1), func(start, end int) {
+		points := make([]curve.G2Affine, end-start)
+		scalars := make([]fr.Element, end-start)
+		for j := start; j < end; j++ {
+			i := 1 + j%(m-1)
+			points[j-start] = a[j/(m-1)*2*m+m+i]
+			scalars[j-start] = twiddles[i]
+		}
+		curve.BatchScalarMultiplicationAffineG2(points, scalars)
+		for j := start; j < end; j++ {
+			a[j/(m-1)*2*m+m+1+j%(m-1)] = points[j-start]
+		}
+	}, nbTasks)
+}
+
+// scaleG2 multiplies a[i] by table[i]⋅factor, table and factor being optional
+func scaleG2(a []curve.G2Affine, table []fr.Element, factor *fr.Element, nbTasks int) {
+	parallel.Execute(len(a), func(start, end int) {
+		scalars := make([]fr.Element, end-start)
+		for i := start; i < end; i++ {
+			s := &scalars[i-start]
+			s.SetOne()
+			if table != nil {
+				s.Set(&table[i])
+			}
+			if factor != nil {
+				s.Mul(s, factor)
+			}
+		}
+		curve.BatchScalarMultiplicationAffineG2(a[start:end], scalars)
+	}, nbTasks)
+}
+
+// toAffineG2 sets a to the points of p, in affine coordinates, performing a single field inversion
+// (Montgomery batch inversion trick)
+func toAffineG2(a []curve.G2Affine, p []curve.G2Jac) {
+	if len(p) == 0 {
+		return
+	}
+
+	// a[i].X holds the product of the Z coordinates of the points before i
+	acc := p[0].Z
+	acc.SetOne()
+	for i := range p {
+		if p[i].Z.IsZero() {
+			continue
+		}
+		a[i].X = acc
+		acc.Mul(&acc, &p[i].Z)
+	}
+	acc.Inverse(&acc)
+
+	// a[i].X holds the inverse of the Z coordinate of p[i]
+	for i := len(p) - 1; i >= 0; i-- {
+		if p[i].Z.IsZero() {
+			continue
+		}
+		a[i].X.Mul(&a[i].X, &acc)
+		acc.Mul(&acc, &p[i].Z)
+	}
+
+	for i := range p {
+		if p[i].Z.IsZero() {
+			// (X=0, Y=0) is infinity point in affine
+			a[i].X.SetZero()
+			a[i].Y.SetZero()
+			continue
+		}
+		zInv, zInvSquare := a[i].X, a[i].X
+		zInvSquare.Square(&zInv)
+		a[i].X.Mul(&p[i].X, &zInvSquare)
+		a[i].Y.Mul(&p[i].Y, &zInvSquare).Mul(&a[i].Y, &zInv)
+	}
+}
@@ -18,9 +18,16 @@ package fft
 
 import (
 	"math/big"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strconv"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/utils"
+
 	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
 
 	"github.com/leanovate/gopter"
@@ -238,6 +245,244 @@ func TestFFT(t *testing.T) {
 
 }
 
+func TestFFTMixedRadix(t *testing.T) {
+	// sizes which are not supported by the field fall back to a power of 2
+	for _, m := range []uint64{3, 5, 6, 15, 3 << 6, 5 << 6, 9 << 5, 15 << 6, 25 << 4} {
+		domain := NewDomainMixedRadix(m)
+		n := int(domain.Cardinality)
+		if domain.Cardinality != m {
+			continue
+		}
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+
+			pol := make([]fr.Element, n)
+			backupPol := make([]fr.Element, n)
+			for i := 0; i < n; i++ {
+				pol[i].SetRandom()
+			}
+			copy(backupPol, pol)
+
+			var one, check fr.Element
+			one.SetOne()
+			check.Exp(domain.Generator, big.NewInt(int64(n)))
+			if !check.Equal(&one) {
+				t.Fatal("the generator should be of order n")
+			}
+
+			// evaluations on the domain and on its coset, in natural order
+			evals := make([]fr.Element, n)
+			evalsCoset := make([]fr.Element, n)
+			var x fr.Element
+			x.SetOne()
+			for i := 0; i < n; i++ {
+				if i > 0 && x.Equal(&one) {
+					t.Fatal("the generator should be of order n")
+				}
+				evals[i] = evaluatePolynomial(backupPol, x)
+				var xCoset fr.Element
+				xCoset.Mul(&x, &domain.FrMultiplicativeGen)
+				evalsCoset[i] = evaluatePolynomial(backupPol, xCoset)
+				x.Mul(&x, &domain.Generator)
+			}
+			equal := func(a, b []fr.Element) bool {
+				for i := range a {
+					if !a[i].Equal(&b[i]) {
+						return false
+					}
+				}
+				return true
+			}
+
+			domain.FFT(pol, DIF)
+			BitReverseInverse(pol)
+			if !equal(pol, evals) {
+				t.Fatal("DIF FFT should be consistent with dual basis")
+			}
+
+			copy(pol, backupPol)
+			BitReverse(pol)
+			domain.FFT(pol, DIT, WithNbTasks(1))
+			if !equal(pol, evals) {
+				t.Fatal("DIT FFT should be consistent with dual basis")
+			}
+
+			copy(pol, backupPol)
+			domain.FFT(pol, DIF, OnCoset())
+			BitReverseInverse(pol)
+			if !equal(pol, evalsCoset) {
+				t.Fatal("DIF FFT on cosets should be consistent with dual basis")
+			}
+
+			domain.FFTInverse(pol, DIF, OnCoset())
+			domain.FFT(pol, DIT, OnCoset())
+			domain.FFTInverse(pol, DIF, OnCoset())
+			BitReverseInverse(pol)
+			if !equal(pol, backupPol) {
+				t.Fatal("DIT FFT(DIF FFT)==id on cosets")
+			}
+
+			BitReverse(pol)
+			domain.FFT(pol, DIT)
+			domain.FFTInverse(pol, DIF)
+			BitReverseInverse(pol)
+			if !equal(pol, backupPol) {
+				t.Fatal("bitReverse(DIF FFT(DIT FFT (bitReverse))))==id")
+			}
+
+			BitReverse(pol)
+			for i := 0; i < n; i++ {
+				if !pol[BitReverseIndex(uint64(n), uint64(i))].Equal(&backupPol[i]) {
+					t.Fatal("BitReverseIndex should be consistent with BitReverse")
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratorMixedRadix(t *testing.T) {
+	// a power of 2 gives the generator of NewDomain
+	for _, n := range []uint64{1, 2, 16} {
+		g, err := GeneratorMixedRadix(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected, _ := Generator(n); !g.Equal(&expected) {
+			t.Fatal("GeneratorMixedRadix and Generator should match on powers of 2")
+		}
+	}
+
+	// GeneratorMixedRadix(n) = GeneratorMixedRadix(k⋅n)ᵏ
+	sizes := []uint64{1, 2, 4}
+	for _, s := range []uint64{3, 5, 9, 15, 25} {
+		if _, err := GeneratorMixedRadix(s); err == nil {
+			sizes = append(sizes, s, 2*s, 4*s)
+		}
+	}
+	for _, n := range sizes {
+		for _, m := range sizes {
+			if m%n != 0 {
+				continue
+			}
+			gn, _ := GeneratorMixedRadix(n)
+			gm, _ := GeneratorMixedRadix(m)
+			gm.Exp(gm, big.NewInt(int64(m/n)))
+			if !gn.Equal(&gm) {
+				t.Fatalf("GeneratorMixedRadix(%d) and GeneratorMixedRadix(%d) should be consistent", n, m)
+			}
+		}
+	}
+
+	if _, err := GeneratorMixedRadix(7); err == nil {
+		t.Fatal("7 is not a supported cardinality")
+	}
+}
+
+func TestFFTBatch(t *testing.T) {
+	domains := []*Domain{NewDomain(1 << 6)}
+	if domain := NewDomainMixedRadix(3 << 4); domain.isMixedRadix() {
+		domains = append(domains, domain)
+	}
+
+	for _, domain := range domains {
+		n := int(domain.Cardinality)
+		// with 2 vectors and 4 tasks, the vectors are transformed concurrently;
+		// with 9 vectors and 2 tasks, they are transformed by groups, the last one being incomplete
+		for _, c := range []struct{ nbVectors, nbTasks int }{
+			{nbVectors: 2, nbTasks: 4},
+			{nbVectors: 9, nbTasks: 2},
+		} {
+			t.Run(strconv.Itoa(n)+"/"+strconv.Itoa(c.nbVectors)+"vectors", func(t *testing.T) {
+				vectors := make([][]fr.Element, c.nbVectors)
+				expected := make([][]fr.Element, c.nbVectors)
+				for i := range vectors {
+					vectors[i] = make([]fr.Element, n)
+					expected[i] = make([]fr.Element, n)
+					for j := range vectors[i] {
+						vectors[i][j].SetRandom()
+					}
+				}
+
+				for _, decimation := range []Decimation{DIF, DIT} {
+					for _, coset := range []bool{false, true} {
+						opts := []Option{WithNbTasks(c.nbTasks)}
+						if coset {
+							opts = append(opts, OnCoset())
+						}
+						for i := range vectors {
+							copy(expected[i], vectors[i])
+							domain.FFT(expected[i], decimation, opts...)
+						}
+						domain.FFTBatch(vectors, decimation, opts...)
+						for i := range vectors {
+							for j := range vectors[i] {
+								if !vectors[i][j].Equal(&expected[i][j]) {
+									t.Fatal("FFTBatch should match FFT")
+								}
+							}
+						}
+
+						for i := range vectors {
+							domain.FFTInverse(expected[i], decimation, opts...)
+						}
+						domain.FFTInverseBatch(vectors, decimation, opts...)
+						for i := range vectors {
+							for j := range vectors[i] {
+								if !vectors[i][j].Equal(&expected[i][j]) {
+									t.Fatal("FFTInverseBatch should match FFTInverse")
+								}
+							}
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestFourStepFFT(t *testing.T) {
+	for logN := 6; logN <= 11; logN++ {
+		n := 1 << logN
+		domain := NewDomain(uint64(n))
+
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			// the vector is memory-mapped if the platform allows it
+			pol, unmap, err := utils.MmapSlice[fr.Element](filepath.Join(t.TempDir(), "pol"), n)
+			if err != nil {
+				pol, unmap = make([]fr.Element, n), func() error { return nil }
+			}
+			defer func() {
+				if err := unmap(); err != nil {
+					t.Fatal(err)
+				}
+			}()
+
+			expected := make([]fr.Element, n)
+			for i := range pol {
+				pol[i].SetRandom()
+			}
+
+			for _, twiddles := range [][][]fr.Element{domain.Twiddles, domain.TwiddlesInv} {
+				copy(expected, pol)
+				difFFT(expected, twiddles, 0, -1, nil, 1)
+				fourStepFFT(pol, twiddles, DIF, 2)
+				for i := range pol {
+					if !pol[i].Equal(&expected[i]) {
+						t.Fatal("DIF four-step FFT should match difFFT")
+					}
+				}
+
+				ditFFT(expected, twiddles, 0, -1, nil, 1)
+				fourStepFFT(pol, twiddles, DIT, 2)
+				for i := range pol {
+					if !pol[i].Equal(&expected[i]) {
+						t.Fatal("DIT four-step FFT should match ditFFT")
+					}
+				}
+			}
+		})
+	}
+}
+
 // --------------------------------------------------------------------
 // benches
 func BenchmarkBitReverse(b *testing.B) {
@@ -291,6 +536,103 @@ func BenchmarkFFT(b *testing.B) {
 
 }
 
+func BenchmarkFFTBatch(b *testing.B) {
+	const nbVectors = 32
+
+	for _, logSize := range []int{10, 14, 18} {
+		sizeDomain := 1 << logSize
+		domain := NewDomain(uint64(sizeDomain))
+		vectors := make([][]fr.Element, nbVectors)
+		for i := range vectors {
+			vectors[i] = make([]fr.Element, sizeDomain)
+			vectors[i][0].SetRandom()
+			for j := 1; j < sizeDomain; j++ {
+				vectors[i][j] = vectors[i][j-1]
+			}
+		}
+
+		b.Run("sequential fft 2**"+strconv.Itoa(logSize)+"bits", func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				for i := range vectors {
+					domain.FFT(vectors[i], DIF)
+				}
+			}
+		})
+		b.Run("batch fft 2**"+strconv.Itoa(logSize)+"bits", func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				domain.FFTBatch(vectors, DIF)
+			}
+		})
+	}
+}
+
+// BenchmarkFourStepFFT compares the recursive and the four-step FFTs from 2²² elements, to set
+// fourStepThreshold. The vector and its twiddles take 2⋅fr.Bytes bytes per element, so that by default
+// the benchmark stops at 2²⁴; set FFT_LOG_MAX_SIZE (e.g. to 28) to run the larger sizes.
+func BenchmarkFourStepFFT(b *testing.B) {
+	logMaxSize := 24
+	if s := os.Getenv("FFT_LOG_MAX_SIZE"); s != "" {
+		var err error
+		if logMaxSize, err = strconv.Atoi(s); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	nbTasks := runtime.NumCPU()
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(nbTasks)))
+	if nbTasks == 1 {
+		maxSplits = -1
+	}
+
+	for logSize := 22; logSize <= logMaxSize; logSize++ {
+		sizeDomain := uint64(1) << logSize
+		generator, err := Generator(sizeDomain)
+		if err != nil {
+			break // fr has no root of unity of order 2^logSize
+		}
+
+		// NewDomain would also compute the inverse twiddles and the coset tables, 3 times the memory
+		// of the vector: only compute the twiddles of the DIF FFT
+		twiddles := make([][]fr.Element, logSize)
+		for i := range twiddles {
+			twiddles[i] = make([]fr.Element, 1+(1<<(logSize-i-1)))
+			twiddles[i][0].SetOne()
+			if i == 0 {
+				twiddles[i][1] = generator
+			} else {
+				twiddles[i][1] = twiddles[i-1][2]
+			}
+			for j := 2; j < len(twiddles[i]); j++ {
+				twiddles[i][j].Mul(&twiddles[i][j-1], &twiddles[i][1])
+			}
+		}
+		pol := make([]fr.Element, sizeDomain)
+		pol[0].SetRandom()
+		for i := 1; i < len(pol); i++ {
+			pol[i] = pol[i-1]
+		}
+
+		b.Run("recursive fft 2**"+strconv.Itoa(logSize)+"bits", func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				difFFT(pol, twiddles, 0, maxSplits, nil, nbTasks)
+			}
+		})
+		b.Run("four-step fft 2**"+strconv.Itoa(logSize)+"bits", func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				fourStepFFT(pol, twiddles, DIF, nbTasks)
+			}
+		})
+
+		// release the vector and the twiddles before allocating the next size
+		twiddles, pol = nil, nil
+		runtime.GC()
+	}
+}
+
 func BenchmarkFFTDITCosetReference(b *testing.B) {
 	const maxSize = 1 << 20
 
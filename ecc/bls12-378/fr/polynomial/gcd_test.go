@@ -0,0 +1,110 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	for _, n := range []int{5, 100, 400} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			// gcd(a⋅c, b⋅c) = c, a and b being coprime with high probability
+			a, b, c := randomPolynomial(n), randomPolynomial(n+7), randomPolynomial(n/2+1)
+			var ac, bc Polynomial
+			ac.Mul(a, c)
+			bc.Mul(b, c)
+
+			g := GCD(ac, bc)
+			if !g.Equal(monic(c)) {
+				t.Fatal("gcd(a⋅c, b⋅c) should be c")
+			}
+			if g = GCD(bc, ac); !g.Equal(monic(c)) {
+				t.Fatal("GCD should be symmetric")
+			}
+			if g = GCD(ac, Polynomial{}); !g.Equal(monic(ac)) {
+				t.Fatal("gcd(p, 0) should be p")
+			}
+		})
+	}
+
+	// half-GCD against the Euclidean algorithm
+	a, b := randomPolynomial(600), randomPolynomial(500)
+	m := hgcd(a, b)
+	c, d := m.apply(a, b)
+	e := hgcdEuclid(a, b, len(a)/2)
+	ce, de := e.apply(a, b)
+	if !c.Equal(ce) || !d.Equal(de) {
+		t.Fatal("hgcd should match the Euclidean algorithm")
+	}
+}
+
+func TestPartialGCD(t *testing.T) {
+	a, b := randomPolynomial(600), randomPolynomial(550)
+	for _, d := range []int{550, 500, 300, 100, 1} {
+		t.Run(strconv.Itoa(d), func(t *testing.T) {
+			r, u, v := PartialGCD(a, b, d)
+
+			// the Euclidean algorithm, down to degree < d
+			expected, prev := b, a
+			for len(expected) > d {
+				_, rem := DivRem(prev, expected)
+				prev, expected = expected, trim(rem)
+			}
+			if !r.Equal(expected) {
+				t.Fatal("wrong remainder")
+			}
+
+			// r = u⋅a + v⋅b
+			if c := combine(u, a, v, b); !c.Equal(r) {
+				t.Fatal("wrong cofactors")
+			}
+		})
+	}
+}
+
+func TestSquareFreeFactorization(t *testing.T) {
+	// p = f₀⋅f₂³
+	f0, f2 := randomPolynomial(4), randomPolynomial(3)
+	var p Polynomial
+	p.Mul(f2, f2)
+	p.Mul(p, f2)
+	p.Mul(p, f0)
+
+	factors := SquareFreeFactorization(p)
+	if len(factors) != 3 {
+		t.Fatal("expected 3 factors")
+	}
+	if !factors[0].Equal(monic(f0)) || len(factors[1]) != 1 || !factors[2].Equal(monic(f2)) {
+		t.Fatal("wrong square-free factorization")
+	}
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkGCD(b *testing.B) {
+	for _, n := range []int{1 << 10, 1 << 12} {
+		p1, p2 := randomPolynomial(n), randomPolynomial(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				GCD(p1, p2)
+			}
+		})
+	}
+}
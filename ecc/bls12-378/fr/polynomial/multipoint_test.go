@@ -0,0 +1,93 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+func TestSubproductTree(t *testing.T) {
+	for _, n := range []int{1, 10, 100, 257} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			points := make([]fr.Element, n)
+			for i := range points {
+				points[i].SetRandom()
+			}
+			tree := NewSubproductTree(points)
+
+			// the product vanishes on the points
+			product := tree.Product()
+			if len(product) != n+1 {
+				t.Fatal("the product should have degree n")
+			}
+			for i := range points {
+				if v := product.Eval(&points[i]); !v.IsZero() {
+					t.Fatal("the product should vanish on the points")
+				}
+			}
+
+			// evaluation of a polynomial of degree > n
+			p := randomPolynomial(2*n + 3)
+			evals := tree.Evaluate(p)
+			for i := range points {
+				if expected := p.Eval(&points[i]); !evals[i].Equal(&expected) {
+					t.Fatal("Evaluate should match Eval")
+				}
+			}
+			if multi := Polynomial(p.EvalMultiPoints(points)); !multi.Equal(evals) {
+				t.Fatal("EvalMultiPoints should match Evaluate")
+			}
+
+			// interpolation of a polynomial of degree < n
+			p = randomPolynomial(n)
+			if res := tree.Interpolate(tree.Evaluate(p)); !res.Equal(p) {
+				t.Fatal("Interpolate should recover the polynomial")
+			}
+			if res := Interpolate(points, tree.Evaluate(p)); !res.Equal(p) {
+				t.Fatal("Interpolate should recover the polynomial")
+			}
+		})
+	}
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkSubproductTree(b *testing.B) {
+	const n = 1 << 12
+	points := make([]fr.Element, n)
+	for i := range points {
+		points[i].SetRandom()
+	}
+	p := randomPolynomial(n)
+	tree := NewSubproductTree(points)
+	evals := tree.Evaluate(p)
+
+	b.Run("Evaluate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tree.Evaluate(p)
+		}
+	})
+	b.Run("Interpolate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tree.Interpolate(evals)
+		}
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package reedsolomon
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr/polynomial"
+)
+
+var (
+	ErrMessageSize     = errors.New("the message should have k elements")
+	ErrWordSize        = errors.New("the word should have n elements")
+	ErrTooManyErasures = errors.New("more than n-k erasures")
+	ErrDecoding        = errors.New("the word is too far from the code to be decoded")
+)
+
+// Code is a Reed-Solomon code of dimension k and length n = k⋅blowup, both powers of 2.
+type Code struct {
+	k, n int
+
+	// the subgroups of order k and n
+	small, large *fft.Domain
+
+	// points[i] = ωⁱ', i' being the bit-reversal of i, is the point of the i-th element of a codeword
+	points []fr.Element
+}
+
+// NewCode returns the Reed-Solomon code of dimension k and length k⋅blowup.
+// It panics if k or blowup is not a power of 2, or if the subgroup of order k⋅blowup doesn't exist.
+func NewCode(k, blowup uint64) *Code {
+	if bits.OnesCount64(k) != 1 || bits.OnesCount64(blowup) != 1 {
+		panic("k and blowup should be powers of 2")
+	}
+	c := &Code{
+		k:     int(k),
+		n:     int(k * blowup),
+		small: fft.NewDomain(k),
+		large: fft.NewDomain(k * blowup),
+	}
+
+	c.points = make([]fr.Element, c.n)
+	c.points[0].SetOne()
+	for i := 1; i < c.n; i++ {
+		c.points[i].Mul(&c.points[i-1], &c.large.Generator)
+	}
+	fft.BitReverse(c.points)
+
+	return c
+}
+
+// Dimension returns k, the number of elements of a message.
+func (c *Code) Dimension() int {
+	return c.k
+}
+
+// Length returns n, the number of elements of a codeword.
+func (c *Code) Length() int {
+	return c.n
+}
+
+// Encode returns the codeword of message, the evaluations in bit-reversed order on the subgroup
+// of order n of the polynomial of degree < k whose evaluations on the subgroup of order k
+// are the message, in bit-reversed order. The first k elements of the codeword are the message.
+func (c *Code) Encode(message []fr.Element) ([]fr.Element, error) {
+	if len(message) != c.k {
+		return nil, ErrMessageSize
+	}
+	p := make([]fr.Element, c.n)
+	copy(p, message)
+	c.small.FFTInverse(p[:c.k], fft.DIT)
+	c.large.FFT(p, fft.DIF)
+	return p, nil
+}
+
+// RecoverErasures returns the codeword equal to word outside the erased positions,
+// erased[i] telling if word[i] is missing. It needs at least k non-erased elements.
+//
+// Writing P for the polynomial of the codeword and Z = ∏(X - xᵢ) for the vanishing polynomial of the
+// points of the erasures, the word with zeros at the erasures times Z are the evaluations of P⋅Z,
+// which interpolates them since deg(P⋅Z) < n. Then P = (P⋅Z)/Z is computed on a coset of the subgroup,
+// where Z doesn't vanish.
+// It returns ErrDecoding if the non-erased elements don't belong to a codeword.
+func (c *Code) RecoverErasures(word []fr.Element, erased []bool) ([]fr.Element, error) {
+	if len(word) != c.n || len(erased) != c.n {
+		return nil, ErrWordSize
+	}
+	var points []fr.Element
+	for i := range erased {
+		if erased[i] {
+			points = append(points, c.points[i])
+		}
+	}
+	if len(points) > c.n-c.k {
+		return nil, ErrTooManyErasures
+	}
+	z := vanishingPolynomial(points)
+
+	// P⋅Z, from its evaluations on the subgroup
+	zEval := make([]fr.Element, c.n)
+	copy(zEval, z)
+	c.large.FFT(zEval, fft.DIF)
+	pz := make([]fr.Element, c.n)
+	for i := range pz {
+		if !erased[i] {
+			pz[i].Mul(&word[i], &zEval[i])
+		}
+	}
+	c.large.FFTInverse(pz, fft.DIT)
+
+	// P = (P⋅Z)/Z, on the coset
+	for i := copy(zEval, z); i < c.n; i++ {
+		zEval[i].SetZero()
+	}
+	c.large.FFT(zEval, fft.DIF, fft.OnCoset())
+	c.large.FFT(pz, fft.DIF, fft.OnCoset())
+	zEval = fr.BatchInvert(zEval)
+	for i := range pz {
+		pz[i].Mul(&pz[i], &zEval[i])
+	}
+	c.large.FFTInverse(pz, fft.DIT, fft.OnCoset())
+
+	return c.codeword(pz)
+}
+
+// Decode returns the codeword at distance at most (n-k)/2 from word, correcting its errors
+// with Gao's algorithm, or ErrDecoding if there isn't any.
+//
+// Writing g₀ = Xⁿ - 1 for the vanishing polynomial of the subgroup and g₁ for the interpolation of
+// word, the extended Euclidean algorithm on (g₀, g₁), stopped at the first remainder g = u⋅g₀ + v⋅g₁
+// of degree < (n+k)/2, gives g = P⋅v where P is the polynomial of the codeword and v vanishes on the
+// points of the errors.
+func (c *Code) Decode(word []fr.Element) ([]fr.Element, error) {
+	if len(word) != c.n {
+		return nil, ErrWordSize
+	}
+	g1 := make(polynomial.Polynomial, c.n)
+	copy(g1, word)
+	c.large.FFTInverse(g1, fft.DIT)
+
+	g0 := make(polynomial.Polynomial, c.n+1)
+	g0[0].SetOne().Neg(&g0[0])
+	g0[c.n].SetOne()
+
+	g, _, v := polynomial.PartialGCD(g0, g1, (c.n+c.k+1)/2)
+	p, r := polynomial.DivRem(g, v)
+	for i := range r {
+		if !r[i].IsZero() {
+			return nil, ErrDecoding
+		}
+	}
+
+	if len(p) > c.k {
+		return nil, ErrDecoding
+	}
+	res := make([]fr.Element, c.n)
+	copy(res, p)
+	return c.codeword(res)
+}
+
+// codeword returns the evaluations of p, given by its n coefficients, in bit-reversed order,
+// or ErrDecoding if deg(p) ⩾ k
+func (c *Code) codeword(p []fr.Element) ([]fr.Element, error) {
+	for i := c.k; i < c.n; i++ {
+		if !p[i].IsZero() {
+			return nil, ErrDecoding
+		}
+	}
+	c.large.FFT(p, fft.DIF)
+	return p, nil
+}
+
+// vanishingPolynomial returns ∏(X - xᵢ), multiplying the halves recursively
+func vanishingPolynomial(points []fr.Element) polynomial.Polynomial {
+	switch len(points) {
+	case 0:
+		return polynomial.Polynomial{fr.One()}
+	case 1:
+		p := polynomial.Polynomial{points[0], fr.One()}
+		p[0].Neg(&p[0])
+		return p
+	}
+	m := len(points) / 2
+	var p polynomial.Polynomial
+	p.Mul(vanishingPolynomial(points[:m]), vanishingPolynomial(points[m:]))
+	return p
+}
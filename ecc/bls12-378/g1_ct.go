@@ -0,0 +1,211 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bls12378
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+)
+
+// g1ProjCT is a point in homogeneous projective coordinates (x=X/Z, y=Y/Z), with (0:1:0) the point at infinity.
+// It is used by the constant-time scalar multiplication, with the complete formulas of
+// Renes, Costello and Batina (https://eprint.iacr.org/2015/1060): there are no exceptional cases to branch on.
+type g1ProjCT struct {
+	X, Y, Z fp.Element
+}
+
+// ScalarMultiplicationCT computes and returns p = a ⋅ s in constant time, for secret scalars.
+// The sequence of operations and memory accesses depends on max(fr.Bytes, ⌈s.BitLen()/8⌉),
+// but not on the values of a and s. If s < 0, a is negated; the sign of s is not hidden.
+//
+// It is several times slower than ScalarMultiplication.
+func (p *G1Affine) ScalarMultiplicationCT(a *G1Affine, s *big.Int) *G1Affine {
+	var _p g1ProjCT
+	_p.fromAffine(a)
+	_p.mulCT(&_p, s)
+	return p.fromProjCT(&_p)
+}
+
+// ScalarMultiplicationBaseCT computes and returns p = g ⋅ s in constant time, where g is the prime subgroup generator.
+// See ScalarMultiplicationCT.
+func (p *G1Affine) ScalarMultiplicationBaseCT(s *big.Int) *G1Affine {
+	return p.ScalarMultiplicationCT(&g1GenAff, s)
+}
+
+// fromProjCT sets p = q and returns p, inverting q.Z in constant time
+func (p *G1Affine) fromProjCT(q *g1ProjCT) *G1Affine {
+	// if q is the point at infinity, zInv = 0 and p = (0,0)
+	var zInv fp.Element
+	zInv.InverseCT(&q.Z)
+	p.X.MulCT(&q.X, &zInv)
+	p.Y.MulCT(&q.Y, &zInv)
+	return p
+}
+
+// fromAffine sets p = a and returns p
+func (p *g1ProjCT) fromAffine(a *G1Affine) *g1ProjCT {
+	var zero, one fp.Element
+	one.SetOne()
+
+	// notInfinity == 0 iff a = (0,0)
+	notInfinity := int(a.X.NotEqual(&zero) | a.Y.NotEqual(&zero))
+	p.X = a.X
+	p.Y.Select(notInfinity, &one, &a.Y)
+	p.Z.Select(notInfinity, &zero, &one)
+	return p
+}
+
+func (p *g1ProjCT) setInfinity() *g1ProjCT {
+	p.X.SetZero()
+	p.Y.SetOne()
+	p.Z.SetZero()
+	return p
+}
+
+// neg sets p = -a and returns p
+func (p *g1ProjCT) neg(a *g1ProjCT) *g1ProjCT {
+	var zero fp.Element
+	p.X = a.X
+	p.Y.SubCT(&zero, &a.Y)
+	p.Z = a.Z
+	return p
+}
+
+// selectCT sets p = a if c == 0, p = b otherwise, in constant time
+func (p *g1ProjCT) selectCT(c int, a, b *g1ProjCT) *g1ProjCT {
+	p.X.Select(c, &a.X, &b.X)
+	p.Y.Select(c, &a.Y, &b.Y)
+	p.Z.Select(c, &a.Z, &b.Z)
+	return p
+}
+
+// mulCT sets p = a ⋅ s with a 4-bits fixed window, reading the table with constant-time selections
+func (p *g1ProjCT) mulCT(a *g1ProjCT, s *big.Int) *g1ProjCT {
+	// b3 = 3⋅b
+	var b3 fp.Element
+	b3.Double(&bCurveCoeff).Add(&b3, &bCurveCoeff)
+
+	base := *a
+	if s.Sign() == -1 {
+		base.neg(&base)
+	}
+
+	// table[i] = i ⋅ base
+	const w = 4
+	var table [1 << w]g1ProjCT
+	table[0].setInfinity()
+	table[1] = base
+	for i := 2; i < len(table); i++ {
+		table[i].add(&table[i-1], &base, &b3)
+	}
+
+	// the bits of |s| are read from a fixed-size big-endian buffer, as big.Int.Bit branches on the length of s
+	nbBytes := fr.Bytes
+	if l := (s.BitLen() + 7) / 8; l > nbBytes {
+		nbBytes = l
+	}
+	e := s.FillBytes(make([]byte, nbBytes))
+
+	var res, t g1ProjCT
+	res.setInfinity()
+	for i := 0; i < 2*nbBytes; i++ {
+		for j := 0; j < w; j++ {
+			res.double(&res, &b3)
+		}
+		// d is the i-th window of 4 bits, from the most significant one
+		d := uint(e[i/2]>>(w*(1-i%2))) & (1<<w - 1)
+		// t = table[d], scanning the whole table
+		for j := range table {
+			t.selectCT(int(uint(j)^d), &table[j], &t)
+		}
+		res.add(&res, &t, &b3)
+	}
+
+	*p = res
+	return p
+}
+
+// add sets p = a + b and returns p, for any a and b (including a = b and the point at infinity)
+// https://eprint.iacr.org/2015/1060, algorithm 7 (a = 0)
+func (p *g1ProjCT) add(a, b *g1ProjCT, b3 *fp.Element) *g1ProjCT {
+	var t0, t1, t2, t3, t4, X3, Y3, Z3 fp.Element
+	t0.MulCT(&a.X, &b.X)
+	t1.MulCT(&a.Y, &b.Y)
+	t2.MulCT(&a.Z, &b.Z)
+	t3.AddCT(&a.X, &a.Y)
+	t4.AddCT(&b.X, &b.Y)
+	t3.MulCT(&t3, &t4)
+	t4.AddCT(&t0, &t1)
+	t3.SubCT(&t3, &t4)
+	t4.AddCT(&a.Y, &a.Z)
+	X3.AddCT(&b.Y, &b.Z)
+	t4.MulCT(&t4, &X3)
+	X3.AddCT(&t1, &t2)
+	t4.SubCT(&t4, &X3)
+	X3.AddCT(&a.X, &a.Z)
+	Y3.AddCT(&b.X, &b.Z)
+	X3.MulCT(&X3, &Y3)
+	Y3.AddCT(&t0, &t2)
+	Y3.SubCT(&X3, &Y3)
+	X3.AddCT(&t0, &t0)
+	t0.AddCT(&X3, &t0)
+	t2.MulCT(b3, &t2)
+	Z3.AddCT(&t1, &t2)
+	t1.SubCT(&t1, &t2)
+	Y3.MulCT(b3, &Y3)
+	X3.MulCT(&t4, &Y3)
+	t2.MulCT(&t3, &t1)
+	X3.SubCT(&t2, &X3)
+	Y3.MulCT(&Y3, &t0)
+	t1.MulCT(&t1, &Z3)
+	Y3.AddCT(&t1, &Y3)
+	t0.MulCT(&t0, &t3)
+	Z3.MulCT(&Z3, &t4)
+	Z3.AddCT(&Z3, &t0)
+
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
+
+// double sets p = 2 ⋅ a and returns p, for any a (including the point at infinity)
+// https://eprint.iacr.org/2015/1060, algorithm 9 (a = 0)
+func (p *g1ProjCT) double(a *g1ProjCT, b3 *fp.Element) *g1ProjCT {
+	var t0, t1, t2, X3, Y3, Z3 fp.Element
+	t0.MulCT(&a.Y, &a.Y)
+	Z3.AddCT(&t0, &t0)
+	Z3.AddCT(&Z3, &Z3)
+	Z3.AddCT(&Z3, &Z3)
+	t1.MulCT(&a.Y, &a.Z)
+	t2.MulCT(&a.Z, &a.Z)
+	t2.MulCT(b3, &t2)
+	X3.MulCT(&t2, &Z3)
+	Y3.AddCT(&t0, &t2)
+	Z3.MulCT(&t1, &Z3)
+	t1.AddCT(&t2, &t2)
+	t2.AddCT(&t1, &t2)
+	t0.SubCT(&t0, &t2)
+	Y3.MulCT(&t0, &Y3)
+	Y3.AddCT(&X3, &Y3)
+	t1.MulCT(&a.X, &a.Y)
+	X3.MulCT(&t0, &t1)
+	X3.AddCT(&X3, &X3)
+
+	p.X, p.Y, p.Z = X3, Y3, Z3
+	return p
+}
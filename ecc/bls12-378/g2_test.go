@@ -492,6 +492,64 @@ func TestG2AffineBatchScalarMultiplication(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG2AffineBatchScalarMultiplicationAffine(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = nbFuzzShort
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	const nbSamples = 10
+
+	properties.Property("[BLS12-378] BatchScalarMultiplicationAffine should be consistent with individual scalar multiplications", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var points, expected [nbSamples]G2Affine
+			var scalars [nbSamples]fr.Element
+			var b big.Int
+
+			for i := 0; i < nbSamples; i++ {
+				scalars[i].SetUint64(uint64(i+1)).
+					Mul(&scalars[i], &mixer)
+				points[i].ScalarMultiplication(&g2GenAff, big.NewInt(int64(i+1)))
+			}
+
+			// zero scalar, infinity point, small scalars, same point twice
+			scalars[0].SetZero()
+			points[1].setInfinity()
+			scalars[2].SetOne()
+			scalars[3].SetOne().Neg(&scalars[3])
+			points[4].Set(&points[5])
+
+			for i := range points {
+				expected[i].ScalarMultiplication(&points[i], scalars[i].BigInt(&b))
+			}
+			BatchScalarMultiplicationAffineG2(points[:], scalars[:])
+
+			for i := range points {
+				if !points[i].Equal(&expected[i]) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// the exceptional cases of the affine formulas are reported, not computed
+	var p, q, neg G2Affine
+	p.Set(&g2GenAff)
+	neg.Neg(&p)
+	failed := make([]bool, 2)
+	batchAddOrDoubleG2Affine([]*G2Affine{&q, &q}, []*G2Affine{&p, &p}, []*G2Affine{&p, &neg}, []int{0, 1}, failed, make([]fptower.E2, 4))
+	if !failed[0] || !failed[1] || !q.IsInfinity() {
+		t.Fatal("adding points with the same x coordinate should fail")
+	}
+}
+
 // ------------------------------------------------------------
 // benches
 
@@ -559,6 +617,21 @@ func BenchmarkG2AffineBatchScalarMultiplication(b *testing.B) {
 	}
 }
 
+func BenchmarkG2AffineBatchScalarMultiplicationAffine(b *testing.B) {
+	const nbSamples = 1 << 10
+	points := make([]G2Affine, nbSamples)
+	scalars := make([]fr.Element, nbSamples)
+	for i := range scalars {
+		scalars[i].SetRandom()
+	}
+	fillBenchBasesG2(points)
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		BatchScalarMultiplicationAffineG2(points, scalars)
+	}
+}
+
 func BenchmarkG2JacScalarMultiplication(b *testing.B) {
 
 	var scalar big.Int
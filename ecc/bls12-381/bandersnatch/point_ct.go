@@ -0,0 +1,138 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package bandersnatch
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+// ScalarMultiplicationCT scalar multiplication of a point
+// p1 in affine coordinates with a scalar in big.Int, in constant time, for secret scalars.
+// The sequence of operations and memory accesses depends on the size of the curve order and
+// on scalar.BitLen() if it is larger, but not on the values of p1 and scalar.
+// If scalar < 0, p1 is negated; the sign of scalar is not hidden.
+//
+// It is several times slower than ScalarMultiplication.
+func (p *PointAffine) ScalarMultiplicationCT(p1 *PointAffine, scalar *big.Int) *PointAffine {
+	var p1Proj, resProj PointProj
+	p1Proj.FromAffine(p1)
+	resProj.scalarMulCT(&p1Proj, scalar)
+
+	// the twisted Edwards formulas are complete: resProj.Z != 0
+	var I fr.Element
+	I.InverseCT(&resProj.Z)
+	p.X.MulCT(&resProj.X, &I)
+	p.Y.MulCT(&resProj.Y, &I)
+	return p
+}
+
+// scalarMulCT sets p = p1 ⋅ scalar with a 4-bits fixed window, reading the table with constant-time selections
+func (p *PointProj) scalarMulCT(p1 *PointProj, scalar *big.Int) *PointProj {
+	ecurve := GetEdwardsCurve()
+
+	base := *p1
+	if scalar.Sign() == -1 {
+		var zero fr.Element
+		base.X.SubCT(&zero, &base.X)
+	}
+
+	// table[i] = i ⋅ base
+	const w = 4
+	var table [1 << w]PointProj
+	table[0].setInfinity()
+	table[1] = base
+	for i := 2; i < len(table); i++ {
+		table[i].addCT(&table[i-1], &base, &ecurve)
+	}
+
+	// the bits of |scalar| are read from a fixed-size big-endian buffer, as big.Int.Bit branches on the length of scalar
+	nbBytes := (ecurve.Order.BitLen() + 7) / 8
+	if l := (scalar.BitLen() + 7) / 8; l > nbBytes {
+		nbBytes = l
+	}
+	e := scalar.FillBytes(make([]byte, nbBytes))
+
+	var res, t PointProj
+	res.setInfinity()
+	for i := 0; i < 2*nbBytes; i++ {
+		for j := 0; j < w; j++ {
+			res.doubleCT(&res, &ecurve)
+		}
+		// d is the i-th window of 4 bits, from the most significant one
+		d := uint(e[i/2]>>(w*(1-i%2))) & (1<<w - 1)
+		// t = table[d], scanning the whole table
+		for j := range table {
+			t.X.Select(int(uint(j)^d), &table[j].X, &t.X)
+			t.Y.Select(int(uint(j)^d), &table[j].Y, &t.Y)
+			t.Z.Select(int(uint(j)^d), &table[j].Z, &t.Z)
+		}
+		res.addCT(&res, &t, &ecurve)
+	}
+
+	*p = res
+	return p
+}
+
+// addCT adds points in projective coordinates with constant-time field operations;
+// the formulas are complete, see Add
+func (p *PointProj) addCT(p1, p2 *PointProj, ecurve *CurveParams) *PointProj {
+	var A, B, C, D, E, F, G, H, I, X, Y fr.Element
+	A.MulCT(&p1.Z, &p2.Z)
+	B.MulCT(&A, &A)
+	C.MulCT(&p1.X, &p2.X)
+	D.MulCT(&p1.Y, &p2.Y)
+	E.MulCT(&ecurve.D, &C).MulCT(&E, &D)
+	F.SubCT(&B, &E)
+	G.AddCT(&B, &E)
+	H.AddCT(&p1.X, &p1.Y)
+	I.AddCT(&p2.X, &p2.Y)
+	X.MulCT(&H, &I).
+		SubCT(&X, &C).
+		SubCT(&X, &D).
+		MulCT(&X, &A).
+		MulCT(&X, &F)
+	C.MulCT(&ecurve.A, &C)
+	Y.SubCT(&D, &C).
+		MulCT(&Y, &A).
+		MulCT(&Y, &G)
+	p.Z.MulCT(&F, &G)
+	p.X, p.Y = X, Y
+
+	return p
+}
+
+// doubleCT doubles a point in projective coordinates with constant-time field operations, see Double
+func (p *PointProj) doubleCT(p1 *PointProj, ecurve *CurveParams) *PointProj {
+	var B, C, D, E, F, H, J fr.Element
+	B.AddCT(&p1.X, &p1.Y)
+	B.MulCT(&B, &B)
+	C.MulCT(&p1.X, &p1.X)
+	D.MulCT(&p1.Y, &p1.Y)
+	E.MulCT(&ecurve.A, &C)
+	F.AddCT(&E, &D)
+	H.MulCT(&p1.Z, &p1.Z)
+	J.SubCT(&F, &H).SubCT(&J, &H)
+	p.X.SubCT(&B, &C).
+		SubCT(&p.X, &D).
+		MulCT(&p.X, &J)
+	p.Y.SubCT(&E, &D).MulCT(&p.Y, &F)
+	p.Z.MulCT(&F, &J)
+
+	return p
+}
@@ -304,3 +304,94 @@ TEXT ·Butterfly(SB), $48-16
 	MOVQ R8, 32(AX)
 	MOVQ R9, 40(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), $16-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2          // n == 0, we are done
+	MOVQ  0(AX), SI
+	MOVQ  8(AX), DI
+	MOVQ  16(AX), R8
+	MOVQ  24(AX), R9
+	MOVQ  32(AX), R10
+	MOVQ  40(AX), R11
+	ADDQ  0(DX), SI
+	ADCQ  8(DX), DI
+	ADCQ  16(DX), R8
+	ADCQ  24(DX), R9
+	ADCQ  32(DX), R10
+	ADCQ  40(DX), R11
+
+	// reduce element(SI,DI,R8,R9,R10,R11) using temp registers (R12,R13,R14,R15,s0-8(SP),s1-16(SP))
+	REDUCE(SI,DI,R8,R9,R10,R11,R12,R13,R14,R15,s0-8(SP),s1-16(SP))
+
+	MOVQ SI, 0(CX)
+	MOVQ DI, 8(CX)
+	MOVQ R8, 16(CX)
+	MOVQ R9, 24(CX)
+	MOVQ R10, 32(CX)
+	MOVQ R11, 40(CX)
+
+	// increment pointers to visit next element
+	ADDQ $48, AX
+	ADDQ $48, DX
+	ADDQ $48, CX
+	DECQ BX      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+l3:
+	TESTQ BX, BX
+	JEQ   l5              // n == 0, we are done
+	MOVQ  0(AX), SI
+	MOVQ  8(AX), DI
+	MOVQ  16(AX), R8
+	MOVQ  24(AX), R9
+	MOVQ  32(AX), R10
+	MOVQ  40(AX), R11
+	SUBQ  0(DX), SI
+	SBBQ  8(DX), DI
+	SBBQ  16(DX), R8
+	SBBQ  24(DX), R9
+	SBBQ  32(DX), R10
+	SBBQ  40(DX), R11
+	JCC   l4
+	ADDQ  q<>+0(SB), SI
+	ADCQ  q<>+8(SB), DI
+	ADCQ  q<>+16(SB), R8
+	ADCQ  q<>+24(SB), R9
+	ADCQ  q<>+32(SB), R10
+	ADCQ  q<>+40(SB), R11
+
+l4:
+	MOVQ SI, 0(CX)
+	MOVQ DI, 8(CX)
+	MOVQ R8, 16(CX)
+	MOVQ R9, 24(CX)
+	MOVQ R10, 32(CX)
+	MOVQ R11, 40(CX)
+
+	// increment pointers to visit next element
+	ADDQ $48, AX
+	ADDQ $48, DX
+	ADDQ $48, CX
+	DECQ BX      // decrement n
+	JMP  l3
+
+l5:
+	RET
@@ -110,6 +110,18 @@ func BenchmarkElementInverse(b *testing.B) {
 
 }
 
+func BenchmarkElementInverseCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchResElement.InverseCT(&x)
+	}
+
+}
+
 func BenchmarkElementButterfly(b *testing.B) {
 	var x Element
 	x.SetRandom()
@@ -131,6 +143,17 @@ func BenchmarkElementExp(b *testing.B) {
 	}
 }
 
+func BenchmarkElementExpCT(b *testing.B) {
+	var x Element
+	x.SetRandom()
+	benchResElement.SetRandom()
+	b1, _ := rand.Int(rand.Reader, Modulus())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.ExpCT(x, b1)
+	}
+}
+
 func BenchmarkElementDouble(b *testing.B) {
 	benchResElement.SetRandom()
 	b.ResetTimer()
@@ -203,6 +226,16 @@ func BenchmarkElementSqrt(b *testing.B) {
 	}
 }
 
+func BenchmarkElementSqrtCT(b *testing.B) {
+	var a Element
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchResElement.SqrtCT(&a)
+	}
+}
+
 func BenchmarkElementMul(b *testing.B) {
 	x := Element{
 		14526898881837571181,
@@ -1059,7 +1092,7 @@ func TestElementMul(t *testing.T) {
 
 }
 
-func TestElementDiv(t *testing.T) {
+func TestElementAddCT(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
@@ -1073,14 +1106,14 @@ func TestElementDiv(t *testing.T) {
 	genA := gen()
 	genB := gen()
 
-	properties.Property("Div: having the receiver as operand should output the same result", prop.ForAll(
+	properties.Property("AddCT: having the receiver as operand should output the same result", prop.ForAll(
 		func(a, b testPairElement) bool {
 			var c, d Element
 			d.Set(&a.element)
 
-			c.Div(&a.element, &b.element)
-			a.element.Div(&a.element, &b.element)
-			b.element.Div(&d, &b.element)
+			c.AddCT(&a.element, &b.element)
+			a.element.AddCT(&a.element, &b.element)
+			b.element.AddCT(&d, &b.element)
 
 			return a.element.Equal(&b.element) && a.element.Equal(&c) && b.element.Equal(&c)
 		},
@@ -1088,16 +1121,15 @@ func TestElementDiv(t *testing.T) {
 		genB,
 	))
 
-	properties.Property("Div: operation result must match big.Int result", prop.ForAll(
+	properties.Property("AddCT: operation result must match big.Int result", prop.ForAll(
 		func(a, b testPairElement) bool {
 			{
 				var c Element
 
-				c.Div(&a.element, &b.element)
+				c.AddCT(&a.element, &b.element)
 
 				var d, e big.Int
-				d.ModInverse(&b.bigint, Modulus())
-				d.Mul(&d, &a.bigint).Mod(&d, Modulus())
+				d.Add(&a.bigint, &b.bigint).Mod(&d, Modulus())
 
 				if c.BigInt(&e).Cmp(&d) != 0 {
 					return false
@@ -1115,9 +1147,8 @@ func TestElementDiv(t *testing.T) {
 				r.BigInt(&rb)
 
 				var c Element
-				c.Div(&a.element, &r)
-				d.ModInverse(&rb, Modulus())
-				d.Mul(&d, &a.bigint).Mod(&d, Modulus())
+				c.AddCT(&a.element, &r)
+				d.Add(&a.bigint, &rb).Mod(&d, Modulus())
 
 				if c.BigInt(&e).Cmp(&d) != 0 {
 					return false
@@ -1129,11 +1160,11 @@ func TestElementDiv(t *testing.T) {
 		genB,
 	))
 
-	properties.Property("Div: operation result must be smaller than modulus", prop.ForAll(
+	properties.Property("AddCT: operation result must be smaller than modulus", prop.ForAll(
 		func(a, b testPairElement) bool {
 			var c Element
 
-			c.Div(&a.element, &b.element)
+			c.AddCT(&a.element, &b.element)
 
 			return c.smallerThanModulus()
 		},
@@ -1155,12 +1186,11 @@ func TestElementDiv(t *testing.T) {
 				b.BigInt(&bBig)
 
 				var c Element
-				c.Div(&a, &b)
-				d.ModInverse(&bBig, Modulus())
-				d.Mul(&d, &aBig).Mod(&d, Modulus())
+				c.AddCT(&a, &b)
+				d.Add(&aBig, &bBig).Mod(&d, Modulus())
 
 				if c.BigInt(&e).Cmp(&d) != 0 {
-					t.Fatal("Div failed special test values")
+					t.Fatal("AddCT failed special test values")
 				}
 			}
 		}
@@ -1171,7 +1201,7 @@ func TestElementDiv(t *testing.T) {
 
 }
 
-func TestElementExp(t *testing.T) {
+func TestElementSubCT(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
@@ -1185,14 +1215,14 @@ func TestElementExp(t *testing.T) {
 	genA := gen()
 	genB := gen()
 
-	properties.Property("Exp: having the receiver as operand should output the same result", prop.ForAll(
+	properties.Property("SubCT: having the receiver as operand should output the same result", prop.ForAll(
 		func(a, b testPairElement) bool {
 			var c, d Element
 			d.Set(&a.element)
 
-			c.Exp(a.element, &b.bigint)
-			a.element.Exp(a.element, &b.bigint)
-			b.element.Exp(d, &b.bigint)
+			c.SubCT(&a.element, &b.element)
+			a.element.SubCT(&a.element, &b.element)
+			b.element.SubCT(&d, &b.element)
 
 			return a.element.Equal(&b.element) && a.element.Equal(&c) && b.element.Equal(&c)
 		},
@@ -1200,15 +1230,15 @@ func TestElementExp(t *testing.T) {
 		genB,
 	))
 
-	properties.Property("Exp: operation result must match big.Int result", prop.ForAll(
+	properties.Property("SubCT: operation result must match big.Int result", prop.ForAll(
 		func(a, b testPairElement) bool {
 			{
 				var c Element
 
-				c.Exp(a.element, &b.bigint)
+				c.SubCT(&a.element, &b.element)
 
 				var d, e big.Int
-				d.Exp(&a.bigint, &b.bigint, Modulus())
+				d.Sub(&a.bigint, &b.bigint).Mod(&d, Modulus())
 
 				if c.BigInt(&e).Cmp(&d) != 0 {
 					return false
@@ -1226,8 +1256,8 @@ func TestElementExp(t *testing.T) {
 				r.BigInt(&rb)
 
 				var c Element
-				c.Exp(a.element, &rb)
-				d.Exp(&a.bigint, &rb, Modulus())
+				c.SubCT(&a.element, &r)
+				d.Sub(&a.bigint, &rb).Mod(&d, Modulus())
 
 				if c.BigInt(&e).Cmp(&d) != 0 {
 					return false
@@ -1239,11 +1269,11 @@ func TestElementExp(t *testing.T) {
 		genB,
 	))
 
-	properties.Property("Exp: operation result must be smaller than modulus", prop.ForAll(
+	properties.Property("SubCT: operation result must be smaller than modulus", prop.ForAll(
 		func(a, b testPairElement) bool {
 			var c Element
 
-			c.Exp(a.element, &b.bigint)
+			c.SubCT(&a.element, &b.element)
 
 			return c.smallerThanModulus()
 		},
@@ -1265,11 +1295,11 @@ func TestElementExp(t *testing.T) {
 				b.BigInt(&bBig)
 
 				var c Element
-				c.Exp(a, &bBig)
-				d.Exp(&aBig, &bBig, Modulus())
+				c.SubCT(&a, &b)
+				d.Sub(&aBig, &bBig).Mod(&d, Modulus())
 
 				if c.BigInt(&e).Cmp(&d) != 0 {
-					t.Fatal("Exp failed special test values")
+					t.Fatal("SubCT failed special test values")
 				}
 			}
 		}
@@ -1280,7 +1310,7 @@ func TestElementExp(t *testing.T) {
 
 }
 
-func TestElementSquare(t *testing.T) {
+func TestElementMulCT(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
@@ -1292,57 +1322,120 @@ func TestElementSquare(t *testing.T) {
 	properties := gopter.NewProperties(parameters)
 
 	genA := gen()
+	genB := gen()
 
-	properties.Property("Square: having the receiver as operand should output the same result", prop.ForAll(
-		func(a testPairElement) bool {
+	properties.Property("MulCT: having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			d.Set(&a.element)
 
-			var b Element
+			c.MulCT(&a.element, &b.element)
+			a.element.MulCT(&a.element, &b.element)
+			b.element.MulCT(&d, &b.element)
 
-			b.Square(&a.element)
-			a.element.Square(&a.element)
-			return a.element.Equal(&b)
+			return a.element.Equal(&b.element) && a.element.Equal(&c) && b.element.Equal(&c)
 		},
 		genA,
+		genB,
 	))
 
-	properties.Property("Square: operation result must match big.Int result", prop.ForAll(
-		func(a testPairElement) bool {
-			var c Element
-			c.Square(&a.element)
+	properties.Property("MulCT: operation result must match big.Int result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			{
+				var c Element
 
-			var d, e big.Int
-			d.Mul(&a.bigint, &a.bigint).Mod(&d, Modulus())
+				c.MulCT(&a.element, &b.element)
 
-			return c.BigInt(&e).Cmp(&d) == 0
+				var d, e big.Int
+				d.Mul(&a.bigint, &b.bigint).Mod(&d, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+
+			// fixed elements
+			// a is random
+			// r takes special values
+			testValues := make([]Element, len(staticTestValues))
+			copy(testValues, staticTestValues)
+
+			for _, r := range testValues {
+				var d, e, rb big.Int
+				r.BigInt(&rb)
+
+				var c Element
+				c.MulCT(&a.element, &r)
+				d.Mul(&a.bigint, &rb).Mod(&d, Modulus())
+
+				// checking generic impl against asm path
+				var cGeneric Element
+				_mulGeneric(&cGeneric, &a.element, &r)
+				if !cGeneric.Equal(&c) {
+					// need to give context to failing error.
+					return false
+				}
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+			return true
 		},
 		genA,
+		genB,
 	))
 
-	properties.Property("Square: operation result must be smaller than modulus", prop.ForAll(
-		func(a testPairElement) bool {
+	properties.Property("MulCT: operation result must be smaller than modulus", prop.ForAll(
+		func(a, b testPairElement) bool {
 			var c Element
-			c.Square(&a.element)
+
+			c.MulCT(&a.element, &b.element)
+
 			return c.smallerThanModulus()
 		},
 		genA,
+		genB,
+	))
+
+	properties.Property("MulCT: assembly implementation must be consistent with generic one", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			c.MulCT(&a.element, &b.element)
+			_mulGeneric(&d, &a.element, &b.element)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
 	))
 
 	specialValueTest := func() {
-		// test special values
+		// test special values against special values
 		testValues := make([]Element, len(staticTestValues))
 		copy(testValues, staticTestValues)
 
 		for _, a := range testValues {
 			var aBig big.Int
 			a.BigInt(&aBig)
-			var c Element
-			c.Square(&a)
+			for _, b := range testValues {
 
-			var d, e big.Int
-			d.Mul(&aBig, &aBig).Mod(&d, Modulus())
+				var bBig, d, e big.Int
+				b.BigInt(&bBig)
 
-			if c.BigInt(&e).Cmp(&d) != 0 {
-				t.Fatal("Square failed special test values")
+				var c Element
+				c.MulCT(&a, &b)
+				d.Mul(&aBig, &bBig).Mod(&d, Modulus())
+
+				// checking asm against generic impl
+				var cGeneric Element
+				_mulGeneric(&cGeneric, &a, &b)
+				if !cGeneric.Equal(&c) {
+					t.Fatal("MulCT failed special test values: asm and generic impl don't match")
+				}
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					t.Fatal("MulCT failed special test values")
+				}
 			}
 		}
 	}
@@ -1352,7 +1445,7 @@ func TestElementSquare(t *testing.T) {
 
 }
 
-func TestElementInverse(t *testing.T) {
+func TestElementDiv(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
@@ -1364,57 +1457,97 @@ func TestElementInverse(t *testing.T) {
 	properties := gopter.NewProperties(parameters)
 
 	genA := gen()
+	genB := gen()
 
-	properties.Property("Inverse: having the receiver as operand should output the same result", prop.ForAll(
-		func(a testPairElement) bool {
+	properties.Property("Div: having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			d.Set(&a.element)
 
-			var b Element
+			c.Div(&a.element, &b.element)
+			a.element.Div(&a.element, &b.element)
+			b.element.Div(&d, &b.element)
 
-			b.Inverse(&a.element)
-			a.element.Inverse(&a.element)
-			return a.element.Equal(&b)
+			return a.element.Equal(&b.element) && a.element.Equal(&c) && b.element.Equal(&c)
 		},
 		genA,
+		genB,
 	))
 
-	properties.Property("Inverse: operation result must match big.Int result", prop.ForAll(
-		func(a testPairElement) bool {
-			var c Element
-			c.Inverse(&a.element)
+	properties.Property("Div: operation result must match big.Int result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			{
+				var c Element
 
-			var d, e big.Int
-			d.ModInverse(&a.bigint, Modulus())
+				c.Div(&a.element, &b.element)
 
-			return c.BigInt(&e).Cmp(&d) == 0
+				var d, e big.Int
+				d.ModInverse(&b.bigint, Modulus())
+				d.Mul(&d, &a.bigint).Mod(&d, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+
+			// fixed elements
+			// a is random
+			// r takes special values
+			testValues := make([]Element, len(staticTestValues))
+			copy(testValues, staticTestValues)
+
+			for _, r := range testValues {
+				var d, e, rb big.Int
+				r.BigInt(&rb)
+
+				var c Element
+				c.Div(&a.element, &r)
+				d.ModInverse(&rb, Modulus())
+				d.Mul(&d, &a.bigint).Mod(&d, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+			return true
 		},
 		genA,
+		genB,
 	))
 
-	properties.Property("Inverse: operation result must be smaller than modulus", prop.ForAll(
-		func(a testPairElement) bool {
+	properties.Property("Div: operation result must be smaller than modulus", prop.ForAll(
+		func(a, b testPairElement) bool {
 			var c Element
-			c.Inverse(&a.element)
+
+			c.Div(&a.element, &b.element)
+
 			return c.smallerThanModulus()
 		},
 		genA,
+		genB,
 	))
 
 	specialValueTest := func() {
-		// test special values
+		// test special values against special values
 		testValues := make([]Element, len(staticTestValues))
 		copy(testValues, staticTestValues)
 
 		for _, a := range testValues {
 			var aBig big.Int
 			a.BigInt(&aBig)
-			var c Element
-			c.Inverse(&a)
+			for _, b := range testValues {
 
-			var d, e big.Int
-			d.ModInverse(&aBig, Modulus())
+				var bBig, d, e big.Int
+				b.BigInt(&bBig)
 
-			if c.BigInt(&e).Cmp(&d) != 0 {
-				t.Fatal("Inverse failed special test values")
+				var c Element
+				c.Div(&a, &b)
+				d.ModInverse(&bBig, Modulus())
+				d.Mul(&d, &aBig).Mod(&d, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					t.Fatal("Div failed special test values")
+				}
 			}
 		}
 	}
@@ -1424,7 +1557,7 @@ func TestElementInverse(t *testing.T) {
 
 }
 
-func TestElementSqrt(t *testing.T) {
+func TestElementExp(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
 	if testing.Short() {
@@ -1436,10 +1569,444 @@ func TestElementSqrt(t *testing.T) {
 	properties := gopter.NewProperties(parameters)
 
 	genA := gen()
+	genB := gen()
+
+	properties.Property("Exp: having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			d.Set(&a.element)
+
+			c.Exp(a.element, &b.bigint)
+			a.element.Exp(a.element, &b.bigint)
+			b.element.Exp(d, &b.bigint)
+
+			return a.element.Equal(&b.element) && a.element.Equal(&c) && b.element.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("Exp: operation result must match big.Int result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			{
+				var c Element
+
+				c.Exp(a.element, &b.bigint)
+
+				var d, e big.Int
+				d.Exp(&a.bigint, &b.bigint, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+
+			// fixed elements
+			// a is random
+			// r takes special values
+			testValues := make([]Element, len(staticTestValues))
+			copy(testValues, staticTestValues)
+
+			for _, r := range testValues {
+				var d, e, rb big.Int
+				r.BigInt(&rb)
+
+				var c Element
+				c.Exp(a.element, &rb)
+				d.Exp(&a.bigint, &rb, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("Exp: operation result must be smaller than modulus", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c Element
+
+			c.Exp(a.element, &b.bigint)
+
+			return c.smallerThanModulus()
+		},
+		genA,
+		genB,
+	))
+
+	specialValueTest := func() {
+		// test special values against special values
+		testValues := make([]Element, len(staticTestValues))
+		copy(testValues, staticTestValues)
+
+		for _, a := range testValues {
+			var aBig big.Int
+			a.BigInt(&aBig)
+			for _, b := range testValues {
+
+				var bBig, d, e big.Int
+				b.BigInt(&bBig)
+
+				var c Element
+				c.Exp(a, &bBig)
+				d.Exp(&aBig, &bBig, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					t.Fatal("Exp failed special test values")
+				}
+			}
+		}
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	specialValueTest()
+
+}
+
+func TestElementExpCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+	genB := gen()
+
+	properties.Property("ExpCT: having the receiver as operand should output the same result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c, d Element
+			d.Set(&a.element)
+
+			c.ExpCT(a.element, &b.bigint)
+			a.element.ExpCT(a.element, &b.bigint)
+			b.element.ExpCT(d, &b.bigint)
+
+			return a.element.Equal(&b.element) && a.element.Equal(&c) && b.element.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("ExpCT: operation result must match big.Int result", prop.ForAll(
+		func(a, b testPairElement) bool {
+			{
+				var c Element
+
+				c.ExpCT(a.element, &b.bigint)
+
+				var d, e big.Int
+				d.Exp(&a.bigint, &b.bigint, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+
+			// fixed elements
+			// a is random
+			// r takes special values
+			testValues := make([]Element, len(staticTestValues))
+			copy(testValues, staticTestValues)
+
+			for _, r := range testValues {
+				var d, e, rb big.Int
+				r.BigInt(&rb)
+
+				var c Element
+				c.ExpCT(a.element, &rb)
+				d.Exp(&a.bigint, &rb, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("ExpCT: operation result must be smaller than modulus", prop.ForAll(
+		func(a, b testPairElement) bool {
+			var c Element
+
+			c.ExpCT(a.element, &b.bigint)
+
+			return c.smallerThanModulus()
+		},
+		genA,
+		genB,
+	))
+
+	specialValueTest := func() {
+		// test special values against special values
+		testValues := make([]Element, len(staticTestValues))
+		copy(testValues, staticTestValues)
+
+		for _, a := range testValues {
+			var aBig big.Int
+			a.BigInt(&aBig)
+			for _, b := range testValues {
+
+				var bBig, d, e big.Int
+				b.BigInt(&bBig)
+
+				var c Element
+				c.ExpCT(a, &bBig)
+				d.Exp(&aBig, &bBig, Modulus())
+
+				if c.BigInt(&e).Cmp(&d) != 0 {
+					t.Fatal("ExpCT failed special test values")
+				}
+			}
+		}
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	specialValueTest()
+
+}
+
+func TestElementSquare(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("Square: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPairElement) bool {
+
+			var b Element
+
+			b.Square(&a.element)
+			a.element.Square(&a.element)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("Square: operation result must match big.Int result", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.Square(&a.element)
+
+			var d, e big.Int
+			d.Mul(&a.bigint, &a.bigint).Mod(&d, Modulus())
+
+			return c.BigInt(&e).Cmp(&d) == 0
+		},
+		genA,
+	))
+
+	properties.Property("Square: operation result must be smaller than modulus", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.Square(&a.element)
+			return c.smallerThanModulus()
+		},
+		genA,
+	))
+
+	specialValueTest := func() {
+		// test special values
+		testValues := make([]Element, len(staticTestValues))
+		copy(testValues, staticTestValues)
+
+		for _, a := range testValues {
+			var aBig big.Int
+			a.BigInt(&aBig)
+			var c Element
+			c.Square(&a)
+
+			var d, e big.Int
+			d.Mul(&aBig, &aBig).Mod(&d, Modulus())
+
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				t.Fatal("Square failed special test values")
+			}
+		}
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	specialValueTest()
+
+}
+
+func TestElementInverse(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("Inverse: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPairElement) bool {
+
+			var b Element
+
+			b.Inverse(&a.element)
+			a.element.Inverse(&a.element)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("Inverse: operation result must match big.Int result", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.Inverse(&a.element)
+
+			var d, e big.Int
+			d.ModInverse(&a.bigint, Modulus())
+
+			return c.BigInt(&e).Cmp(&d) == 0
+		},
+		genA,
+	))
+
+	properties.Property("Inverse: operation result must be smaller than modulus", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.Inverse(&a.element)
+			return c.smallerThanModulus()
+		},
+		genA,
+	))
+
+	specialValueTest := func() {
+		// test special values
+		testValues := make([]Element, len(staticTestValues))
+		copy(testValues, staticTestValues)
+
+		for _, a := range testValues {
+			var aBig big.Int
+			a.BigInt(&aBig)
+			var c Element
+			c.Inverse(&a)
+
+			var d, e big.Int
+			d.ModInverse(&aBig, Modulus())
+
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				t.Fatal("Inverse failed special test values")
+			}
+		}
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	specialValueTest()
+
+}
+
+func TestElementInverseCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("InverseCT: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPairElement) bool {
+
+			var b Element
+
+			b.InverseCT(&a.element)
+			a.element.InverseCT(&a.element)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("InverseCT: operation result must match big.Int result", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.InverseCT(&a.element)
+
+			var d, e big.Int
+			d.ModInverse(&a.bigint, Modulus())
+
+			return c.BigInt(&e).Cmp(&d) == 0
+		},
+		genA,
+	))
+
+	properties.Property("InverseCT: operation result must be smaller than modulus", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.InverseCT(&a.element)
+			return c.smallerThanModulus()
+		},
+		genA,
+	))
+
+	specialValueTest := func() {
+		// test special values
+		testValues := make([]Element, len(staticTestValues))
+		copy(testValues, staticTestValues)
+
+		for _, a := range testValues {
+			var aBig big.Int
+			a.BigInt(&aBig)
+			var c Element
+			c.InverseCT(&a)
+
+			var d, e big.Int
+			d.ModInverse(&aBig, Modulus())
+
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				t.Fatal("InverseCT failed special test values")
+			}
+		}
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	specialValueTest()
+
+}
+
+func TestElementSqrt(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("Sqrt: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPairElement) bool {
 
-	properties.Property("Sqrt: having the receiver as operand should output the same result", prop.ForAll(
-		func(a testPairElement) bool {
-
 			b := a.element
 
 			b.Sqrt(&a.element)
@@ -1496,6 +2063,86 @@ func TestElementSqrt(t *testing.T) {
 
 }
 
+func TestElementSqrtCT(t *testing.T) {
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen()
+
+	properties.Property("SqrtCT: having the receiver as operand should output the same result", prop.ForAll(
+		func(a testPairElement) bool {
+
+			b := a.element
+
+			b.SqrtCT(&a.element)
+			a.element.SqrtCT(&a.element)
+			return a.element.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT: operation result must match big.Int result", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.SqrtCT(&a.element)
+
+			var d, e big.Int
+			d.ModSqrt(&a.bigint, Modulus())
+			// the root may differ from the one of big.Int by its sign
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				c.Neg(&c)
+			}
+
+			return c.BigInt(&e).Cmp(&d) == 0
+		},
+		genA,
+	))
+
+	properties.Property("SqrtCT: operation result must be smaller than modulus", prop.ForAll(
+		func(a testPairElement) bool {
+			var c Element
+			c.SqrtCT(&a.element)
+			return c.smallerThanModulus()
+		},
+		genA,
+	))
+
+	specialValueTest := func() {
+		// test special values
+		testValues := make([]Element, len(staticTestValues))
+		copy(testValues, staticTestValues)
+
+		for _, a := range testValues {
+			var aBig big.Int
+			a.BigInt(&aBig)
+			var c Element
+			c.SqrtCT(&a)
+
+			var d, e big.Int
+			d.ModSqrt(&aBig, Modulus())
+			// the root may differ from the one of big.Int by its sign
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				c.Neg(&c)
+			}
+
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				t.Fatal("SqrtCT failed special test values")
+			}
+		}
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+	specialValueTest()
+
+}
+
 func TestElementDouble(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -0,0 +1,132 @@
+package peerdas
+
+import (
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+// BlobToKZGCommitment returns the KZG commitment [p(τ)]₁ to the polynomial p of blob.
+func (ctx *Context) BlobToKZGCommitment(blob *Blob) (kzg.Digest, error) {
+	return kzg.Commit(ctx.coefficients(blob[:]), ctx.pk)
+}
+
+// ComputeCells returns the CellsPerExtBlob cells of the extension of blob.
+func (ctx *Context) ComputeCells(blob *Blob) ([]Cell, error) {
+	extended, err := ctx.code.Encode(blob[:])
+	if err != nil {
+		return nil, err
+	}
+	return toCells(extended), nil
+}
+
+// ComputeCellsAndKZGProofs returns the CellsPerExtBlob cells of the extension of blob and their proofs.
+func (ctx *Context) ComputeCellsAndKZGProofs(blob *Blob) ([]Cell, []kzg.Digest, error) {
+	extended, err := ctx.code.Encode(blob[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return toCells(extended), ctx.computeProofs(ctx.coefficients(blob[:])), nil
+}
+
+// RecoverCellsAndKZGProofs returns all the cells of an extended blob and their proofs, from at least
+// half of its cells, cells[k] being the cell of index cellIndices[k]. As in the specification,
+// the cell indices must be in strictly increasing order.
+// It returns an error if the cells don't belong to the same extended blob.
+func (ctx *Context) RecoverCellsAndKZGProofs(cellIndices []uint64, cells []Cell) ([]Cell, []kzg.Digest, error) {
+	if len(cellIndices) != len(cells) {
+		return nil, nil, ErrNbCells
+	}
+	if len(cells) < CellsPerExtBlob/2 || len(cells) > CellsPerExtBlob {
+		return nil, nil, ErrRecoveryNbCells
+	}
+
+	word := make([]fr.Element, FieldElementsPerExtBlob)
+	erased := make([]bool, FieldElementsPerExtBlob)
+	for i := range erased {
+		erased[i] = true
+	}
+	for k, index := range cellIndices {
+		if index >= CellsPerExtBlob {
+			return nil, nil, ErrCellIndex
+		}
+		if k > 0 && index <= cellIndices[k-1] {
+			return nil, nil, ErrCellIndicesOrder
+		}
+		offset := int(index) * FieldElementsPerCell
+		copy(word[offset:], cells[k][:])
+		for j := 0; j < FieldElementsPerCell; j++ {
+			erased[offset+j] = false
+		}
+	}
+
+	extended, err := ctx.code.RecoverErasures(word, erased)
+	if err != nil {
+		return nil, nil, err
+	}
+	return toCells(extended), ctx.computeProofs(ctx.coefficients(extended[:FieldElementsPerBlob])), nil
+}
+
+// coefficients returns the coefficients of the polynomial of a blob
+func (ctx *Context) coefficients(blob []fr.Element) []fr.Element {
+	p := make([]fr.Element, FieldElementsPerBlob)
+	copy(p, blob)
+	ctx.blobDomain.FFTInverse(p, fft.DIT)
+	return p
+}
+
+func toCells(extended []fr.Element) []Cell {
+	cells := make([]Cell, CellsPerExtBlob)
+	for i := range cells {
+		copy(cells[i][:], extended[i*FieldElementsPerCell:])
+	}
+	return cells
+}
+
+// computeProofs returns the proofs of the cells of the polynomial p = Σ cⱼ⋅Xʲ, with the FK20 algorithm.
+//
+// Writing ℓ = FieldElementsPerCell, m = FieldElementsPerBlob/ℓ and p = Σ Pᵢ⋅X^(iℓ) with deg(Pᵢ) < ℓ,
+// the quotient of p by X^ℓ - y is Σ_{s<m-1} yˢ⋅Σ_{i>s} Pᵢ⋅X^((i-1-s)ℓ), so that the proof of the cell of
+// shift h is Σₛ (h^ℓ)ˢ⋅Hₛ with Hₛ = Σ_{r<ℓ} Σ_{i>s} c_(iℓ+r)⋅[τ^((i-1-s)ℓ+r)]₁. The h^ℓ are the roots of unity
+// of order CellsPerExtBlob in bit-reversed order, hence the proofs are the FFT of the Hₛ.
+// For each r, (Hₛ)ₛ is a Toeplitz matrix-vector product, computed as a convolution of size 2m with FFTs.
+func (ctx *Context) computeProofs(p []fr.Element) []kzg.Digest {
+	const m = FieldElementsPerBlob / FieldElementsPerCell
+
+	// FFTs of the reversed columns (c_((m-1-i)ℓ+r))ᵢ; scalars[k][r] is the k-th element of the r-th one
+	scalars := make([][]fr.Element, 2*m)
+	for k := range scalars {
+		scalars[k] = make([]fr.Element, FieldElementsPerCell)
+	}
+	column := make([]fr.Element, 2*m)
+	for r := 0; r < FieldElementsPerCell; r++ {
+		for i := 0; i < m; i++ {
+			column[i] = p[(m-1-i)*FieldElementsPerCell+r]
+		}
+		for i := m; i < 2*m; i++ {
+			column[i].SetZero()
+		}
+		ctx.proofDomain.FFT(column, fft.DIF)
+		for k := range column {
+			scalars[k][r] = column[k]
+		}
+	}
+
+	// the convolutions, summed over r
+	conv := make([]bls12381.G1Affine, 2*m)
+	for k := range conv {
+		conv[k].MultiExp(ctx.fk20[k], scalars[k], ecc.MultiExpConfig{})
+	}
+	ctx.proofDomain.FFTInverseG1(conv, fft.DIT)
+
+	// Hₛ = conv[m-2-s]
+	proofs := make([]bls12381.G1Affine, CellsPerExtBlob)
+	for s := 0; s < m-1; s++ {
+		proofs[s] = conv[m-2-s]
+	}
+	ctx.proofDomain.FFTG1(proofs, fft.DIF)
+
+	return proofs
+}
@@ -0,0 +1,14 @@
+// Package peerdas implements the cell API of EIP-7594 (PeerDAS) for the data availability sampling
+// of Ethereum, following the polynomial-commitments-sampling specification of the consensus specs.
+//
+// A blob of FieldElementsPerBlob elements, the evaluations of a polynomial p of degree < 4096 in
+// bit-reversed order, is extended to FieldElementsPerExtBlob evaluations with a Reed-Solomon code,
+// and split into CellsPerExtBlob cells of FieldElementsPerCell evaluations each: the cell i lists the
+// evaluations of p on the coset hᵢ⋅H of the subgroup H of order FieldElementsPerCell.
+//
+// Each cell comes with a KZG proof that its evaluations are those of the committed polynomial,
+// the commitment to (p - Iᵢ)/(X^FieldElementsPerCell - hᵢ^FieldElementsPerCell), Iᵢ being the
+// interpolation of the cell. The proofs of all cells are computed at once with the FK20 algorithm.
+//
+// See https://github.com/ethereum/consensus-specs/blob/dev/specs/fulu/polynomial-commitments-sampling.md
+package peerdas
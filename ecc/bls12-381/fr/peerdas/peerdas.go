@@ -0,0 +1,154 @@
+package peerdas
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/reedsolomon"
+)
+
+const (
+	FieldElementsPerBlob    = 4096
+	FieldElementsPerExtBlob = 2 * FieldElementsPerBlob
+	FieldElementsPerCell    = 64
+	CellsPerExtBlob         = FieldElementsPerExtBlob / FieldElementsPerCell
+	BytesPerBlob            = FieldElementsPerBlob * fr.Bytes
+	BytesPerCell            = FieldElementsPerCell * fr.Bytes
+)
+
+var (
+	ErrSetupSize            = errors.New("the setup should have FieldElementsPerBlob points in G1 and FieldElementsPerCell+1 points in G2")
+	ErrBlobSize             = errors.New("a blob should have BytesPerBlob bytes")
+	ErrCellSize             = errors.New("a cell should have BytesPerCell bytes")
+	ErrCellIndex            = errors.New("cell index out of range")
+	ErrNbCells              = errors.New("the numbers of commitments, cell indices, cells and proofs should match")
+	ErrRecoveryNbCells      = errors.New("the recovery needs at least half of the cells")
+	ErrCellIndicesOrder     = errors.New("the cell indices should be in strictly increasing order")
+	ErrVerifyCellProofBatch = errors.New("can't verify cell proof batch")
+)
+
+// Blob is a blob, the evaluations in bit-reversed order of a polynomial of degree < FieldElementsPerBlob
+// on the subgroup of order FieldElementsPerBlob
+type Blob [FieldElementsPerBlob]fr.Element
+
+// Cell is a cell of an extended blob, the evaluations of its polynomial on a coset
+type Cell [FieldElementsPerCell]fr.Element
+
+// SetBytes sets b from the BytesPerBlob bytes of its big-endian canonical field elements.
+func (b *Blob) SetBytes(buf []byte) error {
+	if len(buf) != BytesPerBlob {
+		return ErrBlobSize
+	}
+	return setElements(b[:], buf)
+}
+
+// Bytes returns the big-endian encoding of the field elements of b.
+func (b *Blob) Bytes() []byte {
+	return elementsBytes(b[:])
+}
+
+// SetBytes sets c from the BytesPerCell bytes of its big-endian canonical field elements.
+func (c *Cell) SetBytes(buf []byte) error {
+	if len(buf) != BytesPerCell {
+		return ErrCellSize
+	}
+	return setElements(c[:], buf)
+}
+
+// Bytes returns the big-endian encoding of the field elements of c.
+func (c *Cell) Bytes() []byte {
+	return elementsBytes(c[:])
+}
+
+func setElements(e []fr.Element, buf []byte) error {
+	for i := range e {
+		if err := e[i].SetBytesCanonical(buf[i*fr.Bytes : (i+1)*fr.Bytes]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func elementsBytes(e []fr.Element) []byte {
+	res := make([]byte, 0, len(e)*fr.Bytes)
+	for i := range e {
+		b := e[i].Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+// Context holds the trusted setup and the precomputations for the cells and their proofs.
+type Context struct {
+	// [τⁱ]₁ for i < FieldElementsPerBlob
+	pk kzg.ProvingKey
+
+	// [1]₂ and [τ^FieldElementsPerCell]₂
+	g2 [2]bls12381.G2Affine
+
+	// extension of the blobs
+	code *reedsolomon.Code
+
+	// the subgroups of order FieldElementsPerBlob, FieldElementsPerCell and CellsPerExtBlob
+	blobDomain, cellDomain, proofDomain *fft.Domain
+
+	// cosetShifts[i] = hᵢ, the shift of the coset of the cell i, and cosetShiftsInv[i] = 1/hᵢ
+	cosetShifts, cosetShiftsInv [CellsPerExtBlob]fr.Element
+
+	// fk20[k][r] is the k-th element of the FFT of ([τ^(u⋅FieldElementsPerCell+r)]₁)ᵤ, see computeProofs
+	fk20 [][]bls12381.G1Affine
+}
+
+// NewContext returns the context of the trusted setup ([τⁱ]₁)_{i<FieldElementsPerBlob} and
+// ([τⁱ]₂)_{i⩽FieldElementsPerCell}, the monomial points of Ethereum's KZG ceremony.
+func NewContext(g1 []bls12381.G1Affine, g2 []bls12381.G2Affine) (*Context, error) {
+	if len(g1) != FieldElementsPerBlob || len(g2) < FieldElementsPerCell+1 {
+		return nil, ErrSetupSize
+	}
+	ctx := &Context{
+		pk:          kzg.ProvingKey{G1: g1},
+		g2:          [2]bls12381.G2Affine{g2[0], g2[FieldElementsPerCell]},
+		code:        reedsolomon.NewCode(FieldElementsPerBlob, FieldElementsPerExtBlob/FieldElementsPerBlob),
+		blobDomain:  fft.NewDomain(FieldElementsPerBlob),
+		cellDomain:  fft.NewDomain(FieldElementsPerCell),
+		proofDomain: fft.NewDomain(CellsPerExtBlob),
+	}
+
+	// hᵢ = ω^i', ω being the generator of the subgroup of order FieldElementsPerExtBlob and i' the
+	// bit-reversal of i
+	omega := fft.NewDomain(FieldElementsPerExtBlob).Generator
+	nn := 64 - bits.TrailingZeros(CellsPerExtBlob)
+	for i := range ctx.cosetShifts {
+		e := big.NewInt(int64(bits.Reverse64(uint64(i)) >> nn))
+		ctx.cosetShifts[i].Exp(omega, e)
+	}
+	copy(ctx.cosetShiftsInv[:], fr.BatchInvert(ctx.cosetShifts[:]))
+
+	// FFTs of the columns of the Toeplitz matrices of computeProofs, on the subgroup of order
+	// 2⋅m = CellsPerExtBlob
+	const m = FieldElementsPerBlob / FieldElementsPerCell
+	ctx.fk20 = make([][]bls12381.G1Affine, 2*m)
+	for k := range ctx.fk20 {
+		ctx.fk20[k] = make([]bls12381.G1Affine, FieldElementsPerCell)
+	}
+	column := make([]bls12381.G1Affine, 2*m)
+	for r := 0; r < FieldElementsPerCell; r++ {
+		for u := range column {
+			column[u] = bls12381.G1Affine{}
+		}
+		for u := 0; u < m-1; u++ {
+			column[u] = g1[u*FieldElementsPerCell+r]
+		}
+		ctx.proofDomain.FFTG1(column, fft.DIF)
+		for k := range column {
+			ctx.fk20[k][r] = column[k]
+		}
+	}
+
+	return ctx, nil
+}
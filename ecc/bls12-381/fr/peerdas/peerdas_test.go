@@ -0,0 +1,323 @@
+package peerdas
+
+import (
+	"math/big"
+	"math/bits"
+	"math/rand"
+	"sort"
+	"sync"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+// testTau is the secret of the test setup, with which the tests of this file supplement the reference
+// tests of the consensus specs (see vectors_test.go)
+var testTau = big.NewInt(42)
+
+var (
+	testCtx     *Context
+	testCtxOnce sync.Once
+)
+
+func getTestContext(t testing.TB) *Context {
+	testCtxOnce.Do(func() {
+		srs, err := kzg.NewSRS(FieldElementsPerBlob, testTau)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, _, _, g2 := bls12381.Generators()
+		g2Powers := make([]bls12381.G2Affine, FieldElementsPerCell+1)
+		var tau, tauPower fr.Element
+		tau.SetBigInt(testTau)
+		tauPower.SetOne()
+		for i := range g2Powers {
+			var e big.Int
+			g2Powers[i].ScalarMultiplication(&g2, tauPower.BigInt(&e))
+			tauPower.Mul(&tauPower, &tau)
+		}
+		if testCtx, err = NewContext(srs.Pk.G1, g2Powers); err != nil {
+			t.Fatal(err)
+		}
+	})
+	return testCtx
+}
+
+func randomBlob() *Blob {
+	var b Blob
+	for i := range b {
+		b[i].SetRandom()
+	}
+	return &b
+}
+
+func TestCosets(t *testing.T) {
+	ctx := getTestContext(t)
+	for i := range ctx.cosetShifts {
+		if points := cellPoints(i); !points[0].Equal(&ctx.cosetShifts[i]) {
+			t.Fatal("the cosets should match the consensus specs")
+		}
+	}
+}
+
+func TestComputeCellsAndKZGProofs(t *testing.T) {
+	ctx := getTestContext(t)
+	blob := randomBlob()
+	cells, proofs, err := ctx.ComputeCellsAndKZGProofs(blob)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the first cells are the blob
+	for i := 0; i < CellsPerExtBlob/2; i++ {
+		if cells[i] != *(*Cell)(blob[i*FieldElementsPerCell:]) {
+			t.Fatal("the extended blob should start with the blob")
+		}
+	}
+	if c, _ := ctx.ComputeCells(blob); len(c) != CellsPerExtBlob || c[CellsPerExtBlob-1] != cells[CellsPerExtBlob-1] {
+		t.Fatal("ComputeCells and ComputeCellsAndKZGProofs should agree")
+	}
+
+	// πᵢ = [(p(τ) - Iᵢ(τ))/(τ^ℓ - hᵢ^ℓ)]₁, with the secret τ
+	var tau fr.Element
+	tau.SetBigInt(testTau)
+	p := ctx.coefficients(blob[:])
+	pTau := eval(p, &tau)
+	_, _, g1, _ := bls12381.Generators()
+	for _, i := range []int{0, 1, 77, CellsPerExtBlob - 1} {
+		// Iᵢ(τ) by Lagrange interpolation
+		var iTau fr.Element
+		points := cellPoints(i)
+		for j := range points {
+			var num, den, tmp fr.Element
+			num.SetOne()
+			den.SetOne()
+			for l := range points {
+				if l != j {
+					num.Mul(&num, tmp.Sub(&tau, &points[l]))
+					den.Mul(&den, tmp.Sub(&points[j], &points[l]))
+				}
+			}
+			num.Div(&num, &den).Mul(&num, &cells[i][j])
+			iTau.Add(&iTau, &num)
+		}
+
+		var q, tauL, hL fr.Element
+		exponent := big.NewInt(FieldElementsPerCell)
+		tauL.Exp(tau, exponent)
+		hL.Exp(ctx.cosetShifts[i], exponent)
+		q.Sub(&pTau, &iTau).Div(&q, tauL.Sub(&tauL, &hL))
+
+		var expected bls12381.G1Affine
+		var e big.Int
+		expected.ScalarMultiplication(&g1, q.BigInt(&e))
+		if !expected.Equal(&proofs[i]) {
+			t.Fatal("wrong proof")
+		}
+	}
+}
+
+func TestVerifyCellKZGProofBatch(t *testing.T) {
+	ctx := getTestContext(t)
+
+	// some cells of two blobs
+	var commitments, proofs []kzg.Digest
+	var cellIndices []uint64
+	var cells []Cell
+	for b := 0; b < 2; b++ {
+		blob := randomBlob()
+		c, err := ctx.BlobToKZGCommitment(blob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blobCells, blobProofs, err := ctx.ComputeCellsAndKZGProofs(blob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range []int{3, 64, 127} {
+			commitments = append(commitments, c)
+			cellIndices = append(cellIndices, uint64(i))
+			cells = append(cells, blobCells[i])
+			proofs = append(proofs, blobProofs[i])
+		}
+	}
+
+	if err := ctx.VerifyCellKZGProofBatch(commitments, cellIndices, cells, proofs); err != nil {
+		t.Fatal(err)
+	}
+	if err := ctx.VerifyCellKZGProofBatch(nil, nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong cell
+	cells[1][5].SetRandom()
+	if err := ctx.VerifyCellKZGProofBatch(commitments, cellIndices, cells, proofs); err != ErrVerifyCellProofBatch {
+		t.Fatal("verification should fail on a wrong cell")
+	}
+	cells[1], cells[4] = cells[4], cells[1]
+	if err := ctx.VerifyCellKZGProofBatch(commitments[4:], cellIndices[4:], cells[4:], proofs[4:]); err != ErrVerifyCellProofBatch {
+		t.Fatal("verification should fail on a wrong cell")
+	}
+
+	// wrong index
+	if err := ctx.VerifyCellKZGProofBatch(commitments[:1], []uint64{4}, cells[:1], proofs[:1]); err != ErrVerifyCellProofBatch {
+		t.Fatal("verification should fail on a wrong index")
+	}
+	if err := ctx.VerifyCellKZGProofBatch(commitments[:1], []uint64{CellsPerExtBlob}, cells[:1], proofs[:1]); err != ErrCellIndex {
+		t.Fatal("expected ErrCellIndex")
+	}
+
+	// wrong proof
+	if err := ctx.VerifyCellKZGProofBatch(commitments[:1], cellIndices[:1], cells[:1], proofs[2:3]); err != ErrVerifyCellProofBatch {
+		t.Fatal("verification should fail on a wrong proof")
+	}
+}
+
+func TestRecoverCellsAndKZGProofs(t *testing.T) {
+	ctx := getTestContext(t)
+	cells, proofs, err := ctx.ComputeCellsAndKZGProofs(randomBlob())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// any half of the cells
+	perm := rand.Perm(CellsPerExtBlob)[:CellsPerExtBlob/2]
+	sort.Ints(perm)
+	cellIndices := make([]uint64, len(perm))
+	someCells := make([]Cell, len(perm))
+	for k, i := range perm {
+		cellIndices[k] = uint64(i)
+		someCells[k] = cells[i]
+	}
+	recoveredCells, recoveredProofs, err := ctx.RecoverCellsAndKZGProofs(cellIndices, someCells)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range cells {
+		if recoveredCells[i] != cells[i] || !recoveredProofs[i].Equal(&proofs[i]) {
+			t.Fatal("wrong recovered cell")
+		}
+	}
+
+	// too few cells
+	if _, _, err := ctx.RecoverCellsAndKZGProofs(cellIndices[1:], someCells[1:]); err != ErrRecoveryNbCells {
+		t.Fatal("expected ErrRecoveryNbCells")
+	}
+
+	// unsorted indices
+	cellIndices[0], cellIndices[1] = cellIndices[1], cellIndices[0]
+	someCells[0], someCells[1] = someCells[1], someCells[0]
+	if _, _, err := ctx.RecoverCellsAndKZGProofs(cellIndices, someCells); err != ErrCellIndicesOrder {
+		t.Fatal("expected ErrCellIndicesOrder")
+	}
+
+	// duplicate index
+	cellIndices[0] = cellIndices[1]
+	if _, _, err := ctx.RecoverCellsAndKZGProofs(cellIndices, someCells); err != ErrCellIndicesOrder {
+		t.Fatal("expected ErrCellIndicesOrder")
+	}
+}
+
+func TestCellBytes(t *testing.T) {
+	var c, d Cell
+	for i := range c {
+		c[i].SetRandom()
+	}
+	b := c.Bytes()
+	if len(b) != BytesPerCell {
+		t.Fatal("wrong number of bytes")
+	}
+	if err := d.SetBytes(b); err != nil || d != c {
+		t.Fatal("cell bytes round trip failed")
+	}
+
+	// non-canonical element
+	for i := 0; i < fr.Bytes; i++ {
+		b[i] = 0xff
+	}
+	if err := d.SetBytes(b); err == nil {
+		t.Fatal("SetBytes should fail on a non-canonical element")
+	}
+	if err := d.SetBytes(b[1:]); err != ErrCellSize {
+		t.Fatal("expected ErrCellSize")
+	}
+}
+
+// eval returns p(x)
+func eval(p []fr.Element, x *fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(p) - 1; i >= 0; i-- {
+		res.Mul(&res, x).Add(&res, &p[i])
+	}
+	return res
+}
+
+// cellPoints returns the points of the cell i, the roots of unity of order FieldElementsPerExtBlob
+// of indices i⋅FieldElementsPerCell + j in bit-reversed order
+func cellPoints(i int) []fr.Element {
+	omega := extendedRoot()
+	points := make([]fr.Element, FieldElementsPerCell)
+	for j := range points {
+		index := bits.Reverse64(uint64(i*FieldElementsPerCell+j)) >> (64 - 13)
+		points[j].Exp(omega, new(big.Int).SetUint64(index))
+	}
+	return points
+}
+
+// extendedRoot returns the root of unity of order FieldElementsPerExtBlob of the consensus specs,
+// 7^((r-1)/FieldElementsPerExtBlob)
+func extendedRoot() fr.Element {
+	var e big.Int
+	e.Sub(fr.Modulus(), big.NewInt(1)).Div(&e, big.NewInt(FieldElementsPerExtBlob))
+	var omega fr.Element
+	omega.SetUint64(7).Exp(omega, &e)
+	return omega
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkComputeCellsAndKZGProofs(b *testing.B) {
+	ctx := getTestContext(b)
+	blob := randomBlob()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx.ComputeCellsAndKZGProofs(blob)
+	}
+}
+
+func BenchmarkVerifyCellKZGProofBatch(b *testing.B) {
+	ctx := getTestContext(b)
+	blob := randomBlob()
+	c, _ := ctx.BlobToKZGCommitment(blob)
+	cells, proofs, _ := ctx.ComputeCellsAndKZGProofs(blob)
+	commitments := make([]kzg.Digest, CellsPerExtBlob)
+	cellIndices := make([]uint64, CellsPerExtBlob)
+	for i := range commitments {
+		commitments[i] = c
+		cellIndices[i] = uint64(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx.VerifyCellKZGProofBatch(commitments, cellIndices, cells, proofs)
+	}
+}
+
+func BenchmarkRecoverCellsAndKZGProofs(b *testing.B) {
+	ctx := getTestContext(b)
+	cells, _, _ := ctx.ComputeCellsAndKZGProofs(randomBlob())
+	cellIndices := make([]uint64, CellsPerExtBlob/2)
+	for i := range cellIndices {
+		cellIndices[i] = uint64(2 * i)
+	}
+	someCells := make([]Cell, len(cellIndices))
+	for k, i := range cellIndices {
+		someCells[k] = cells[i]
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ctx.RecoverCellsAndKZGProofs(cellIndices, someCells)
+	}
+}
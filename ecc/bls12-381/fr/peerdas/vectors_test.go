@@ -0,0 +1,313 @@
+package peerdas
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+// The reference tests of the consensus specs run against the mainnet trusted setup, both read from testdata:
+//
+//	testdata/trusted_setup_4096.json: presets/mainnet/trusted_setups/trusted_setup_4096.json of ethereum/consensus-specs
+//	testdata/general/fulu/kzg:        the cell handlers of tests/general/fulu/kzg of ethereum/consensus-spec-tests
+//
+// Both were copied from ethereum/c-kzg-4844 v2.1.8, whose CI checks that they match the consensus specs.
+const (
+	trustedSetupPath = "testdata/trusted_setup_4096.json"
+	referenceTests   = "testdata/general/fulu/kzg"
+)
+
+var (
+	mainnetCtx     *Context
+	mainnetCtxErr  error
+	mainnetCtxOnce sync.Once
+)
+
+func getMainnetContext(t *testing.T) *Context {
+	mainnetCtxOnce.Do(func() {
+		var setup struct {
+			G1Monomial []string `json:"g1_monomial"`
+			G2Monomial []string `json:"g2_monomial"`
+		}
+		buf, err := os.ReadFile(trustedSetupPath)
+		if err != nil {
+			mainnetCtxErr = err
+			return
+		}
+		if mainnetCtxErr = json.Unmarshal(buf, &setup); mainnetCtxErr != nil {
+			return
+		}
+		g1, err := parseDigests(setup.G1Monomial)
+		if err != nil {
+			mainnetCtxErr = err
+			return
+		}
+		g2 := make([]bls12381.G2Affine, len(setup.G2Monomial))
+		for i := range g2 {
+			b, err := parseHex(setup.G2Monomial[i], bls12381.SizeOfG2AffineCompressed)
+			if err != nil {
+				mainnetCtxErr = err
+				return
+			}
+			if _, err := g2[i].SetBytes(b); err != nil {
+				mainnetCtxErr = err
+				return
+			}
+		}
+		mainnetCtx, mainnetCtxErr = NewContext(g1, g2)
+	})
+	if mainnetCtxErr != nil {
+		t.Fatal(mainnetCtxErr)
+	}
+	return mainnetCtx
+}
+
+// forEachReferenceTest runs f on the data (input and expected output) of each reference test of handler
+func forEachReferenceTest(t *testing.T, handler string, f func(t *testing.T, ctx *Context, data []byte)) {
+	ctx := getMainnetContext(t)
+	files, err := filepath.Glob(filepath.Join(referenceTests, handler, "kzg-mainnet", "*", "data.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("missing " + filepath.Join(referenceTests, handler))
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Run(filepath.Base(filepath.Dir(file)), func(t *testing.T) {
+			f(t, ctx, data)
+		})
+	}
+}
+
+func TestReferenceComputeCells(t *testing.T) {
+	forEachReferenceTest(t, "compute_cells", func(t *testing.T, ctx *Context, data []byte) {
+		var test struct {
+			Input struct {
+				Blob string `yaml:"blob"`
+			} `yaml:"input"`
+			Output []string `yaml:"output"`
+		}
+		if err := yaml.Unmarshal(data, &test); err != nil {
+			t.Fatal(err)
+		}
+
+		var cells []Cell
+		blob, err := parseBlob(test.Input.Blob)
+		if err == nil {
+			cells, err = ctx.ComputeCells(blob)
+		}
+		if test.Output == nil {
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkCells(t, cells, test.Output)
+	})
+}
+
+func TestReferenceComputeCellsAndKZGProofs(t *testing.T) {
+	forEachReferenceTest(t, "compute_cells_and_kzg_proofs", func(t *testing.T, ctx *Context, data []byte) {
+		var test struct {
+			Input struct {
+				Blob string `yaml:"blob"`
+			} `yaml:"input"`
+			Output [][]string `yaml:"output"`
+		}
+		if err := yaml.Unmarshal(data, &test); err != nil {
+			t.Fatal(err)
+		}
+
+		var cells []Cell
+		var proofs []kzg.Digest
+		blob, err := parseBlob(test.Input.Blob)
+		if err == nil {
+			cells, proofs, err = ctx.ComputeCellsAndKZGProofs(blob)
+		}
+		if test.Output == nil {
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkCells(t, cells, test.Output[0])
+		checkDigests(t, proofs, test.Output[1])
+	})
+}
+
+func TestReferenceVerifyCellKZGProofBatch(t *testing.T) {
+	forEachReferenceTest(t, "verify_cell_kzg_proof_batch", func(t *testing.T, ctx *Context, data []byte) {
+		var test struct {
+			Input struct {
+				Commitments []string `yaml:"commitments"`
+				CellIndices []uint64 `yaml:"cell_indices"`
+				Cells       []string `yaml:"cells"`
+				Proofs      []string `yaml:"proofs"`
+			} `yaml:"input"`
+			Output *bool `yaml:"output"`
+		}
+		if err := yaml.Unmarshal(data, &test); err != nil {
+			t.Fatal(err)
+		}
+
+		valid, err := func() (bool, error) {
+			commitments, err := parseDigests(test.Input.Commitments)
+			if err != nil {
+				return false, err
+			}
+			cells, err := parseCells(test.Input.Cells)
+			if err != nil {
+				return false, err
+			}
+			proofs, err := parseDigests(test.Input.Proofs)
+			if err != nil {
+				return false, err
+			}
+			err = ctx.VerifyCellKZGProofBatch(commitments, test.Input.CellIndices, cells, proofs)
+			if err == ErrVerifyCellProofBatch {
+				return false, nil
+			}
+			return err == nil, err
+		}()
+		if test.Output == nil {
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if valid != *test.Output {
+			t.Fatalf("expected %v, got %v", *test.Output, valid)
+		}
+	})
+}
+
+func TestReferenceRecoverCellsAndKZGProofs(t *testing.T) {
+	forEachReferenceTest(t, "recover_cells_and_kzg_proofs", func(t *testing.T, ctx *Context, data []byte) {
+		var test struct {
+			Input struct {
+				CellIndices []uint64 `yaml:"cell_indices"`
+				Cells       []string `yaml:"cells"`
+			} `yaml:"input"`
+			Output [][]string `yaml:"output"`
+		}
+		if err := yaml.Unmarshal(data, &test); err != nil {
+			t.Fatal(err)
+		}
+
+		var recoveredCells []Cell
+		var proofs []kzg.Digest
+		cells, err := parseCells(test.Input.Cells)
+		if err == nil {
+			recoveredCells, proofs, err = ctx.RecoverCellsAndKZGProofs(test.Input.CellIndices, cells)
+		}
+		if test.Output == nil {
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkCells(t, recoveredCells, test.Output[0])
+		checkDigests(t, proofs, test.Output[1])
+	})
+}
+
+// parseHex decodes the 0x-prefixed hexadecimal string s of size bytes
+func parseHex(s string, size int) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != size {
+		return nil, errors.New("wrong number of bytes")
+	}
+	return b, nil
+}
+
+func parseBlob(s string) (*Blob, error) {
+	b, err := parseHex(s, BytesPerBlob)
+	if err != nil {
+		return nil, err
+	}
+	var blob Blob
+	if err := blob.SetBytes(b); err != nil {
+		return nil, err
+	}
+	return &blob, nil
+}
+
+func parseCells(s []string) ([]Cell, error) {
+	cells := make([]Cell, len(s))
+	for i := range s {
+		b, err := parseHex(s[i], BytesPerCell)
+		if err != nil {
+			return nil, err
+		}
+		if err := cells[i].SetBytes(b); err != nil {
+			return nil, err
+		}
+	}
+	return cells, nil
+}
+
+func parseDigests(s []string) ([]kzg.Digest, error) {
+	digests := make([]kzg.Digest, len(s))
+	for i := range s {
+		b, err := parseHex(s[i], bls12381.SizeOfG1AffineCompressed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := digests[i].SetBytes(b); err != nil {
+			return nil, err
+		}
+	}
+	return digests, nil
+}
+
+func checkCells(t *testing.T, cells []Cell, expected []string) {
+	if len(cells) != len(expected) {
+		t.Fatalf("expected %d cells, got %d", len(expected), len(cells))
+	}
+	for i := range cells {
+		if "0x"+hex.EncodeToString(cells[i].Bytes()) != expected[i] {
+			t.Fatalf("wrong cell %d", i)
+		}
+	}
+}
+
+func checkDigests(t *testing.T, digests []kzg.Digest, expected []string) {
+	if len(digests) != len(expected) {
+		t.Fatalf("expected %d proofs, got %d", len(expected), len(digests))
+	}
+	for i := range digests {
+		b := digests[i].Bytes()
+		if "0x"+hex.EncodeToString(b[:]) != expected[i] {
+			t.Fatalf("wrong proof %d", i)
+		}
+	}
+}
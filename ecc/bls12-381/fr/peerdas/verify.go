@@ -0,0 +1,147 @@
+package peerdas
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+)
+
+// domain separator of the challenge of VerifyCellKZGProofBatch
+const randomChallengeKZGCellBatchDomain = "RCKZGCBATCH__V1_"
+
+// VerifyCellKZGProofBatch verifies that cells[k] is the cell of index cellIndices[k] of the extended blob
+// committed to by commitments[k], with the proof proofs[k].
+//
+// With a random r, the proofs πₖ of the cells of shifts hₖ and interpolations Iₖ are checked at once by
+// e(Σ rᵏ⋅πₖ, [τ^ℓ]₂) = e(Σ rᵏ⋅Cₖ - [Σ rᵏ⋅Iₖ(τ)]₁ + Σ rᵏ⋅hₖ^ℓ⋅πₖ, [1]₂), ℓ being FieldElementsPerCell.
+func (ctx *Context) VerifyCellKZGProofBatch(commitments []kzg.Digest, cellIndices []uint64, cells []Cell, proofs []kzg.Digest) error {
+	n := len(cells)
+	if len(commitments) != n || len(cellIndices) != n || len(proofs) != n {
+		return ErrNbCells
+	}
+	for _, index := range cellIndices {
+		if index >= CellsPerExtBlob {
+			return ErrCellIndex
+		}
+	}
+	if n == 0 {
+		return nil
+	}
+
+	// the distinct commitments, and the index of the commitment of each cell among them
+	var uniqueCommitments []kzg.Digest
+	commitmentIndices := make([]uint64, n)
+	seen := make(map[kzg.Digest]uint64)
+	for k := range commitments {
+		i, ok := seen[commitments[k]]
+		if !ok {
+			i = uint64(len(uniqueCommitments))
+			seen[commitments[k]] = i
+			uniqueCommitments = append(uniqueCommitments, commitments[k])
+		}
+		commitmentIndices[k] = i
+	}
+
+	r := batchChallenge(uniqueCommitments, commitmentIndices, cellIndices, cells, proofs)
+	rPowers := make([]fr.Element, n)
+	rPowers[0].SetOne()
+	for k := 1; k < n; k++ {
+		rPowers[k].Mul(&rPowers[k-1], &r)
+	}
+
+	// Σ rᵏ⋅Cₖ, grouped by commitment
+	weights := make([]fr.Element, len(uniqueCommitments))
+	for k := range commitmentIndices {
+		weights[commitmentIndices[k]].Add(&weights[commitmentIndices[k]], &rPowers[k])
+	}
+	var rlc bls12381.G1Jac
+	if _, err := rlc.MultiExp(uniqueCommitments, weights, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// Σ rᵏ⋅Iₖ: the inverse FFT of a cell gives the coefficients of Iₖ(hₖ⋅X)
+	interpolation := make([]fr.Element, FieldElementsPerCell)
+	evals := make([]fr.Element, FieldElementsPerCell)
+	for k := range cells {
+		copy(evals, cells[k][:])
+		ctx.cellDomain.FFTInverse(evals, fft.DIT)
+		var coeff fr.Element
+		coeff.Set(&rPowers[k])
+		for j := range evals {
+			evals[j].Mul(&evals[j], &coeff)
+			interpolation[j].Add(&interpolation[j], &evals[j])
+			coeff.Mul(&coeff, &ctx.cosetShiftsInv[cellIndices[k]])
+		}
+	}
+	var rli bls12381.G1Jac
+	if _, err := rli.MultiExp(ctx.pk.G1[:FieldElementsPerCell], interpolation, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// Σ rᵏ⋅πₖ and Σ rᵏ⋅hₖ^ℓ⋅πₖ
+	var ll bls12381.G1Affine
+	if _, err := ll.MultiExp(proofs, rPowers, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	weighted := make([]fr.Element, n)
+	exponent := big.NewInt(FieldElementsPerCell)
+	for k := range weighted {
+		weighted[k].Exp(ctx.cosetShifts[cellIndices[k]], exponent).Mul(&weighted[k], &rPowers[k])
+	}
+	var rlp bls12381.G1Jac
+	if _, err := rlp.MultiExp(proofs, weighted, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	var rl bls12381.G1Affine
+	rlc.SubAssign(&rli).AddAssign(&rlp)
+	rl.FromJacobian(&rlc)
+
+	// e(-RL, [1]₂)⋅e(LL, [τ^ℓ]₂) = 1
+	rl.Neg(&rl)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{rl, ll}, ctx.g2[:])
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyCellProofBatch
+	}
+	return nil
+}
+
+// batchChallenge returns the Fiat-Shamir challenge r of VerifyCellKZGProofBatch
+func batchChallenge(commitments []kzg.Digest, commitmentIndices, cellIndices []uint64, cells []Cell, proofs []kzg.Digest) fr.Element {
+	h := sha256.New()
+	var buf [8]byte
+	writeUint64 := func(v uint64) {
+		binary.BigEndian.PutUint64(buf[:], v)
+		h.Write(buf[:])
+	}
+
+	h.Write([]byte(randomChallengeKZGCellBatchDomain))
+	writeUint64(FieldElementsPerBlob)
+	writeUint64(FieldElementsPerCell)
+	writeUint64(uint64(len(commitments)))
+	writeUint64(uint64(len(cells)))
+	for i := range commitments {
+		b := commitments[i].Bytes()
+		h.Write(b[:])
+	}
+	for k := range cells {
+		writeUint64(commitmentIndices[k])
+		writeUint64(cellIndices[k])
+		h.Write(cells[k].Bytes())
+		b := proofs[k].Bytes()
+		h.Write(b[:])
+	}
+
+	var r fr.Element
+	r.SetBytes(h.Sum(nil))
+	return r
+}
@@ -0,0 +1,372 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+)
+
+// below these sizes, the schoolbook algorithms are faster than the ones using FFTs
+const (
+	// mulThreshold is the size of the smallest operand of Mul
+	mulThreshold = 64
+	// divThreshold is the size of the divisor and of the quotient of Div and Rem
+	divThreshold = 64
+	// composeThreshold is the size of the outer polynomial of Compose
+	composeThreshold = 8
+)
+
+// Mul sets p to p1⋅p2 and returns p, with deg(p) = deg(p1) + deg(p2).
+// It uses the schoolbook algorithm if one of the operands has less than mulThreshold coefficients,
+// and FFTs otherwise.
+func (p *Polynomial) Mul(p1, p2 Polynomial) *Polynomial {
+	if len(p1) == 0 || len(p2) == 0 {
+		*p = Polynomial{}
+		return p
+	}
+	if len(p1) < mulThreshold || len(p2) < mulThreshold {
+		*p = mulSchoolbook(p1, p2)
+	} else {
+		*p = mulFFT(p1, p2)
+	}
+	return p
+}
+
+func mulSchoolbook(p1, p2 Polynomial) Polynomial {
+	res := make(Polynomial, len(p1)+len(p2)-1)
+	var tmp fr.Element
+	for i := range p1 {
+		for j := range p2 {
+			tmp.Mul(&p1[i], &p2[j])
+			res[i+j].Add(&res[i+j], &tmp)
+		}
+	}
+	return res
+}
+
+func mulFFT(p1, p2 Polynomial) Polynomial {
+	n := len(p1) + len(p2) - 1
+	domain := getDomain(ecc.NextPowerOfTwo(uint64(n)))
+
+	a := make([]fr.Element, domain.Cardinality)
+	b := make([]fr.Element, domain.Cardinality)
+	copy(a, p1)
+	copy(b, p2)
+	domain.FFT(a, fft.DIF)
+	domain.FFT(b, fft.DIF)
+	for i := range a {
+		a[i].Mul(&a[i], &b[i])
+	}
+	domain.FFTInverse(a, fft.DIT)
+
+	return a[:n]
+}
+
+// maxCachedDomain is the cardinality of the largest domain kept by getDomain
+const maxCachedDomain = 1 << 16
+
+// domains caches the domains of cardinality 2ⁱ ⩽ maxCachedDomain used by the FFT-based algorithms
+var domains struct {
+	sync.Mutex
+	d [bits.UintSize]*fft.Domain
+}
+
+// getDomain returns a domain of cardinality n, n being a power of 2
+func getDomain(n uint64) *fft.Domain {
+	if n > maxCachedDomain {
+		return fft.NewDomain(n)
+	}
+	log := bits.TrailingZeros64(n)
+	domains.Lock()
+	defer domains.Unlock()
+	if domains.d[log] == nil {
+		domains.d[log] = fft.NewDomain(n)
+	}
+	return domains.d[log]
+}
+
+// Div sets p to the quotient of the Euclidean division of p1 by p2 and returns p.
+// See DivRem.
+func (p *Polynomial) Div(p1, p2 Polynomial) *Polynomial {
+	*p, _ = DivRem(p1, p2)
+	return p
+}
+
+// Rem sets p to the remainder of the Euclidean division of p1 by p2 and returns p.
+// See DivRem.
+func (p *Polynomial) Rem(p1, p2 Polynomial) *Polynomial {
+	_, *p = DivRem(p1, p2)
+	return p
+}
+
+// DivRem returns the quotient q and the remainder r of the Euclidean division of p1 by p2,
+// such that p1 = q⋅p2 + r and deg(r) < deg(p2). The leading zero coefficients of p1 and p2
+// are ignored, q has deg(p1) - deg(p2) + 1 coefficients and r has deg(p2) coefficients
+// (fewer if deg(p1) < deg(p2)).
+//
+// It uses the schoolbook algorithm if p2 or q have less than divThreshold coefficients, and
+// otherwise computes the reversed quotient as rev(p1)⋅rev(p2)⁻¹ mod X^len(q), inverting rev(p2)
+// by Newton iteration.
+//
+// It panics if p2 is zero.
+func DivRem(p1, p2 Polynomial) (q, r Polynomial) {
+	p1, p2 = trim(p1), trim(p2)
+	if len(p2) == 0 {
+		panic("division by the zero polynomial")
+	}
+	if len(p1) < len(p2) {
+		return Polynomial{}, p1.Clone()
+	}
+
+	qLen := len(p1) - len(p2) + 1
+	if qLen < divThreshold || len(p2) < divThreshold {
+		return divRemSchoolbook(p1, p2)
+	}
+
+	rev2 := reverse(p2[max(len(p2)-qLen, 0):])
+	return divRemNewton(p1, p2, inverseMod(rev2, qLen))
+}
+
+// divRemNewton returns the quotient and the remainder of the Euclidean division of p1 by p2,
+// given rev(p2)⁻¹ mod Xᵏ for some k ⩾ deg(p1) - deg(p2) + 1.
+func divRemNewton(p1, p2, rev2Inv Polynomial) (q, r Polynomial) {
+	qLen := len(p1) - len(p2) + 1
+
+	// rev(q) = rev(p1)⋅rev(p2)⁻¹ mod X^qLen
+	rev1 := reverse(p1[len(p1)-qLen:])
+	q = reverse(truncate(*rev1.Mul(rev1, truncate(rev2Inv, qLen)), qLen))
+
+	// r = p1 - q⋅p2, of degree < deg(p2)
+	r = make(Polynomial, len(p2)-1)
+	qp2 := new(Polynomial).Mul(q, p2)
+	for i := range r {
+		r[i].Sub(&p1[i], &(*qp2)[i])
+	}
+	return q, r
+}
+
+func divRemSchoolbook(p1, p2 Polynomial) (q, r Polynomial) {
+	r = p1.Clone()
+	q = make(Polynomial, len(p1)-len(p2)+1)
+
+	var leadInv, tmp fr.Element
+	leadInv.Inverse(&p2[len(p2)-1])
+	for i := len(q) - 1; i >= 0; i-- {
+		q[i].Mul(&r[i+len(p2)-1], &leadInv)
+		for j := range p2 {
+			tmp.Mul(&q[i], &p2[j])
+			r[i+j].Sub(&r[i+j], &tmp)
+		}
+	}
+
+	return q, r[:len(p2)-1]
+}
+
+// reducer computes remainders modulo a fixed polynomial m, caching rev(m)⁻¹ mod X^len(m)
+// for the divisions by Newton iteration
+type reducer struct {
+	m, mRevInv Polynomial
+}
+
+func newReducer(m Polynomial) reducer {
+	m = trim(m)
+	r := reducer{m: m}
+	if len(m) >= divThreshold {
+		r.mRevInv = inverseMod(reverse(m), len(m))
+	}
+	return r
+}
+
+// rem returns p mod m
+func (r *reducer) rem(p Polynomial) Polynomial {
+	p = trim(p)
+	qLen := len(p) - len(r.m) + 1
+	switch {
+	case qLen <= 0:
+		return p
+	case r.mRevInv != nil && qLen >= divThreshold && qLen <= len(r.mRevInv):
+		_, res := divRemNewton(p, r.m, r.mRevInv)
+		return res
+	default:
+		_, res := DivRem(p, r.m)
+		return res
+	}
+}
+
+// inverseMod returns p⁻¹ mod Xⁿ, p[0] being non-zero. Each Newton iteration g ← g⋅(2 - p⋅g) mod X²ᵏ
+// doubles the number k of correct coefficients.
+func inverseMod(p Polynomial, n int) Polynomial {
+	g := make(Polynomial, 1, n)
+	g[0].Inverse(&p[0])
+
+	var two fr.Element
+	two.SetUint64(2)
+	for k := 1; k < n; {
+		k *= 2
+		if k > n {
+			k = n
+		}
+		// e = 2 - p⋅g mod Xᵏ
+		e := new(Polynomial).Mul(truncate(p, k), g)
+		*e = truncate(*e, k)
+		for i := range *e {
+			(*e)[i].Neg(&(*e)[i])
+		}
+		(*e)[0].Add(&(*e)[0], &two)
+		g = truncate(*e.Mul(*e, g), k)
+	}
+	return g
+}
+
+// DivideByVanishing sets p to the quotient of the Euclidean division of p1 by Xⁿ - 1,
+// the vanishing polynomial of the subgroup of order n, and returns p.
+// It runs in O(len(p1)) operations; the remainder p1 - p⋅(Xⁿ - 1) is discarded.
+func (p *Polynomial) DivideByVanishing(p1 Polynomial, n int) *Polynomial {
+	if len(p1) <= n {
+		*p = Polynomial{}
+		return p
+	}
+
+	// p1 = q⋅(Xⁿ - 1) + r gives qᵢ = p1ᵢ₊ₙ + qᵢ₊ₙ
+	q := make(Polynomial, len(p1)-n)
+	for i := len(q) - 1; i >= 0; i-- {
+		q[i].Set(&p1[i+n])
+		if i+n < len(q) {
+			q[i].Add(&q[i], &q[i+n])
+		}
+	}
+	*p = q
+	return p
+}
+
+// Compose sets p to p1∘p2 = p1(p2(X)) and returns p.
+// Writing p1 = p1ₗ + Xʰ⋅p1ₕ, it computes p1ₗ∘p2 + p2ʰ⋅(p1ₕ∘p2) recursively, and uses Horner's method
+// below composeThreshold coefficients.
+func (p *Polynomial) Compose(p1, p2 Polynomial) *Polynomial {
+	if len(p1) == 0 {
+		*p = Polynomial{}
+		return p
+	}
+	if len(p2) == 0 {
+		// p1∘0 = p1(0)
+		*p = Polynomial{p1[0]}
+		return p
+	}
+
+	// powers[i] = p2^(2ⁱ⋅composeThreshold)
+	var powers []Polynomial
+	for h := composeThreshold; h < len(p1); h *= 2 {
+		if len(powers) == 0 {
+			powers = append(powers, pow(p2, h))
+		} else {
+			last := powers[len(powers)-1]
+			powers = append(powers, *new(Polynomial).Mul(last, last))
+		}
+	}
+
+	*p = compose(p1, p2, powers)
+	return p
+}
+
+func compose(p1, p2 Polynomial, powers []Polynomial) Polynomial {
+	if len(p1) <= composeThreshold {
+		res := Polynomial{p1[len(p1)-1]}
+		for i := len(p1) - 2; i >= 0; i-- {
+			res.Mul(res, p2)
+			res[0].Add(&res[0], &p1[i])
+		}
+		return res
+	}
+
+	// the largest h = 2ⁱ⋅composeThreshold < len(p1)
+	i := 0
+	for composeThreshold<<(i+1) < len(p1) {
+		i++
+	}
+	h := composeThreshold << i
+
+	lo := compose(p1[:h], p2, powers[:i])
+	hi := compose(p1[h:], p2, powers)
+	hi.Mul(hi, powers[i])
+	return *hi.Add(hi, lo)
+}
+
+// pow returns pⁿ
+func pow(p Polynomial, n int) Polynomial {
+	res := Polynomial{fr.One()}
+	for i := bits.Len(uint(n)) - 1; i >= 0; i-- {
+		res.Mul(res, res)
+		if n>>i&1 == 1 {
+			res.Mul(res, p)
+		}
+	}
+	return res
+}
+
+// Derivative sets p to the derivative of p1 and returns p.
+func (p *Polynomial) Derivative(p1 Polynomial) *Polynomial {
+	if len(p1) <= 1 {
+		*p = Polynomial{}
+		return p
+	}
+	res := make(Polynomial, len(p1)-1)
+	var c fr.Element
+	for i := range res {
+		c.SetUint64(uint64(i + 1))
+		res[i].Mul(&p1[i+1], &c)
+	}
+	*p = res
+	return p
+}
+
+// trim returns p without its leading zero coefficients
+func trim(p Polynomial) Polynomial {
+	n := len(p)
+	for n > 0 && p[n-1].IsZero() {
+		n--
+	}
+	return p[:n]
+}
+
+// truncate returns p mod Xⁿ
+func truncate(p Polynomial, n int) Polynomial {
+	if len(p) > n {
+		return p[:n]
+	}
+	return p
+}
+
+// reverse returns a copy of p with its coefficients in the reverse order
+func reverse(p Polynomial) Polynomial {
+	res := make(Polynomial, len(p))
+	for i := range p {
+		res[len(p)-1-i] = p[i]
+	}
+	return res
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
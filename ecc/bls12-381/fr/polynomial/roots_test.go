@@ -0,0 +1,70 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+func TestRoots(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 20} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			// p = (X - x₀)²⋅∏_{i>0}(X - xᵢ)⋅q, q having no roots with high probability
+			expected := make(map[fr.Element]bool, n)
+			p := Polynomial{fr.One()}
+			for i := 0; i < n; i++ {
+				var x fr.Element
+				x.SetRandom()
+				expected[x] = true
+				var root Polynomial
+				root = append(root, x, fr.One())
+				root[0].Neg(&root[0])
+				p.Mul(p, root)
+				if i == 0 {
+					p.Mul(p, root)
+				}
+			}
+			p.Mul(p, irreducibleQuadratic())
+
+			roots := p.Roots()
+			if len(roots) != n {
+				t.Fatal("wrong number of roots")
+			}
+			for _, x := range roots {
+				if !expected[x] {
+					t.Fatal("unexpected root")
+				}
+			}
+		})
+	}
+}
+
+// irreducibleQuadratic returns X² - c for a non-square c
+func irreducibleQuadratic() Polynomial {
+	q := make(Polynomial, 3)
+	q[2].SetOne()
+	for {
+		q[0].SetRandom()
+		if q[0].Legendre() == -1 {
+			q[0].Neg(&q[0])
+			return q
+		}
+	}
+}
@@ -411,6 +411,44 @@ func TestG1AffineOps(t *testing.T) {
 		genScalar,
 	))
 
+	properties.Property("[BLS12-381] ScalarMultiplicationCT and ScalarMultiplication should output the same results", prop.ForAll(
+		func(a fp.Element, s fr.Element) bool {
+			fop1 := fuzzG1Jac(&g1Gen, a)
+			var p1, op1, op2 G1Affine
+			p1.FromJacobian(&fop1)
+
+			var scalar, negScalar, blindedScalar big.Int
+			s.BigInt(&scalar)
+			negScalar.Neg(&scalar)
+			blindedScalar.Mul(&scalar, fr.Modulus()).Add(&blindedScalar, &scalar)
+
+			for _, k := range []*big.Int{&scalar, &blindedScalar, big.NewInt(0), big.NewInt(1), fr.Modulus()} {
+				op1.ScalarMultiplicationCT(&p1, k)
+				op2.ScalarMultiplication(&p1, k)
+				if !op1.Equal(&op2) {
+					return false
+				}
+			}
+			op1.ScalarMultiplicationCT(&p1, &negScalar)
+			op2.ScalarMultiplication(&p1, &scalar).Neg(&op2)
+			if !op1.Equal(&op2) {
+				return false
+			}
+
+			// base point and point at infinity
+			op1.ScalarMultiplicationBaseCT(&scalar)
+			op2.ScalarMultiplicationBase(&scalar)
+			if !op1.Equal(&op2) {
+				return false
+			}
+			p1.setInfinity()
+			op1.ScalarMultiplicationCT(&p1, &scalar)
+			return op1.IsInfinity()
+		},
+		GenFp(),
+		genScalar,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
@@ -503,6 +541,64 @@ func TestG1AffineBatchScalarMultiplication(t *testing.T) {
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+func TestG1AffineBatchScalarMultiplicationAffine(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = nbFuzzShort
+
+	properties := gopter.NewProperties(parameters)
+
+	genScalar := GenFr()
+
+	const nbSamples = 10
+
+	properties.Property("[BLS12-381] BatchScalarMultiplicationAffine should be consistent with individual scalar multiplications", prop.ForAll(
+		func(mixer fr.Element) bool {
+			var points, expected [nbSamples]G1Affine
+			var scalars [nbSamples]fr.Element
+			var b big.Int
+
+			for i := 0; i < nbSamples; i++ {
+				scalars[i].SetUint64(uint64(i+1)).
+					Mul(&scalars[i], &mixer)
+				points[i].ScalarMultiplication(&g1GenAff, big.NewInt(int64(i+1)))
+			}
+
+			// zero scalar, infinity point, small scalars, same point twice
+			scalars[0].SetZero()
+			points[1].setInfinity()
+			scalars[2].SetOne()
+			scalars[3].SetOne().Neg(&scalars[3])
+			points[4].Set(&points[5])
+
+			for i := range points {
+				expected[i].ScalarMultiplication(&points[i], scalars[i].BigInt(&b))
+			}
+			BatchScalarMultiplicationAffineG1(points[:], scalars[:])
+
+			for i := range points {
+				if !points[i].Equal(&expected[i]) {
+					return false
+				}
+			}
+			return true
+		},
+		genScalar,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+
+	// the exceptional cases of the affine formulas are reported, not computed
+	var p, q, neg G1Affine
+	p.Set(&g1GenAff)
+	neg.Neg(&p)
+	failed := make([]bool, 2)
+	batchAddOrDoubleG1Affine([]*G1Affine{&q, &q}, []*G1Affine{&p, &p}, []*G1Affine{&p, &neg}, []int{0, 1}, failed, make([]fp.Element, 4))
+	if !failed[0] || !failed[1] || !q.IsInfinity() {
+		t.Fatal("adding points with the same x coordinate should fail")
+	}
+}
+
 // ------------------------------------------------------------
 // benches
 
@@ -570,6 +666,21 @@ func BenchmarkG1AffineBatchScalarMultiplication(b *testing.B) {
 	}
 }
 
+func BenchmarkG1AffineBatchScalarMultiplicationAffine(b *testing.B) {
+	const nbSamples = 1 << 10
+	points := make([]G1Affine, nbSamples)
+	scalars := make([]fr.Element, nbSamples)
+	for i := range scalars {
+		scalars[i].SetRandom()
+	}
+	fillBenchBasesG1(points)
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		BatchScalarMultiplicationAffineG1(points, scalars)
+	}
+}
+
 func BenchmarkG1JacScalarMultiplication(b *testing.B) {
 
 	var scalar big.Int
@@ -594,6 +705,14 @@ func BenchmarkG1JacScalarMultiplication(b *testing.B) {
 		}
 	})
 
+	var ct G1Affine
+	b.Run("constant time", func(b *testing.B) {
+		b.ResetTimer()
+		for j := 0; j < b.N; j++ {
+			ct.ScalarMultiplicationCT(&g1GenAff, &scalar)
+		}
+	})
+
 }
 
 func BenchmarkG1AffineCofactorClearing(b *testing.B) {
@@ -965,6 +965,213 @@ func BatchScalarMultiplicationG2(base *G2Affine, scalars []fr.Element) []G2Affin
 	return toReturn
 }
 
+// BatchScalarMultiplicationAffineG2 sets points[i] to [scalars[i]]points[i], for all i.
+//
+// The scalar multiplications run in lockstep in affine coordinates: the points are processed in batches that
+// share a single field inversion per group operation (Montgomery batch inversion trick), which is cheaper than
+// Jacobian coordinates when there are many points. It runs on the calling goroutine only.
+func BatchScalarMultiplicationAffineG2(points []G2Affine, scalars []fr.Element) {
+	if len(points) != len(scalars) {
+		panic("number of points and scalars don't match")
+	}
+	const batchSize = 256
+	for start := 0; start < len(points); start += batchSize {
+		end := start + batchSize
+		if end > len(points) {
+			end = len(points)
+		}
+		batchScalarMultiplicationG2Affine(points[start:end], scalars[start:end])
+	}
+}
+
+// batchScalarMultiplicationG2Affine sets points[i] to [scalars[i]]points[i], for all i, with a windowed-GLV method run in lockstep over all the points.
+// The points that hit an exceptional case of the affine formulas (doubling a point of order 2, or adding two
+// points with the same x coordinate) fall back to a scalar multiplication in Jacobian coordinates.
+func batchScalarMultiplicationG2Affine(points []G2Affine, scalars []fr.Element) {
+	n := len(points)
+
+	// table[b3b2b1b0-1] = b3b2 ⋅ ϕ(a) + b1b0 ⋅ a
+	tables := make([][15]G2Affine, n)
+	k1 := make([]fr.Element, n)
+	k2 := make([]fr.Element, n)
+
+	// trivial[i] is set if points[i] or scalars[i] is zero, failed[i] if it hit an exceptional case
+	trivial := make([]bool, n)
+	failed := make([]bool, n)
+
+	var s big.Int
+	maxBit := 0
+	for i := range points {
+		if points[i].IsInfinity() || scalars[i].IsZero() {
+			trivial[i] = true
+			continue
+		}
+		tables[i][0].Set(&points[i])
+		tables[i][3].Set(&points[i])
+		tables[i][3].X.MulByElement(&tables[i][3].X, &thirdRootOneG2)
+
+		// split the scalar, modifies ±a, ϕ(a) accordingly
+		k := ecc.SplitScalar(scalars[i].BigInt(&s), &glvBasis)
+		if k[0].Sign() == -1 {
+			k[0].Neg(&k[0])
+			tables[i][0].Neg(&tables[i][0])
+		}
+		if k[1].Sign() == -1 {
+			k[1].Neg(&k[1])
+			tables[i][3].Neg(&tables[i][3])
+		}
+		k1[i] = k1[i].SetBigInt(&k[0]).Bits()
+		k2[i] = k2[i].SetBigInt(&k[1]).Bits()
+		if k1[i].BitLen() > maxBit {
+			maxBit = k1[i].BitLen()
+		}
+		if k2[i].BitLen() > maxBit {
+			maxBit = k2[i].BitLen()
+		}
+	}
+
+	// the operations of a round are independent, and share a field inversion
+	var r, a, b []*G2Affine
+	var idx []int
+	scratch := make([]fptower.E2, 2*6*n)
+	push := func(i int, dst, p, q *G2Affine) {
+		if trivial[i] || failed[i] {
+			return
+		}
+		r, a, b, idx = append(r, dst), append(a, p), append(b, q), append(idx, i)
+	}
+	flush := func() {
+		batchAddOrDoubleG2Affine(r, a, b, idx, failed, scratch)
+		r, a, b, idx = r[:0], a[:0], b[:0], idx[:0]
+	}
+
+	// precompute the tables
+	for i := range tables {
+		t := &tables[i]
+		push(i, &t[1], &t[0], nil)
+		push(i, &t[7], &t[3], nil)
+	}
+	flush()
+	for i := range tables {
+		t := &tables[i]
+		push(i, &t[2], &t[1], &t[0])
+		push(i, &t[4], &t[3], &t[0])
+		push(i, &t[5], &t[3], &t[1])
+		push(i, &t[8], &t[7], &t[0])
+		push(i, &t[9], &t[7], &t[1])
+		push(i, &t[11], &t[7], &t[3])
+	}
+	flush()
+	for i := range tables {
+		t := &tables[i]
+		push(i, &t[6], &t[3], &t[2])
+		push(i, &t[10], &t[7], &t[2])
+		push(i, &t[12], &t[11], &t[0])
+		push(i, &t[13], &t[11], &t[1])
+		push(i, &t[14], &t[11], &t[2])
+	}
+	flush()
+
+	// acc[i] starts at infinity, and takes the first non-zero table entry as is
+	acc := make([]G2Affine, n)
+	for j := (maxBit+1)&^1 - 2; j >= 0; j -= 2 {
+		for d := 0; d < 2; d++ {
+			for i := range acc {
+				if !acc[i].IsInfinity() {
+					push(i, &acc[i], &acc[i], nil)
+				}
+			}
+			flush()
+		}
+		for i := range acc {
+			digit := (k1[i][j/64]>>(j%64))&3 | ((k2[i][j/64]>>(j%64))&3)<<2
+			switch {
+			case digit == 0 || trivial[i] || failed[i]:
+			case acc[i].IsInfinity():
+				acc[i].Set(&tables[i][digit-1])
+			default:
+				push(i, &acc[i], &acc[i], &tables[i][digit-1])
+			}
+		}
+		flush()
+	}
+
+	for i := range points {
+		switch {
+		case trivial[i]:
+			points[i].setInfinity()
+		case failed[i]:
+			points[i].ScalarMultiplication(&points[i], scalars[i].BigInt(&s))
+		default:
+			points[i].Set(&acc[i])
+		}
+	}
+}
+
+// batchAddOrDoubleG2Affine sets *r[i] to *a[i] + *b[i], or to 2⋅*a[i] if b[i] is nil, for all i,
+// using batch inversion. r[i] may alias a[i] or b[i], and none of the operands may be the infinity point.
+// If the operation i is an exceptional case of the affine formulas (a[i].X = b[i].X when adding,
+// a[i].Y = 0 when doubling), it sets failed[idx[i]] instead. scratch must hold at least 2⋅len(r) elements.
+func batchAddOrDoubleG2Affine(r, a, b []*G2Affine, idx []int, failed []bool, scratch []fptower.E2) {
+	if len(r) == 0 {
+		return
+	}
+
+	// lambda[i] holds the denominator of the slope of the operation i, inv[i] its inverse
+	lambda, inv := scratch[:len(r)], scratch[len(r):2*len(r)]
+	for i := range r {
+		if b[i] == nil {
+			lambda[i].Double(&a[i].Y)
+		} else {
+			lambda[i].Sub(&b[i].X, &a[i].X)
+		}
+		if lambda[i].IsZero() {
+			failed[idx[i]] = true
+			lambda[i].SetOne()
+		}
+	}
+
+	// invert the denominators using montgomery batch invert technique
+	var accumulator fptower.E2
+	accumulator.SetOne()
+	for i := range r {
+		inv[i] = accumulator
+		accumulator.Mul(&accumulator, &lambda[i])
+	}
+	accumulator.Inverse(&accumulator)
+	for i := len(r) - 1; i >= 0; i-- {
+		inv[i].Mul(&inv[i], &accumulator)
+		accumulator.Mul(&accumulator, &lambda[i])
+	}
+
+	var l, t fptower.E2
+	var res G2Affine
+	for i := range r {
+		if failed[idx[i]] {
+			continue
+		}
+		if b[i] == nil {
+			// λ = (3x² + a) / 2y, x₃ = λ² - 2x
+			l.Square(&a[i].X)
+			t.Double(&l)
+			l.Add(&l, &t)
+			res.X.Double(&a[i].X)
+		} else {
+			// λ = (y₂ - y₁) / (x₂ - x₁), x₃ = λ² - x₁ - x₂
+			l.Sub(&b[i].Y, &a[i].Y)
+			res.X.Add(&a[i].X, &b[i].X)
+		}
+		l.Mul(&l, &inv[i])
+		t.Square(&l)
+		res.X.Sub(&t, &res.X)
+
+		// y₃ = λ(x₁ - x₃) - y₁
+		t.Sub(&a[i].X, &res.X)
+		res.Y.Mul(&l, &t).Sub(&res.Y, &a[i].Y)
+		r[i].Set(&res)
+	}
+}
+
 // batch add affine coordinates
 // using batch inversion
 // special cases (doubling, infinity) must be filtered out before this call
@@ -270,3 +270,87 @@ TEXT ·Butterfly(SB), $24-16
 	MOVQ DI, 24(AX)
 	MOVQ R8, 32(AX)
 	RET
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+l1:
+	TESTQ BX, BX
+	JEQ   l2          // n == 0, we are done
+	MOVQ  0(AX), SI
+	MOVQ  8(AX), DI
+	MOVQ  16(AX), R8
+	MOVQ  24(AX), R9
+	MOVQ  32(AX), R10
+	ADDQ  0(DX), SI
+	ADCQ  8(DX), DI
+	ADCQ  16(DX), R8
+	ADCQ  24(DX), R9
+	ADCQ  32(DX), R10
+
+	// reduce element(SI,DI,R8,R9,R10) using temp registers (R11,R12,R13,R14,R15)
+	REDUCE(SI,DI,R8,R9,R10,R11,R12,R13,R14,R15)
+
+	MOVQ SI, 0(CX)
+	MOVQ DI, 8(CX)
+	MOVQ R8, 16(CX)
+	MOVQ R9, 24(CX)
+	MOVQ R10, 32(CX)
+
+	// increment pointers to visit next element
+	ADDQ $40, AX
+	ADDQ $40, DX
+	ADDQ $40, CX
+	DECQ BX      // decrement n
+	JMP  l1
+
+l2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), AX
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+l3:
+	TESTQ BX, BX
+	JEQ   l5              // n == 0, we are done
+	MOVQ  0(AX), SI
+	MOVQ  8(AX), DI
+	MOVQ  16(AX), R8
+	MOVQ  24(AX), R9
+	MOVQ  32(AX), R10
+	SUBQ  0(DX), SI
+	SBBQ  8(DX), DI
+	SBBQ  16(DX), R8
+	SBBQ  24(DX), R9
+	SBBQ  32(DX), R10
+	JCC   l4
+	ADDQ  q<>+0(SB), SI
+	ADCQ  q<>+8(SB), DI
+	ADCQ  q<>+16(SB), R8
+	ADCQ  q<>+24(SB), R9
+	ADCQ  q<>+32(SB), R10
+
+l4:
+	MOVQ SI, 0(CX)
+	MOVQ DI, 8(CX)
+	MOVQ R8, 16(CX)
+	MOVQ R9, 24(CX)
+	MOVQ R10, 32(CX)
+
+	// increment pointers to visit next element
+	ADDQ $40, AX
+	ADDQ $40, DX
+	ADDQ $40, CX
+	DECQ BX      // decrement n
+	JMP  l3
+
+l5:
+	RET
@@ -0,0 +1,219 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// batchGroupSize is the number of vectors transformed together by a task of FFTBatch,
+// sharing the loads of the twiddle factors.
+const batchGroupSize = 4
+
+// batchBlockSize is the number of twiddle factors applied to all the vectors of a group
+// before moving to the next ones: the block stays in the cache while the vectors are read sequentially.
+const batchBlockSize = 64
+
+// FFTBatch computes the discrete Fourier transform of each vector of a and stores the results in a,
+// as a sequence of calls to FFT with the same decimation and options would.
+// All the vectors must have the size of the domain.
+//
+// Instead of parallelizing each FFT, the vectors are distributed among the tasks (see WithNbTasks),
+// and each task transforms its vectors by groups, loading the twiddle factors once per group.
+// This is faster than a sequence of calls to FFT when there are many vectors of moderate size.
+func (domain *Domain) FFTBatch(a [][]fr.Element, decimation Decimation, opts ...Option) {
+	domain.fftBatch(a, decimation, false, opts...)
+}
+
+// FFTInverseBatch computes the inverse discrete Fourier transform of each vector of a and stores the results in a,
+// as a sequence of calls to FFTInverse with the same decimation and options would. See FFTBatch.
+func (domain *Domain) FFTInverseBatch(a [][]fr.Element, decimation Decimation, opts ...Option) {
+	domain.fftBatch(a, decimation, true, opts...)
+}
+
+func (domain *Domain) fftBatch(a [][]fr.Element, decimation Decimation, inverse bool, opts ...Option) {
+	if decimation != DIT && decimation != DIF {
+		panic("not implemented")
+	}
+	if len(a) == 0 {
+		return
+	}
+	opt := options(opts...)
+
+	// fewer vectors than tasks: the vectors are transformed concurrently, sharing the tasks
+	if len(a) < opt.nbTasks {
+		vectorOpts := append(append([]Option{}, opts...), WithNbTasks(opt.nbTasks/len(a)))
+		var wg sync.WaitGroup
+		wg.Add(len(a))
+		for i := range a {
+			go func(v []fr.Element) {
+				defer wg.Done()
+				if inverse {
+					domain.FFTInverse(v, decimation, vectorOpts...)
+				} else {
+					domain.FFT(v, decimation, vectorOpts...)
+				}
+			}(a[i])
+		}
+		wg.Wait()
+		return
+	}
+
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i += batchGroupSize {
+			j := i + batchGroupSize
+			if j > end {
+				j = end
+			}
+			domain.fftGroup(a[i:j], decimation, inverse, opt.coset)
+		}
+	}, opt.nbTasks)
+}
+
+// fftGroup transforms the vectors of a, at most batchGroupSize of them, without spawning go routines
+func (domain *Domain) fftGroup(a [][]fr.Element, decimation Decimation, inverse, coset bool) {
+	scale := func(table []fr.Element, cardinalityInv bool) {
+		for _, v := range a {
+			for i := range v {
+				v[i].Mul(&v[i], &table[i])
+				if cardinalityInv {
+					v[i].Mul(&v[i], &domain.CardinalityInv)
+				}
+			}
+		}
+	}
+
+	twiddles := domain.Twiddles
+	if inverse {
+		twiddles = domain.TwiddlesInv
+	} else if coset {
+		if decimation == DIT {
+			scale(domain.CosetTableReversed, false)
+		} else {
+			scale(domain.CosetTable, false)
+		}
+	}
+
+	switch {
+	case domain.isMixedRadix():
+		for _, v := range a {
+			mixedRadixFFT(v, twiddles, decimation, 1)
+		}
+	case decimation == DIF:
+		difFFTBatch(a, 0, int(domain.Cardinality), twiddles, 0)
+	default:
+		ditFFTBatch(a, 0, int(domain.Cardinality), twiddles, 0)
+	}
+
+	if !inverse {
+		return
+	}
+	switch {
+	case !coset:
+		for _, v := range a {
+			for i := range v {
+				v[i].Mul(&v[i], &domain.CardinalityInv)
+			}
+		}
+	case decimation == DIT:
+		scale(domain.CosetTableInv, true)
+	default:
+		scale(domain.CosetTableInvReversed, true)
+	}
+}
+
+// difFFTBatch applies difFFT to the sub-vectors v[offset:offset+n] of the vectors v of a,
+// applying each block of twiddle factors to all the vectors before moving to the next one.
+// len(a) must be at most batchGroupSize.
+func difFFTBatch(a [][]fr.Element, offset, n int, twiddles [][]fr.Element, stage int) {
+	if n == 1 {
+		return
+	} else if n == 8 {
+		for _, v := range a {
+			kerDIF8(v[offset:offset+8], twiddles, stage)
+		}
+		return
+	}
+	m := n >> 1
+
+	var lo, hi [batchGroupSize][]fr.Element
+	for k, v := range a {
+		lo[k], hi[k] = v[offset:offset+m], v[offset+m:offset+n]
+		fr.Butterfly(&lo[k][0], &hi[k][0])
+	}
+	for i0 := 1; i0 < m; i0 += batchBlockSize {
+		i1 := i0 + batchBlockSize
+		if i1 > m {
+			i1 = m
+		}
+		t := twiddles[stage][i0:i1]
+		for k := range a {
+			l, h := lo[k][i0:i1], hi[k][i0:i1]
+			for i := range t {
+				fr.Butterfly(&l[i], &h[i])
+				h[i].Mul(&h[i], &t[i])
+			}
+		}
+	}
+
+	if m == 1 {
+		return
+	}
+
+	difFFTBatch(a, offset, m, twiddles, stage+1)
+	difFFTBatch(a, offset+m, m, twiddles, stage+1)
+}
+
+// ditFFTBatch applies ditFFT to the sub-vectors v[offset:offset+n] of the vectors v of a, see difFFTBatch
+func ditFFTBatch(a [][]fr.Element, offset, n int, twiddles [][]fr.Element, stage int) {
+	if n == 1 {
+		return
+	} else if n == 8 {
+		for _, v := range a {
+			kerDIT8(v[offset:offset+8], twiddles, stage)
+		}
+		return
+	}
+	m := n >> 1
+
+	ditFFTBatch(a, offset, m, twiddles, stage+1)
+	ditFFTBatch(a, offset+m, m, twiddles, stage+1)
+
+	var lo, hi [batchGroupSize][]fr.Element
+	for k, v := range a {
+		lo[k], hi[k] = v[offset:offset+m], v[offset+m:offset+n]
+		fr.Butterfly(&lo[k][0], &hi[k][0])
+	}
+	for i0 := 1; i0 < m; i0 += batchBlockSize {
+		i1 := i0 + batchBlockSize
+		if i1 > m {
+			i1 = m
+		}
+		t := twiddles[stage][i0:i1]
+		for k := range a {
+			l, h := lo[k][i0:i1], hi[k][i0:i1]
+			for i := range t {
+				h[i].Mul(&h[i], &t[i])
+				fr.Butterfly(&l[i], &h[i])
+			}
+		}
+	}
+}
@@ -39,6 +39,7 @@ const butterflyThreshold = 16
 // FFT computes (recursively) the discrete Fourier transform of a and stores the result in a
 // if decimation == DIT (decimation in time), the input must be in bit-reversed order
 // if decimation == DIF (decimation in frequency), the output will be in bit-reversed order
+// above fourStepThreshold elements, it uses the cache-friendly four-step algorithm (see fourStepFFT)
 func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option) {
 
 	opt := options(opts...)
@@ -67,10 +68,14 @@ func (domain *Domain) FFT(a []fr.Element, decimation Decimation, opts ...Option)
 		maxSplits = -1
 	}
 
-	switch decimation {
-	case DIF:
+	switch {
+	case domain.isMixedRadix():
+		mixedRadixFFT(a, domain.Twiddles, decimation, opt.nbTasks)
+	case len(a) >= fourStepThreshold && (decimation == DIF || decimation == DIT):
+		fourStepFFT(a, domain.Twiddles, decimation, opt.nbTasks)
+	case decimation == DIF:
 		difFFT(a, domain.Twiddles, 0, maxSplits, nil, opt.nbTasks)
-	case DIT:
+	case decimation == DIT:
 		ditFFT(a, domain.Twiddles, 0, maxSplits, nil, opt.nbTasks)
 	default:
 		panic("not implemented")
@@ -91,10 +96,14 @@ func (domain *Domain) FFTInverse(a []fr.Element, decimation Decimation, opts ...
 	if opt.nbTasks == 1 {
 		maxSplits = -1
 	}
-	switch decimation {
-	case DIF:
+	switch {
+	case domain.isMixedRadix():
+		mixedRadixFFT(a, domain.TwiddlesInv, decimation, opt.nbTasks)
+	case len(a) >= fourStepThreshold && (decimation == DIF || decimation == DIT):
+		fourStepFFT(a, domain.TwiddlesInv, decimation, opt.nbTasks)
+	case decimation == DIF:
 		difFFT(a, domain.TwiddlesInv, 0, maxSplits, nil, opt.nbTasks)
-	case DIT:
+	case decimation == DIT:
 		ditFFT(a, domain.TwiddlesInv, 0, maxSplits, nil, opt.nbTasks)
 	default:
 		panic("not implemented")
@@ -228,9 +237,15 @@ func ditFFT(a []fr.Element, twiddles [][]fr.Element, stage, maxSplits int, chDon
 }
 
 // BitReverse applies the bit-reversal permutation to a.
-// len(a) must be a power of 2 (as in every single function in this file)
+// len(a) must be a power of 2 (as in every single function in this file), or of the form 2ᵃ⋅3ᵇ⋅5ᶜ:
+// a is then permuted from the natural order to the order of the output of a DIF FFT on a mixed-radix domain,
+// and the permutation is not an involution anymore (see BitReverseInverse).
 func BitReverse(a []fr.Element) {
 	n := uint64(len(a))
+	if n&(n-1) != 0 {
+		digitReverse(a, false)
+		return
+	}
 	nn := uint64(64 - bits.TrailingZeros64(n))
 
 	for i := uint64(0); i < n; i++ {
@@ -0,0 +1,151 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+
+	curve "github.com/consensys/gnark-crypto/ecc/bls24-315"
+)
+
+func TestFFTG1(t *testing.T) {
+	const n = 16
+	domain := NewDomain(n)
+	_, _, g, _ := curve.Generators()
+
+	// the FFT of [sᵢ]G is [FFT(s)ᵢ]G; s₀ = 0 checks the point at infinity
+	s := make([]fr.Element, n)
+	for i := 1; i < n; i++ {
+		s[i].SetRandom()
+	}
+
+	for _, decimation := range []Decimation{DIF, DIT} {
+		for _, coset := range []bool{false, true} {
+			var opts []Option
+			if coset {
+				opts = append(opts, OnCoset())
+			}
+
+			expected := make([]fr.Element, n)
+			copy(expected, s)
+			domain.FFT(expected, decimation, opts...)
+			a := curve.BatchScalarMultiplicationG1(&g, s)
+			domain.FFTG1(a, decimation, opts...)
+			if !equalG1(a, curve.BatchScalarMultiplicationG1(&g, expected)) {
+				t.Fatal("FFTG1 should match the FFT of the scalars")
+			}
+
+			copy(expected, s)
+			domain.FFTInverse(expected, decimation, opts...)
+			a = curve.BatchScalarMultiplicationG1(&g, s)
+			domain.FFTInverseG1(a, decimation, opts...)
+			if !equalG1(a, curve.BatchScalarMultiplicationG1(&g, expected)) {
+				t.Fatal("FFTInverseG1 should match the inverse FFT of the scalars")
+			}
+		}
+	}
+}
+
+func equalG1(a, b []curve.G1Affine) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFFTG2(t *testing.T) {
+	const n = 16
+	domain := NewDomain(n)
+	_, _, _, g := curve.Generators()
+
+	// the FFT of [sᵢ]G is [FFT(s)ᵢ]G; s₀ = 0 checks the point at infinity
+	s := make([]fr.Element, n)
+	for i := 1; i < n; i++ {
+		s[i].SetRandom()
+	}
+
+	for _, decimation := range []Decimation{DIF, DIT} {
+		for _, coset := range []bool{false, true} {
+			var opts []Option
+			if coset {
+				opts = append(opts, OnCoset())
+			}
+
+			expected := make([]fr.Element, n)
+			copy(expected, s)
+			domain.FFT(expected, decimation, opts...)
+			a := curve.BatchScalarMultiplicationG2(&g, s)
+			domain.FFTG2(a, decimation, opts...)
+			if !equalG2(a, curve.BatchScalarMultiplicationG2(&g, expected)) {
+				t.Fatal("FFTG2 should match the FFT of the scalars")
+			}
+
+			copy(expected, s)
+			domain.FFTInverse(expected, decimation, opts...)
+			a = curve.BatchScalarMultiplicationG2(&g, s)
+			domain.FFTInverseG2(a, decimation, opts...)
+			if !equalG2(a, curve.BatchScalarMultiplicationG2(&g, expected)) {
+				t.Fatal("FFTInverseG2 should match the inverse FFT of the scalars")
+			}
+		}
+	}
+}
+
+func equalG2(a, b []curve.G2Affine) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkFFTG1(b *testing.B) {
+	for _, logN := range []int{10, 14, 20} {
+		n := 1 << logN
+		b.Run(fmt.Sprintf("2^%d points", logN), func(b *testing.B) {
+			// a[i] = [i+1]G
+			_, _, g, _ := curve.Generators()
+			p := make([]curve.G1Jac, n)
+			p[0].FromAffine(&g)
+			for i := 1; i < n; i++ {
+				p[i].Set(&p[i-1]).AddMixed(&g)
+			}
+			a := curve.BatchJacobianToAffineG1(p)
+			domain := NewDomain(uint64(n))
+
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				domain.FFTG1(a, DIF)
+			}
+		})
+	}
+}
@@ -19,8 +19,8 @@ package iop
 import (
 	"errors"
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
 	"github.com/consensys/gnark-crypto/internal/parallel"
-	"math/bits"
 )
 
 // Expression represents a multivariate polynomial.
@@ -52,9 +52,8 @@ func Evaluate(f Expression, form Form, x ...*Polynomial) (*Polynomial, error) {
 		return i
 	}
 	if form.Layout != Regular {
-		nn := uint64(64 - bits.TrailingZeros(uint(n)))
 		idx = func(i int) int {
-			return int(bits.Reverse64(uint64(i)) >> nn)
+			return int(fft.BitReverseIndex(uint64(n), uint64(i)))
 		}
 	}
 
@@ -20,7 +20,6 @@ import (
 	"crypto/sha256"
 	"errors"
 	"math/big"
-	"math/bits"
 	"sort"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
@@ -33,6 +32,7 @@ var (
 	ErrNotInTable          = errors.New("some value in the vector is not in the lookup table")
 	ErrPlookupVerification = errors.New("plookup verification failed")
 	ErrGenerator           = errors.New("wrong generator")
+	ErrDomainSize          = errors.New("the domain of twice the size of the vectors doesn't exist")
 )
 
 // Proof Plookup proof, containing opening proofs
@@ -136,12 +136,10 @@ func evaluateNumBitReversed(_lz, _lh1, _lh2, _lt, _lf []fr.Element, beta, gamma
 	expo := big.NewInt(int64(domainBig.Cardinality>>1 - 1))
 	gg.Square(&domainBig.Generator).Exp(gg, expo)
 
-	nn := uint64(64 - bits.TrailingZeros64(domainBig.Cardinality))
-
 	for i := 0; i < s; i++ {
 
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
-		_is := int(bits.Reverse64(uint64((i+2)%s)) >> nn)
+		_i := int(fft.BitReverseIndex(domainBig.Cardinality, uint64(i)))
+		_is := int(fft.BitReverseIndex(domainBig.Cardinality, uint64((i+2)%s)))
 
 		// m = z*(1+\beta)*(\gamma+f)*(\gamma(1+\beta) + t+ \beta*t(gX))
 		m.Mul(&onePlusBeta, &_lz[_i])
@@ -249,12 +247,10 @@ func evaluateZStartsByOneBitReversed(lsZBitReversed []fr.Element, domainBig *fft
 
 	res := make([]fr.Element, domainBig.Cardinality)
 
-	nn := uint64(64 - bits.TrailingZeros64(domainBig.Cardinality))
-
 	xnMinusOne, denL0 := evaluateL0DomainBig(domainBig)
 
 	for i := 0; i < len(lsZBitReversed); i++ {
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
+		_i := int(fft.BitReverseIndex(domainBig.Cardinality, uint64(i)))
 		res[_i].Sub(&lsZBitReversed[_i], &one).
 			Mul(&res[_i], &xnMinusOne[i%2]).
 			Mul(&res[_i], &denL0[i])
@@ -272,10 +268,9 @@ func evaluateZEndsByOneBitReversed(lsZBitReversed []fr.Element, domainBig *fft.D
 	numLn, denLn := evaluationLnDomainBig(domainBig)
 
 	res := make([]fr.Element, len(lsZBitReversed))
-	nn := uint64(64 - bits.TrailingZeros64(domainBig.Cardinality))
 
 	for i := 0; i < len(lsZBitReversed); i++ {
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
+		_i := int(fft.BitReverseIndex(domainBig.Cardinality, uint64(i)))
 		res[_i].Sub(&lsZBitReversed[_i], &one).
 			Mul(&res[_i], &numLn[i%2]).
 			Mul(&res[_i], &denLn[i])
@@ -293,13 +288,12 @@ func evaluateOverlapH1h2BitReversed(_lh1, _lh2 []fr.Element, domainBig *fft.Doma
 	numLn, denLn := evaluationLnDomainBig(domainBig)
 
 	res := make([]fr.Element, len(_lh1))
-	nn := uint64(64 - bits.TrailingZeros64(domainBig.Cardinality))
 
 	s := len(_lh1)
 	for i := 0; i < s; i++ {
 
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
-		_is := int(bits.Reverse64(uint64((i+2)%s)) >> nn)
+		_i := int(fft.BitReverseIndex(domainBig.Cardinality, uint64(i)))
+		_is := int(fft.BitReverseIndex(domainBig.Cardinality, uint64((i+2)%s)))
 
 		res[_i].Sub(&_lh1[_i], &_lh2[_is]).
 			Mul(&res[_i], &numLn[i%2]).
@@ -325,11 +319,10 @@ func computeQuotientCanonical(alpha fr.Element, lh, lh0, lhn, lh1h2 []fr.Element
 	numLn := evaluateXnMinusOneDomainBig(domainBig)
 	numLn[0].Inverse(&numLn[0])
 	numLn[1].Inverse(&numLn[1])
-	nn := uint64(64 - bits.TrailingZeros64(domainBig.Cardinality))
 
 	for i := 0; i < sizeDomainBig; i++ {
 
-		_i := int(bits.Reverse64(uint64(i)) >> nn)
+		_i := int(fft.BitReverseIndex(domainBig.Cardinality, uint64(i)))
 
 		res[_i].Mul(&lh1h2[_i], &alpha).
 			Add(&res[_i], &lhn[_i]).
@@ -368,9 +361,9 @@ func ProveLookupVector(pk kzg.ProvingKey, f, t fr.Vector) (ProofLookupVector, er
 	// create domains
 	var domainSmall *fft.Domain
 	if len(t) <= len(f) {
-		domainSmall = fft.NewDomain(uint64(len(f) + 1))
+		domainSmall = fft.NewDomainMixedRadix(uint64(len(f) + 1))
 	} else {
-		domainSmall = fft.NewDomain(uint64(len(t)))
+		domainSmall = fft.NewDomainMixedRadix(uint64(len(t)))
 	}
 	sizeDomainSmall := int(domainSmall.Cardinality)
 
@@ -399,8 +392,8 @@ func ProveLookupVector(pk kzg.ProvingKey, f, t fr.Vector) (ProofLookupVector, er
 	copy(cf, lf)
 	domainSmall.FFTInverse(ct, fft.DIF)
 	domainSmall.FFTInverse(cf, fft.DIF)
-	fft.BitReverse(ct)
-	fft.BitReverse(cf)
+	fft.BitReverseInverse(ct)
+	fft.BitReverseInverse(cf)
 	proof.t, err = kzg.Commit(ct, pk)
 	if err != nil {
 		return proof, err
@@ -428,8 +421,8 @@ func ProveLookupVector(pk kzg.ProvingKey, f, t fr.Vector) (ProofLookupVector, er
 	copy(ch2, lfSortedByt[sizeDomainSmall-1:])
 	domainSmall.FFTInverse(ch1, fft.DIF)
 	domainSmall.FFTInverse(ch2, fft.DIF)
-	fft.BitReverse(ch1)
-	fft.BitReverse(ch2)
+	fft.BitReverseInverse(ch1)
+	fft.BitReverseInverse(ch2)
 
 	proof.h1, err = kzg.Commit(ch1, pk)
 	if err != nil {
@@ -455,7 +448,7 @@ func ProveLookupVector(pk kzg.ProvingKey, f, t fr.Vector) (ProofLookupVector, er
 	cz := make([]fr.Element, len(lz))
 	copy(cz, lz)
 	domainSmall.FFTInverse(cz, fft.DIF)
-	fft.BitReverse(cz)
+	fft.BitReverseInverse(cz)
 	proof.z, err = kzg.Commit(cz, pk)
 	if err != nil {
 		return proof, err
@@ -464,7 +457,11 @@ func ProveLookupVector(pk kzg.ProvingKey, f, t fr.Vector) (ProofLookupVector, er
 	// prepare data for computing the quotient
 	// compute the numerator
 	s := domainSmall.Cardinality
-	domainBig := fft.NewDomain(uint64(2 * s))
+	domainBig := fft.NewDomainMixedRadix(uint64(2 * s))
+	if domainBig.Cardinality != 2*s {
+		// the evaluations of xˢ-1 on the coset of domainBig alternate between two values
+		return proof, ErrDomainSize
+	}
 
 	_lz := make([]fr.Element, 2*s)
 	_lh1 := make([]fr.Element, 2*s)
@@ -628,15 +625,26 @@ func VerifyLookupVector(vk kzg.VerifyingKey, proof ProofLookupVector) error {
 		return err
 	}
 
-	// check the generator is correct
+	// check the generator is correct: proof.size = 2ᵃ⋅3ᵇ⋅5ᶜ is the order of proof.g
 	var checkOrder, one fr.Element
 	one.SetOne()
-	checkOrder.Exp(proof.g, big.NewInt(int64(proof.size/2)))
-	if checkOrder.Equal(&one) {
+	checkOrder.Exp(proof.g, big.NewInt(int64(proof.size)))
+	if !checkOrder.Equal(&one) {
 		return ErrGenerator
 	}
-	checkOrder.Square(&checkOrder)
-	if !checkOrder.Equal(&one) {
+	s := proof.size
+	for _, q := range []uint64{2, 3, 5} {
+		if s%q != 0 {
+			continue
+		}
+		for ; s%q == 0; s /= q {
+		}
+		checkOrder.Exp(proof.g, big.NewInt(int64(proof.size/q)))
+		if checkOrder.Equal(&one) {
+			return ErrGenerator
+		}
+	}
+	if s != 1 {
 		return ErrGenerator
 	}
 
@@ -0,0 +1,166 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+func TestPolynomialMul(t *testing.T) {
+	for _, sizes := range [][2]int{{3, 5}, {64, 64}, {100, 257}} {
+		t.Run(strconv.Itoa(sizes[0])+"x"+strconv.Itoa(sizes[1]), func(t *testing.T) {
+			p1, p2 := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+			var p Polynomial
+			p.Mul(p1, p2)
+			if !p.Equal(mulSchoolbook(p1, p2)) {
+				t.Fatal("Mul should match the schoolbook multiplication")
+			}
+
+			// p(x) = p1(x)⋅p2(x)
+			var x, expected fr.Element
+			x.SetRandom()
+			expected.Mul(ptr(p1.Eval(&x)), ptr(p2.Eval(&x)))
+			if got := p.Eval(&x); !got.Equal(&expected) {
+				t.Fatal("p1⋅p2 should evaluate to p1(x)⋅p2(x)")
+			}
+		})
+	}
+}
+
+func TestPolynomialDivRem(t *testing.T) {
+	for _, sizes := range [][2]int{{10, 3}, {3, 10}, {200, 100}, {300, 64}, {129, 1}} {
+		t.Run(strconv.Itoa(sizes[0])+"/"+strconv.Itoa(sizes[1]), func(t *testing.T) {
+			p1, p2 := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+			q, r := DivRem(p1, p2)
+			if len(r) >= len(p2) {
+				t.Fatal("the remainder should have a lower degree than the divisor")
+			}
+
+			// p1 = q⋅p2 + r
+			var p Polynomial
+			p.Mul(q, p2)
+			p.Add(p, r)
+			if p = trim(p); !p.Equal(trim(p1)) {
+				t.Fatal("p1 should be q⋅p2 + r")
+			}
+
+			var q2, r2 Polynomial
+			q2.Div(p1, p2)
+			r2.Rem(p1, p2)
+			if !q2.Equal(q) || !r2.Equal(r) {
+				t.Fatal("Div and Rem should match DivRem")
+			}
+		})
+	}
+
+	// exact division
+	p1, p2 := randomPolynomial(150), randomPolynomial(80)
+	var p, q Polynomial
+	p.Mul(p1, p2)
+	q.Div(p, p2)
+	if !q.Equal(p1) {
+		t.Fatal("(p1⋅p2)/p2 should be p1")
+	}
+}
+
+func TestPolynomialDivideByVanishing(t *testing.T) {
+	const n = 16
+	q, r := randomPolynomial(40), randomPolynomial(n)
+
+	// p = q⋅(Xⁿ - 1) + r
+	vanishing := make(Polynomial, n+1)
+	vanishing[0].SetOne().Neg(&vanishing[0])
+	vanishing[n].SetOne()
+	var p Polynomial
+	p.Mul(q, vanishing)
+	p.Add(p, r)
+
+	var res Polynomial
+	res.DivideByVanishing(p, n)
+	if !res.Equal(q) {
+		t.Fatal("DivideByVanishing should return the quotient by Xⁿ - 1")
+	}
+}
+
+func TestPolynomialCompose(t *testing.T) {
+	for _, sizes := range [][2]int{{1, 5}, {8, 3}, {50, 4}, {70, 1}} {
+		t.Run(strconv.Itoa(sizes[0])+"∘"+strconv.Itoa(sizes[1]), func(t *testing.T) {
+			p1, p2 := randomPolynomial(sizes[0]), randomPolynomial(sizes[1])
+			var p Polynomial
+			p.Compose(p1, p2)
+			if len(p) != (len(p1)-1)*(len(p2)-1)+1 {
+				t.Fatal("deg(p1∘p2) should be deg(p1)⋅deg(p2)")
+			}
+
+			// p(x) = p1(p2(x))
+			var x fr.Element
+			x.SetRandom()
+			expected := p1.Eval(ptr(p2.Eval(&x)))
+			if got := p.Eval(&x); !got.Equal(&expected) {
+				t.Fatal("p1∘p2 should evaluate to p1(p2(x))")
+			}
+		})
+	}
+}
+
+func TestPolynomialDerivative(t *testing.T) {
+	// (1 + X + ... + X⁴)' = 1 + 2X + 3X² + 4X³
+	p := make(Polynomial, 5)
+	expected := make(Polynomial, 4)
+	for i := range p {
+		p[i].SetOne()
+	}
+	for i := range expected {
+		expected[i].SetUint64(uint64(i + 1))
+	}
+
+	var d Polynomial
+	d.Derivative(p)
+	if !d.Equal(expected) {
+		t.Fatal("wrong derivative")
+	}
+}
+
+func randomPolynomial(n int) Polynomial {
+	p := make(Polynomial, n)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	return p
+}
+
+func ptr(x fr.Element) *fr.Element {
+	return &x
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkPolynomialMul(b *testing.B) {
+	for _, n := range []int{1 << 6, 1 << 10, 1 << 14} {
+		p1, p2 := randomPolynomial(n), randomPolynomial(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			var p Polynomial
+			for i := 0; i < b.N; i++ {
+				p.Mul(p1, p2)
+			}
+		})
+	}
+}
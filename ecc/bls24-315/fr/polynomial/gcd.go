@@ -0,0 +1,234 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// hgcdThreshold is the degree below which GCD runs the Euclidean algorithm instead of the half-GCD
+const hgcdThreshold = 256
+
+// GCD returns the monic greatest common divisor of p1 and p2 (the zero polynomial if both are zero).
+//
+// Above hgcdThreshold, it uses the half-GCD algorithm, which computes the matrix of the first half
+// of the Euclidean remainder sequence from the leading coefficients of the operands, in
+// O(M(n)⋅log(n)) operations instead of O(n²).
+func GCD(p1, p2 Polynomial) Polynomial {
+	a, b := trim(p1), trim(p2)
+	if len(a) < len(b) {
+		a, b = b, a
+	}
+	for len(b) != 0 {
+		if len(b) > hgcdThreshold && len(a) > len(b) {
+			m := hgcd(a, b)
+			if a, b = m.apply(a, b); len(b) == 0 {
+				break
+			}
+		}
+		_, r := DivRem(a, b)
+		a, b = b, trim(r)
+	}
+	return monic(a)
+}
+
+// PartialGCD runs the extended Euclidean algorithm on (p1, p2) until the first remainder r of
+// degree < d, and returns r and the cofactors u, v such that r = u⋅p1 + v⋅p2.
+// As GCD, it uses the half-GCD algorithm on the leading coefficients above hgcdThreshold.
+func PartialGCD(p1, p2 Polynomial, d int) (r, u, v Polynomial) {
+	a, b := trim(p1), trim(p2)
+	m := identity()
+	if len(a) < len(b) {
+		a, b = b, a
+		m[0], m[1] = m[1], m[0]
+	}
+	if len(a) <= d {
+		// a is already of degree < d
+		return a, m[0][0], m[0][1]
+	}
+	for len(b) > d {
+		// the half-GCD of a/Xᵏ and b/Xᵏ stops at the first remainder of degree < d
+		if k := max(2*d-(len(a)-1), 0); len(a)-k > hgcdThreshold && len(a) > len(b) {
+			h := hgcd(a[k:], b[min(k, len(b)):])
+			a, b = h.apply(a, b)
+			m = h.mul(&m)
+			if len(b) <= d {
+				break
+			}
+		}
+		q, rem := DivRem(a, b)
+		m = m.euclidStep(q)
+		a, b = b, trim(rem)
+	}
+	return b, m[1][0], m[1][1]
+}
+
+// matrix2x2 is a matrix of polynomials
+type matrix2x2 [2][2]Polynomial
+
+func identity() matrix2x2 {
+	var m matrix2x2
+	m[0][0] = Polynomial{fr.One()}
+	m[1][1] = Polynomial{fr.One()}
+	return m
+}
+
+// apply returns m⋅(a, b)
+func (m *matrix2x2) apply(a, b Polynomial) (Polynomial, Polynomial) {
+	return combine(m[0][0], a, m[0][1], b), combine(m[1][0], a, m[1][1], b)
+}
+
+// mul returns m⋅n
+func (m *matrix2x2) mul(n *matrix2x2) matrix2x2 {
+	var res matrix2x2
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 2; j++ {
+			res[i][j] = combine(m[i][0], n[0][j], m[i][1], n[1][j])
+		}
+	}
+	return res
+}
+
+// euclidStep returns the matrix (0, 1; 1, -q)⋅m, of the Euclidean step (a, b) ↦ (b, a - q⋅b)
+func (m *matrix2x2) euclidStep(q Polynomial) matrix2x2 {
+	var res matrix2x2
+	res[0] = m[1]
+	for j := 0; j < 2; j++ {
+		res[1][j] = sub(m[0][j], *new(Polynomial).Mul(q, m[1][j]))
+	}
+	return res
+}
+
+// hgcd returns the matrix m of the Euclidean steps from (a, b), deg(a) > deg(b), to the first
+// pair (c, d) of the remainder sequence such that deg(d) < ⌈deg(a)/2⌉.
+// The quotients of the first half of the sequence only depend on the leading coefficients of a and b,
+// which hgcd computes recursively on a/Xᵏ and b/Xᵏ.
+func hgcd(a, b Polynomial) matrix2x2 {
+	m := len(a) / 2
+	if len(b) <= m {
+		return identity()
+	}
+	if len(a) <= hgcdThreshold {
+		return hgcdEuclid(a, b, m)
+	}
+
+	// first half, on the leading coefficients
+	r := hgcd(a[m:], b[m:])
+	c, d := r.apply(a, b)
+	if len(d) <= m {
+		return r
+	}
+
+	// one Euclidean step
+	q, rem := DivRem(c, d)
+	r = r.euclidStep(q)
+	c, d = d, trim(rem)
+	if len(d) <= m {
+		return r
+	}
+
+	// second half
+	k := 2*m - (len(c) - 1)
+	if k < 0 {
+		k = 0
+	}
+	s := hgcd(c[min(k, len(c)):], d[min(k, len(d)):])
+	return s.mul(&r)
+}
+
+// hgcdEuclid is hgcd with the Euclidean algorithm, stopping once deg(b) < m
+func hgcdEuclid(a, b Polynomial, m int) matrix2x2 {
+	r := identity()
+	for len(b) > m {
+		q, rem := DivRem(a, b)
+		r = r.euclidStep(q)
+		a, b = b, trim(rem)
+	}
+	return r
+}
+
+// combine returns a⋅b + c⋅d
+func combine(a, b, c, d Polynomial) Polynomial {
+	var ab, cd Polynomial
+	ab.Mul(a, b)
+	cd.Mul(c, d)
+	if len(ab) == 0 {
+		return trim(cd)
+	}
+	return trim(*ab.Add(ab, cd))
+}
+
+// sub returns p1 - p2
+func sub(p1, p2 Polynomial) Polynomial {
+	res := make(Polynomial, max(len(p1), len(p2)))
+	copy(res, p1)
+	for i := range p2 {
+		res[i].Sub(&res[i], &p2[i])
+	}
+	return trim(res)
+}
+
+// monic returns p divided by its leading coefficient
+func monic(p Polynomial) Polynomial {
+	p = trim(p)
+	if len(p) == 0 {
+		return p
+	}
+	var lcInv fr.Element
+	lcInv.Inverse(&p[len(p)-1])
+	res := make(Polynomial, len(p))
+	for i := range p {
+		res[i].Mul(&p[i], &lcInv)
+	}
+	return res
+}
+
+// SquareFreeFactorization returns the square-free factorization of p: the monic, square-free and
+// pairwise coprime polynomials fᵢ such that p = c⋅∏fᵢ⁽ⁱ⁺¹⁾ for a constant c, some of them being 1.
+// It uses Yun's algorithm, and the characteristic of the field being larger than deg(p).
+func SquareFreeFactorization(p Polynomial) []Polynomial {
+	a := monic(p)
+	if len(a) <= 1 {
+		return nil
+	}
+
+	// with a = ∏fᵢ⁽ⁱ⁺¹⁾: c = gcd(a, a') = ∏fᵢ⁽ⁱ⁾, w = a/c = ∏fᵢ and y = a'/c = Σ (i+1)⋅fᵢ'⋅∏_{j≠i}fⱼ
+	var da, w, y, z, dw Polynomial
+	da.Derivative(a)
+	c := GCD(a, da)
+	w.Div(a, c)
+	y.Div(da, c)
+
+	var res []Polynomial
+	for len(w) > 1 {
+		// z = y - w' = Σ i⋅fᵢ'⋅∏_{j≠i}fⱼ is divisible by f₀ but coprime with the other factors
+		dw.Derivative(w)
+		z = sub(y, dw)
+		f := GCD(w, z)
+		res = append(res, f)
+		w.Div(w, f)
+		y.Div(z, f)
+	}
+	return res
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+)
+
+// Roots returns the distinct roots of p in the field, in no particular order.
+//
+// It uses the Cantor-Zassenhaus algorithm: g = gcd(p, Xʳ - X), r being the modulus, is the product of
+// the X - x for the roots x of p, and gcd(g, (X + a)^((r-1)/2) - 1) splits g for a random a
+// with probability about 1/2.
+func (p *Polynomial) Roots() []fr.Element {
+	f := monic(*p)
+	if len(f) <= 1 {
+		return nil
+	}
+
+	// g = gcd(f, Xʳ - X mod f)
+	red := newReducer(f)
+	x := Polynomial{fr.Element{}, fr.One()}
+	xr := red.powMod(red.rem(x), fr.Modulus())
+	g := GCD(f, sub(xr, x))
+
+	roots := make([]fr.Element, 0, len(g)-1)
+	return splitRoots(g, roots)
+}
+
+// splitRoots appends to roots the roots of g, a monic product of distinct linear factors
+func splitRoots(g Polynomial, roots []fr.Element) []fr.Element {
+	switch len(g) {
+	case 0, 1:
+		return roots
+	case 2:
+		// X + g₀
+		var root fr.Element
+		root.Neg(&g[0])
+		return append(roots, root)
+	case 3:
+		// X² + g₁⋅X + g₀: (-g₁ ± √(g₁² - 4⋅g₀))/2
+		var delta, four, twoInv fr.Element
+		four.SetUint64(4)
+		delta.Square(&g[1])
+		four.Mul(&four, &g[0])
+		delta.Sub(&delta, &four)
+		if delta.Sqrt(&delta) == nil {
+			panic("the polynomial should split")
+		}
+		twoInv.SetUint64(2).Inverse(&twoInv)
+		var r0, r1 fr.Element
+		r0.Sub(&delta, &g[1]).Mul(&r0, &twoInv)
+		r1.Add(&delta, &g[1]).Neg(&r1).Mul(&r1, &twoInv)
+		return append(roots, r0, r1)
+	}
+
+	// e = (r-1)/2
+	var e big.Int
+	e.Rsh(fr.Modulus(), 1)
+
+	var one fr.Element
+	one.SetOne()
+	red := newReducer(g)
+	for {
+		var a fr.Element
+		a.SetRandom()
+
+		// h = gcd(g, (X + a)^e - 1)
+		s := red.powMod(Polynomial{a, fr.One()}, &e)
+		if len(s) == 0 {
+			continue
+		}
+		s[0].Sub(&s[0], &one)
+		h := GCD(g, s)
+		if len(h) <= 1 || len(h) == len(g) {
+			continue
+		}
+
+		var q Polynomial
+		q.Div(g, h)
+		roots = splitRoots(h, roots)
+		return splitRoots(monic(q), roots)
+	}
+}
+
+// powMod returns pᵉ mod m, p being reduced modulo m
+func (r *reducer) powMod(p Polynomial, e *big.Int) Polynomial {
+	res := Polynomial{fr.One()}
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		res = r.rem(*res.Mul(res, res))
+		if e.Bit(i) == 1 {
+			res = r.rem(*res.Mul(res, p))
+		}
+	}
+	return res
+}
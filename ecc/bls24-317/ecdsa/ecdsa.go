@@ -22,6 +22,7 @@ import (
 	"crypto/rand"
 	"crypto/sha512"
 	"crypto/subtle"
+	"encoding/binary"
 	"hash"
 	"io"
 	"math/big"
@@ -88,7 +89,7 @@ func GenerateKey(rand io.Reader) (*PrivateKey, error) {
 
 	privateKey := new(PrivateKey)
 	k.FillBytes(privateKey.scalar[:sizeFr])
-	privateKey.PublicKey.A.ScalarMultiplication(&g, k)
+	privateKey.PublicKey.A.ScalarMultiplicationCT(&g, k)
 	return privateKey, nil
 }
 
@@ -100,13 +101,31 @@ func HashToInt(hash []byte) *big.Int {
 		hash = hash[:sizeFr]
 	}
 	ret := new(big.Int).SetBytes(hash)
-	excess := len(hash)*8 - sizeFr
+	excess := len(hash)*8 - fr.Bits
 	if excess > 0 {
 		ret.Rsh(ret, uint(excess))
 	}
 	return ret
 }
 
+// montR is R = 2^(64⋅fr.Limbs) mod order, the Montgomery constant of fr
+var montR fr.Element
+
+func init() {
+	montR.SetBigInt(new(big.Int).Lsh(one, 64*fr.Limbs))
+}
+
+// setBytesCT sets z to the big-endian integer b < order in constant time, as b is secret.
+// SetBytes and SetBigInt convert to Montgomery form with Mul, which may branch on the value.
+func setBytesCT(z *fr.Element, b *[sizeFr]byte) *fr.Element {
+	var v fr.Element
+	for i := 0; i < fr.Limbs; i++ {
+		v[i] = binary.BigEndian.Uint64(b[sizeFr-8*(i+1):])
+	}
+	// v holds b⋅R⁻¹ in Montgomery form
+	return z.MulCT(&v, &montR)
+}
+
 type zr struct{}
 
 // Read replaces the contents of dst with zeros. It is safe for concurrent use.
@@ -190,10 +209,15 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 // s = k⁻¹ . (m + sk ⋅ r)
 // signature = {r, s}
 //
+// Once drawn, the nonce k and the secret scalar sk only go through constant-time operations:
+// the scalar multiplication, and the fr.Element arithmetic (MulCT, AddCT, InverseCT) of s.
+//
 // SEC 1, Version 2.0, Section 4.1.3
 func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
-	scalar, r, s, kInv := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
-	scalar.SetBytes(privKey.scalar[:sizeFr])
+	r, s := new(big.Int), new(big.Int)
+
+	var sk, kInv, e fr.Element
+	setBytesCT(&sk, &privKey.scalar)
 	for {
 		for {
 			csprng, err := nonce(privKey, message)
@@ -206,8 +230,10 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			}
 
 			var P bls24317.G1Affine
-			P.ScalarMultiplicationBase(k)
-			kInv.ModInverse(k, order)
+			P.ScalarMultiplicationBaseCT(k)
+			var kBytes [sizeFr]byte
+			k.FillBytes(kBytes[:])
+			setBytesCT(&kInv, &kBytes).InverseCT(&kInv)
 
 			P.X.BigInt(r)
 
@@ -216,7 +242,9 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 				break
 			}
 		}
-		s.Mul(r, scalar)
+		// s = k⁻¹ ⋅ (m + sk ⋅ r)
+		e.SetBigInt(r)
+		e.MulCT(&e, &sk)
 
 		var m *big.Int
 		if hFunc != nil {
@@ -234,9 +262,10 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 			m = HashToInt(message)
 		}
 
-		s.Add(m, s).
-			Mul(kInv, s).
-			Mod(s, order) // order != 0
+		var sm fr.Element
+		sm.SetBigInt(m)
+		e.AddCT(&e, &sm).MulCT(&e, &kInv)
+		e.BigInt(s)
 		if s.Sign() != 0 {
 			break
 		}
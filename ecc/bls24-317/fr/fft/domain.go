@@ -31,7 +31,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc"
 )
 
-// Domain with a power of 2 cardinality
+// Domain with a power of 2 cardinality, or a mixed-radix cardinality 2ᵃ⋅3ᵇ⋅5ᶜ (see NewDomainMixedRadix)
 // compute a field element of order 2x and store it in FinerGenerator
 // all other values can be derived from x, GeneratorSqrt
 type Domain struct {
@@ -45,6 +45,7 @@ type Domain struct {
 	// the following slices are not serialized and are (re)computed through domain.preComputeTwiddles()
 
 	// Twiddles factor for the FFT using Generator for each stage of the recursive FFT
+	// (for a mixed-radix domain, Twiddles[i] lists the powers of the generator of the i-th stage)
 	Twiddles [][]fr.Element
 
 	// Twiddles factor for the FFT using GeneratorInv for each stage of the recursive FFT
@@ -65,10 +66,18 @@ type Domain struct {
 // cardinality >= m
 // shift: when specified, it's the element by which the set of root of unity is shifted.
 func NewDomain(m uint64, shift ...fr.Element) *Domain {
+	generator, err := Generator(m)
+	if err != nil {
+		panic(err)
+	}
+	return newDomain(ecc.NextPowerOfTwo(m), generator, shift...)
+}
+
+// newDomain returns the subgroup generated by generator, of order cardinality
+func newDomain(cardinality uint64, generator fr.Element, shift ...fr.Element) *Domain {
 
 	domain := &Domain{}
-	x := ecc.NextPowerOfTwo(m)
-	domain.Cardinality = uint64(x)
+	domain.Cardinality = cardinality
 
 	// generator of the largest 2-adic subgroup
 
@@ -79,13 +88,9 @@ func NewDomain(m uint64, shift ...fr.Element) *Domain {
 	}
 	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
 
-	var err error
-	domain.Generator, err = Generator(m)
-	if err != nil {
-		panic(err)
-	}
+	domain.Generator = generator
 	domain.GeneratorInv.Inverse(&domain.Generator)
-	domain.CardinalityInv.SetUint64(uint64(x)).Inverse(&domain.CardinalityInv)
+	domain.CardinalityInv.SetUint64(cardinality).Inverse(&domain.CardinalityInv)
 
 	// twiddle factors
 	domain.preComputeTwiddles()
@@ -131,6 +136,10 @@ func (d *Domain) preComputeTwiddles() {
 
 	// nb fft stages
 	nbStages := uint64(bits.TrailingZeros64(d.Cardinality))
+	mixedRadix := d.isMixedRadix()
+	if mixedRadix {
+		nbStages = uint64(len(radixDecomposition(d.Cardinality)))
+	}
 
 	d.Twiddles = make([][]fr.Element, nbStages)
 	d.TwiddlesInv = make([][]fr.Element, nbStages)
@@ -164,6 +173,13 @@ func (d *Domain) preComputeTwiddles() {
 		wg.Done()
 	}
 
+	if mixedRadix {
+		twiddles = func(t [][]fr.Element, omega fr.Element) {
+			mixedRadixTwiddles(t, omega, radixDecomposition(d.Cardinality))
+			wg.Done()
+		}
+	}
+
 	wg.Add(4)
 	go twiddles(d.Twiddles, d.Generator)
 	go twiddles(d.TwiddlesInv, d.GeneratorInv)
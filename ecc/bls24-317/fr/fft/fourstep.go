@@ -0,0 +1,164 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// fourStepThreshold is the size (in number of elements) from which FFT and FFTInverse use
+// the four-step algorithm, that of a 4GiB vector. Vectors of a few MB already exceed the L2 cache,
+// but BenchmarkFourStepFFT on bn254 (one core, 300MB of L3) found the recursive FFT 3% to 23% faster
+// from 2²² to 2²⁶ elements (2GiB), 2²⁵ aside where the two varied between runs: the threshold
+// is set above the measured sizes, at 2²⁷ elements of 32 bytes.
+const fourStepThreshold = (1 << 32) / fr.Bytes
+
+// transposeTileSize is the size of the square tiles swapped by transpose
+const transposeTileSize = 16
+
+// fourStepFFT computes the FFT of a, len(a) being a power of 2, with the same input and output
+// layouts as difFFT and ditFFT.
+//
+// Bailey's four-step algorithm sees a as a square matrix of size √n×√n, and computes its FFT
+// with FFTs of size √n on the rows, which fit in the cache, a multiplication by twiddle factors and
+// transpositions. The vector is read and written a constant number of times instead of log(n),
+// which makes it suitable for memory-mapped vectors (see utils.MmapSlice).
+// If log(n) is odd, a first (DIF) or last (DIT) radix-2 stage reduces the problem to two square matrices.
+func fourStepFFT(a []fr.Element, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	n := len(a)
+	if bits.TrailingZeros64(uint64(n))%2 == 0 {
+		fourStepFFTSquare(a, twiddles, decimation, nbTasks)
+		return
+	}
+
+	m := n >> 1
+	if decimation == DIF {
+		parallel.Execute(m, func(start, end int) {
+			for i := start; i < end; i++ {
+				fr.Butterfly(&a[i], &a[i+m])
+				a[i+m].Mul(&a[i+m], &twiddles[0][i])
+			}
+		}, nbTasks)
+	}
+	fourStepFFTSquare(a[:m], twiddles[1:], decimation, nbTasks)
+	fourStepFFTSquare(a[m:], twiddles[1:], decimation, nbTasks)
+	if decimation == DIT {
+		parallel.Execute(m, func(start, end int) {
+			for i := start; i < end; i++ {
+				a[i+m].Mul(&a[i+m], &twiddles[0][i])
+				fr.Butterfly(&a[i], &a[i+m])
+			}
+		}, nbTasks)
+	}
+}
+
+// fourStepFFTSquare computes the FFT of a, where len(a) = n = n₁² is an even power of 2.
+//
+// With j = j₁ + n₁⋅j₂ and k = k₁ + n₁⋅k₂, Σⱼ ωʲᵏ⋅aⱼ = Σ_{j₂} ω₁^(j₂⋅k₂)⋅ω^(j₂⋅k₁)⋅Σ_{j₁} ω₁^(j₁⋅k₁)⋅aⱼ where ω₁ = ω^n₁,
+// and the bit reversal of k is rev(k₁)⋅n₁ + rev(k₂), so that in DIF:
+//   - the transpose of a holds the sequences (a_{j₁+n₁⋅j₂})_{j₁} in its rows j₂,
+//   - their FFTs of size n₁ are multiplied by ω^(j₂⋅k₁) and transposed,
+//   - the FFTs of size n₁ of the rows rev(k₁) give the results at rev(k₁)⋅n₁ + rev(k₂).
+//
+// The DIT FFT runs the same steps in the reverse order.
+func fourStepFFTSquare(a []fr.Element, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	n := len(a)
+	logN1 := bits.TrailingZeros64(uint64(n)) / 2
+	n1 := 1 << logN1
+
+	// the twiddle factors of the FFTs of size n₁ are the ones of the last stages
+	rowTwiddles := twiddles[logN1:]
+
+	// rowsFFT applies the FFT of size n₁ to the rows of a, and multiplies the coefficient of the row i
+	// at the (bit-reversed in DIF) index k by ω^(e(i)⋅k) where e(i) = i in DIF and rev(i) in DIT
+	rowsFFT := func(scale bool) {
+		parallel.Execute(n1, func(start, end int) {
+			for i := start; i < end; i++ {
+				row := a[i*n1 : (i+1)*n1]
+				if decimation == DIF {
+					difFFT(row, rowTwiddles, 0, -1, nil, 1)
+				} else {
+					ditFFT(row, rowTwiddles, 0, -1, nil, 1)
+				}
+				if !scale {
+					continue
+				}
+
+				var w, acc fr.Element
+				if decimation == DIF {
+					w = twiddles[0][i]
+				} else {
+					w = twiddles[0][bits.Reverse64(uint64(i))>>(64-logN1)]
+				}
+				acc.SetOne()
+				for k := 0; k < n1; k++ {
+					idx := uint64(k)
+					if decimation == DIF {
+						idx = bits.Reverse64(idx) >> (64 - logN1)
+					}
+					row[idx].Mul(&row[idx], &acc)
+					acc.Mul(&acc, &w)
+				}
+			}
+		}, nbTasks)
+	}
+
+	if decimation == DIF {
+		transpose(a, n1, nbTasks)
+		rowsFFT(true)
+		transpose(a, n1, nbTasks)
+		rowsFFT(false)
+	} else {
+		rowsFFT(true)
+		transpose(a, n1, nbTasks)
+		rowsFFT(false)
+		transpose(a, n1, nbTasks)
+	}
+}
+
+// transpose transposes in place the n×n matrix a stored row by row,
+// swapping its tiles of size transposeTileSize×transposeTileSize
+func transpose(a []fr.Element, n int, nbTasks int) {
+	nbTiles := (n + transposeTileSize - 1) / transposeTileSize
+	parallel.Execute(nbTiles, func(start, end int) {
+		for ti := start; ti < end; ti++ {
+			iStart, iEnd := ti*transposeTileSize, (ti+1)*transposeTileSize
+			if iEnd > n {
+				iEnd = n
+			}
+			for tj := ti; tj < nbTiles; tj++ {
+				jStart, jEnd := tj*transposeTileSize, (tj+1)*transposeTileSize
+				if jEnd > n {
+					jEnd = n
+				}
+				for i := iStart; i < iEnd; i++ {
+					j := jStart
+					if ti == tj {
+						j = i + 1
+					}
+					for ; j < jEnd; j++ {
+						a[i*n+j], a[j*n+i] = a[j*n+i], a[i*n+j]
+					}
+				}
+			}
+		}
+	}, nbTasks)
+}
@@ -0,0 +1,313 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// the roots of unity of order 2ᵃ⋅3ᵇ⋅5ᶜ exist for a ⩽ maxOrderRoot2, b ⩽ maxOrderRoot3 and c ⩽ maxOrderRoot5
+const (
+	maxOrderRoot2 = 60
+	maxOrderRoot3 = 1
+	maxOrderRoot5 = 2
+)
+
+// maxRadix is the largest radix of the mixed-radix FFT
+const maxRadix = 5
+
+// NewDomainMixedRadix returns a subgroup with a cardinality of the form 2ᵃ⋅3ᵇ⋅5ᶜ,
+// the smallest one >= m for which the roots of unity exist in the field.
+// Unlike NewDomain, m is not rounded up to a power of 2: NewDomainMixedRadix(3 << 10) returns
+// a domain of cardinality 3⋅2¹⁰ if 3 divides r-1, where NewDomain returns a domain of cardinality 2¹².
+// If the cardinality is a power of 2, the domain is the one returned by NewDomain.
+//
+// shift: when specified, it's the element by which the set of root of unity is shifted.
+func NewDomainMixedRadix(m uint64, shift ...fr.Element) *Domain {
+	n, err := mixedRadixCardinality(m)
+	if err != nil {
+		panic(err)
+	}
+	if n&(n-1) == 0 {
+		return NewDomain(n, shift...)
+	}
+	generator, err := GeneratorMixedRadix(n)
+	if err != nil {
+		panic(err)
+	}
+	return newDomain(n, generator, shift...)
+}
+
+// GeneratorMixedRadix returns a generator for Z/nZ, where n must be of the form 2ᵃ⋅3ᵇ⋅5ᶜ,
+// or an error if the required root of unity doesn't exist.
+// If n is a power of 2, it returns Generator(n). The generators are consistent: if n divides m,
+// GeneratorMixedRadix(n) = GeneratorMixedRadix(m)^(m/n).
+func GeneratorMixedRadix(n uint64) (fr.Element, error) {
+	// n = 2ᵃ⋅s with s = 3ᵇ⋅5ᶜ
+	a := bits.TrailingZeros64(n)
+	s := n >> a
+	b, c := 0, 0
+	for ; s != 0 && s%3 == 0; s /= 3 {
+		b++
+	}
+	for ; s != 0 && s%5 == 0; s /= 5 {
+		c++
+	}
+	if s != 1 {
+		return fr.Element{}, fmt.Errorf("n (%d) is not of the form 2ᵃ⋅3ᵇ⋅5ᶜ", n)
+	}
+	if a > maxOrderRoot2 || b > maxOrderRoot3 || c > maxOrderRoot5 {
+		return fr.Element{}, fmt.Errorf("n (%d) is too big: the required root of unity does not exist", n)
+	}
+
+	// ω₂ of order 2ᵃ
+	omega2, err := Generator(uint64(1) << a)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	if b == 0 && c == 0 {
+		return omega2, nil
+	}
+
+	// ωₛ of order s = 3ᵇ⋅5ᶜ, from the root of unity of order 3^maxOrderRoot3⋅5^maxOrderRoot5
+	var rootOfUnity, omegaS fr.Element
+	rootOfUnity.SetString("3928772847817747920363461696269631644820291352665198690124906665054014076545")
+	s = n >> a
+	omegaS.Exp(rootOfUnity, new(big.Int).SetUint64(pow(3, maxOrderRoot3-b)*pow(5, maxOrderRoot5-c)))
+
+	// ω = ω₂^(s⁻¹ mod 2ᵃ) ⋅ ωₛ^(2⁻ᵃ mod s) does not depend on the way n is factored,
+	// so that the generators of the domains of cardinality n and k⋅n are consistent.
+	var e, f big.Int
+	p2 := new(big.Int).Lsh(big.NewInt(1), uint(a))
+	bs := new(big.Int).SetUint64(s)
+	if a > 0 {
+		e.ModInverse(bs, p2)
+	}
+	f.ModInverse(new(big.Int).Mod(p2, bs), bs)
+	omega2.Exp(omega2, &e)
+	omegaS.Exp(omegaS, &f)
+	omega2.Mul(&omega2, &omegaS)
+	return omega2, nil
+}
+
+// mixedRadixCardinality returns the smallest n >= m of the form 2ᵃ⋅3ᵇ⋅5ᶜ
+// for which the root of unity of order n exists
+func mixedRadixCardinality(m uint64) (uint64, error) {
+	var res uint64
+	for b, p3 := 0, uint64(1); b <= maxOrderRoot3; b, p3 = b+1, p3*3 {
+		for c, p5 := 0, uint64(1); c <= maxOrderRoot5; c, p5 = c+1, p5*5 {
+			s := p3 * p5
+			n := ecc.NextPowerOfTwo((m + s - 1) / s)
+			if bits.TrailingZeros64(n) > maxOrderRoot2 {
+				continue
+			}
+			if n *= s; res == 0 || n < res {
+				res = n
+			}
+		}
+	}
+	if res == 0 {
+		return 0, fmt.Errorf("m (%d) is too big: the required root of unity does not exist", m)
+	}
+	return res, nil
+}
+
+// isMixedRadix returns true if the cardinality of the domain is not a power of 2
+func (d *Domain) isMixedRadix() bool {
+	return d.Cardinality&(d.Cardinality-1) != 0
+}
+
+// radixDecomposition returns the radices of the stages of the mixed-radix FFT of size n, 5s first, then 3s and 2s.
+// It panics if n is not of the form 2ᵃ⋅3ᵇ⋅5ᶜ.
+func radixDecomposition(n uint64) []uint64 {
+	var radices []uint64
+	for _, r := range []uint64{5, 3, 2} {
+		for ; n != 0 && n%r == 0; n /= r {
+			radices = append(radices, r)
+		}
+	}
+	if n != 1 {
+		panic("the size must be of the form 2ᵃ⋅3ᵇ⋅5ᶜ")
+	}
+	return radices
+}
+
+// mixedRadixTwiddles sets t[i] to the powers of the generator of the i-th stage of the mixed-radix FFT,
+// ωᵢ = omega^(r₀⋅…⋅rᵢ₋₁) of order mᵢ = n/(r₀⋅…⋅rᵢ₋₁), for which len(t[i]) = mᵢ
+func mixedRadixTwiddles(t [][]fr.Element, omega fr.Element, radices []uint64) {
+	m := uint64(1)
+	for _, r := range radices {
+		m *= r
+	}
+	for i, r := range radices {
+		t[i] = make([]fr.Element, m)
+		t[i][0].SetOne()
+		if i > 0 {
+			omega = t[i-1][radices[i-1]]
+		}
+		for j := 1; j < len(t[i]); j++ {
+			t[i][j].Mul(&t[i][j-1], &omega)
+		}
+		m /= r
+	}
+}
+
+// mixedRadixFFT computes the FFT of a on a mixed-radix domain, with the twiddle factors
+// computed by mixedRadixTwiddles. As in the radix-2 FFT, a DIF FFT takes its input in natural order
+// and returns it in digit-reversed order (see BitReverse), and the other way around for a DIT FFT.
+func mixedRadixFFT(a []fr.Element, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	radices := radixDecomposition(uint64(len(a)))
+
+	// stage i splits the blocks of size mᵢ = len(twiddles[i]) in rᵢ sub-blocks of size l = mᵢ/rᵢ
+	stage := func(i int, twiddlesFirst bool) {
+		r := int(radices[i])
+		t := twiddles[i]
+		m := len(t)
+		l := m / r
+		parallel.Execute(len(a)/r, func(start, end int) {
+			var x [maxRadix]fr.Element
+			for k := start; k < end; k++ {
+				// j-th butterfly of the block starting at b
+				b, j := (k/l)*m, k%l
+				for q := 0; q < r; q++ {
+					x[q] = a[b+j+q*l]
+				}
+				if twiddlesFirst {
+					for q := 1; q < r; q++ {
+						x[q].Mul(&x[q], &t[q*j])
+					}
+				}
+				butterflyMixedRadix(x[:r], t, l)
+				if !twiddlesFirst {
+					for q := 1; q < r; q++ {
+						x[q].Mul(&x[q], &t[q*j])
+					}
+				}
+				for q := 0; q < r; q++ {
+					a[b+j+q*l] = x[q]
+				}
+			}
+		}, nbTasks)
+	}
+
+	switch decimation {
+	case DIF:
+		for i := range radices {
+			stage(i, false)
+		}
+	case DIT:
+		for i := len(radices) - 1; i >= 0; i-- {
+			stage(i, true)
+		}
+	default:
+		panic("not implemented")
+	}
+}
+
+// butterflyMixedRadix sets x to its discrete Fourier transform of size r = len(x),
+// where t[l] is the r-th root of unity
+func butterflyMixedRadix(x []fr.Element, t []fr.Element, l int) {
+	switch len(x) {
+	case 2:
+		fr.Butterfly(&x[0], &x[1])
+	case 3:
+		// with ω = t[l], ω² = -1 - ω:
+		// y₁ = x₀ + ω⋅x₁ + ω²⋅x₂ = x₀ - x₂ + ω⋅(x₁ - x₂)
+		// y₂ = x₀ + ω²⋅x₁ + ω⋅x₂ = x₀ - x₁ - ω⋅(x₁ - x₂)
+		var w, y1, y2 fr.Element
+		w.Sub(&x[1], &x[2]).Mul(&w, &t[l])
+		y1.Sub(&x[0], &x[2]).Add(&y1, &w)
+		y2.Sub(&x[0], &x[1]).Sub(&y2, &w)
+		x[0].Add(&x[0], &x[1]).Add(&x[0], &x[2])
+		x[1], x[2] = y1, y2
+	default:
+		// yₚ = ∑_q ω^(p⋅q)⋅x_q
+		r := len(x)
+		var y [maxRadix]fr.Element
+		var tmp fr.Element
+		for p := 0; p < r; p++ {
+			y[p] = x[0]
+			for q := 1; q < r; q++ {
+				tmp.Mul(&x[q], &t[((p*q)%r)*l])
+				y[p].Add(&y[p], &tmp)
+			}
+		}
+		copy(x, y[:r])
+	}
+}
+
+// BitReverseInverse applies the inverse of BitReverse to a.
+// If len(a) is a power of 2, it is BitReverse.
+func BitReverseInverse(a []fr.Element) {
+	n := uint64(len(a))
+	if n&(n-1) != 0 {
+		digitReverse(a, true)
+		return
+	}
+	BitReverse(a)
+}
+
+// BitReverseIndex returns the index of a[i] in BitReverse(a), where len(a) = n
+func BitReverseIndex(n, i uint64) uint64 {
+	if n&(n-1) != 0 {
+		return reverseDigits(i, n, radixDecomposition(n))
+	}
+	nn := uint64(64 - bits.TrailingZeros64(n))
+	return bits.Reverse64(i) >> nn
+}
+
+// digitReverse applies the digit-reversal permutation of the mixed-radix FFT of size len(a) to a, or its inverse
+func digitReverse(a []fr.Element, inverse bool) {
+	n := uint64(len(a))
+	radices := radixDecomposition(n)
+	tmp := make([]fr.Element, n)
+	copy(tmp, a)
+	for i := uint64(0); i < n; i++ {
+		iRev := reverseDigits(i, n, radices)
+		if inverse {
+			a[i] = tmp[iRev]
+		} else {
+			a[iRev] = tmp[i]
+		}
+	}
+}
+
+// reverseDigits writes i = i₀ + r₀⋅(i₁ + r₁⋅(i₂ + …)) and returns i₀⋅n/r₀ + i₁⋅n/(r₀⋅r₁) + …
+func reverseDigits(i, n uint64, radices []uint64) uint64 {
+	var res uint64
+	for _, r := range radices {
+		n /= r
+		res += (i % r) * n
+		i /= r
+	}
+	return res
+}
+
+func pow(x uint64, k int) uint64 {
+	res := uint64(1)
+	for ; k > 0; k-- {
+		res *= x
+	}
+	return res
+}
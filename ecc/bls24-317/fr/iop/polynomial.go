@@ -20,7 +20,6 @@ import (
 	"encoding/binary"
 	"io"
 	"math/big"
-	"math/bits"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
@@ -148,7 +147,7 @@ func (p *Polynomial) Evaluate(x fr.Element) fr.Element {
 
 	var g fr.Element
 	if p.shift <= 5 {
-		gen, err := fft.Generator(uint64(p.size))
+		gen, err := fft.GeneratorMixedRadix(uint64(p.size))
 		if err != nil {
 			panic(err)
 		}
@@ -187,8 +186,7 @@ func (p *Polynomial) GetCoeff(i int) fr.Element {
 	if p.polynomial.Form.Layout == Regular {
 		return (*p.coefficients)[(i+rho*p.shift)%n]
 	} else {
-		nn := uint64(64 - bits.TrailingZeros(uint(n)))
-		iRev := bits.Reverse64(uint64((i+rho*p.shift)%n)) >> nn
+		iRev := fft.BitReverseIndex(uint64(n), uint64((i+rho*p.shift)%n))
 		return (*p.coefficients)[iRev]
 	}
 
@@ -241,9 +239,9 @@ func (p *polynomial) evaluate(x fr.Element) fr.Element {
 			r.Mul(&r, &x).Add(&r, &(*p.coefficients)[i])
 		}
 	} else {
-		nn := uint64(64 - bits.TrailingZeros(uint(p.coefficients.Len())))
+		n := uint64(p.coefficients.Len())
 		for i := p.coefficients.Len() - 1; i >= 0; i-- {
-			iRev := bits.Reverse64(uint64(i)) >> nn
+			iRev := fft.BitReverseIndex(n, uint64(i))
 			r.Mul(&r, &x).Add(&r, &(*p.coefficients)[iRev])
 		}
 	}
@@ -258,7 +256,7 @@ func (p *Polynomial) ToRegular() *Polynomial {
 	if p.Layout == Regular {
 		return p
 	}
-	fft.BitReverse((*p.coefficients))
+	fft.BitReverseInverse((*p.coefficients))
 	p.Layout = Regular
 	return p
 }
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/fft"
 	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr/kzg"
 )
 
@@ -119,6 +120,95 @@ func TestLookupTable(t *testing.T) {
 
 }
 
+// the tables of TestLookupVectorMixedRadix and TestLookupTableMixedRadix have 12 elements, and the
+// vectors 11, so that the proofs use domains of cardinality 12 and 24 if 3 divides r-1
+const mixedRadixSize = 12
+
+func TestLookupVectorMixedRadix(t *testing.T) {
+
+	if d := fft.NewDomainMixedRadix(mixedRadixSize); d.Cardinality != mixedRadixSize {
+		t.Skip("no root of unity of order 3")
+	}
+
+	kzgSrs, err := kzg.NewSRS(64, big.NewInt(13))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lookupVector := make(fr.Vector, mixedRadixSize)
+	fvector := make(fr.Vector, mixedRadixSize-1)
+	for i := range lookupVector {
+		lookupVector[i].SetUint64(uint64(2 * i))
+	}
+	for i := range fvector {
+		fvector[i].Set(&lookupVector[(5*i+1)%mixedRadixSize])
+	}
+
+	proof, err := ProveLookupVector(kzgSrs.Pk, fvector, lookupVector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if proof.size != mixedRadixSize {
+		t.Fatal("the proof should use a mixed-radix domain")
+	}
+	if err = VerifyLookupVector(kzgSrs.Vk, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong proof
+	fvector[0].SetRandom()
+	proof, err = ProveLookupVector(kzgSrs.Pk, fvector, lookupVector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = VerifyLookupVector(kzgSrs.Vk, proof); err == nil {
+		t.Fatal("verification should fail")
+	}
+}
+
+func TestLookupTableMixedRadix(t *testing.T) {
+
+	if d := fft.NewDomainMixedRadix(mixedRadixSize); d.Cardinality != mixedRadixSize {
+		t.Skip("no root of unity of order 3")
+	}
+
+	kzgSrs, err := kzg.NewSRS(64, big.NewInt(13))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lookupTable := make([]fr.Vector, 3)
+	fTable := make([]fr.Vector, 3)
+	for i := range lookupTable {
+		lookupTable[i] = make(fr.Vector, mixedRadixSize)
+		fTable[i] = make(fr.Vector, mixedRadixSize-1)
+		for j := range lookupTable[i] {
+			lookupTable[i][j].SetUint64(uint64(2*i + j))
+		}
+		for j := range fTable[i] {
+			fTable[i][j].Set(&lookupTable[i][(5*j+1)%mixedRadixSize])
+		}
+	}
+
+	proof, err := ProveLookupTables(kzgSrs.Pk, fTable, lookupTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = VerifyLookupTables(kzgSrs.Vk, proof); err != nil {
+		t.Fatal(err)
+	}
+
+	// wrong proof
+	fTable[0][0].SetRandom()
+	proof, err = ProveLookupTables(kzgSrs.Pk, fTable, lookupTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = VerifyLookupTables(kzgSrs.Vk, proof); err == nil {
+		t.Fatal("verification should fail")
+	}
+}
+
 func BenchmarkPlookup(b *testing.B) {
 
 	srsSize := 1 << 15
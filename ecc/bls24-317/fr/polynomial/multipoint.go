@@ -0,0 +1,152 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package polynomial
+
+import (
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// treeThreshold is the number of points below which the subproduct tree evaluates and interpolates
+// with the schoolbook algorithms
+const treeThreshold = 32
+
+// SubproductTree is the binary tree of the products ∏(X - xᵢ) over the subsets of consecutive points
+// of a set, used for the multipoint evaluation and the interpolation in O(M(n)⋅log(n)) operations,
+// where M(n) is the cost of the multiplication of polynomials of degree n.
+type SubproductTree struct {
+	points []fr.Element
+
+	// the product of the points of the node, and its children (nil below treeThreshold points)
+	product     Polynomial
+	left, right *SubproductTree
+
+	// reduces modulo the product, for the nodes with children
+	reducer reducer
+
+	// derivativeInv[i] = 1 / ∏_{j≠i} (xᵢ - xⱼ), computed on the first interpolation
+	derivativeInv     []fr.Element
+	derivativeInvOnce sync.Once
+}
+
+// NewSubproductTree returns the subproduct tree of points, which must be distinct for the interpolation.
+func NewSubproductTree(points []fr.Element) *SubproductTree {
+	t := &SubproductTree{points: points}
+	if len(points) < treeThreshold {
+		t.product = Polynomial{fr.One()}
+		for i := range points {
+			// product ← product⋅(X - xᵢ)
+			t.product = append(t.product, t.product[len(t.product)-1])
+			for j := len(t.product) - 2; j > 0; j-- {
+				var tmp fr.Element
+				tmp.Mul(&t.product[j], &points[i])
+				t.product[j].Sub(&t.product[j-1], &tmp)
+			}
+			t.product[0].Mul(&t.product[0], &points[i]).Neg(&t.product[0])
+		}
+		return t
+	}
+
+	m := len(points) / 2
+	t.left = NewSubproductTree(points[:m])
+	t.right = NewSubproductTree(points[m:])
+	t.product.Mul(t.left.product, t.right.product)
+	t.reducer = newReducer(t.product)
+	return t
+}
+
+// Product returns ∏(X - xᵢ), the vanishing polynomial of the points.
+func (t *SubproductTree) Product() Polynomial {
+	return t.product
+}
+
+// Evaluate returns the evaluations of p at the points of the tree.
+// It reduces p modulo the products of the nodes, from the root to the leaves.
+func (t *SubproductTree) Evaluate(p Polynomial) []fr.Element {
+	res := make([]fr.Element, len(t.points))
+	t.evaluate(p, res)
+	return res
+}
+
+func (t *SubproductTree) evaluate(p Polynomial, res []fr.Element) {
+	if t.left == nil {
+		for i := range t.points {
+			res[i] = p.Eval(&t.points[i])
+		}
+		return
+	}
+
+	p = t.reducer.rem(p)
+	m := len(t.left.points)
+	t.left.evaluate(p, res[:m])
+	t.right.evaluate(p, res[m:])
+}
+
+// Interpolate returns the polynomial of degree < n taking the values at the n points of the tree.
+// Writing P = ∏(X - xᵢ), it returns Σ cᵢ⋅P/(X - xᵢ) where cᵢ = valuesᵢ/P'(xᵢ), combining the nodes
+// from the leaves to the root.
+func (t *SubproductTree) Interpolate(values []fr.Element) Polynomial {
+	if len(values) != len(t.points) {
+		panic("the number of values and points should match")
+	}
+	t.derivativeInvOnce.Do(func() {
+		t.derivativeInv = fr.BatchInvert(t.Evaluate(*new(Polynomial).Derivative(t.product)))
+	})
+	c := make([]fr.Element, len(values))
+	for i := range c {
+		c[i].Mul(&values[i], &t.derivativeInv[i])
+	}
+	return t.linearCombination(c)
+}
+
+// linearCombination returns Σ cᵢ⋅P/(X - xᵢ), P being the product of the node
+func (t *SubproductTree) linearCombination(c []fr.Element) Polynomial {
+	if t.left == nil {
+		res := make(Polynomial, len(t.points))
+		for i := range t.points {
+			// P/(X - xᵢ), by synthetic division of P by X - xᵢ
+			var q fr.Element
+			for j := len(t.product) - 1; j > 0; j-- {
+				q.Mul(&q, &t.points[i]).Add(&q, &t.product[j])
+				var tmp fr.Element
+				tmp.Mul(&q, &c[i])
+				res[j-1].Add(&res[j-1], &tmp)
+			}
+		}
+		return res
+	}
+
+	m := len(t.left.points)
+	l := t.left.linearCombination(c[:m])
+	r := t.right.linearCombination(c[m:])
+	l.Mul(l, t.right.product)
+	r.Mul(r, t.left.product)
+	return *l.Add(l, r)
+}
+
+// EvalMultiPoints returns the evaluations of p at the points, with a subproduct tree
+// if there are at least treeThreshold points.
+func (p *Polynomial) EvalMultiPoints(points []fr.Element) []fr.Element {
+	return NewSubproductTree(points).Evaluate(*p)
+}
+
+// Interpolate returns the polynomial of degree < len(points) taking the values at the points,
+// which must be distinct. See SubproductTree.
+func Interpolate(points, values []fr.Element) Polynomial {
+	return NewSubproductTree(points).Interpolate(values)
+}
@@ -0,0 +1,213 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package reedsolomon
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+func randomMessage(k int) []fr.Element {
+	m := make([]fr.Element, k)
+	for i := range m {
+		m[i].SetRandom()
+	}
+	return m
+}
+
+func equal(a, b []fr.Element) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestEncode(t *testing.T) {
+	c := NewCode(16, 4)
+	m := randomMessage(c.Dimension())
+	cw, err := c.Encode(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// systematic
+	if !equal(cw[:c.Dimension()], m) {
+		t.Fatal("the codeword should start with the message")
+	}
+
+	// linear
+	m2 := randomMessage(c.Dimension())
+	cw2, _ := c.Encode(m2)
+	for i := range m {
+		m2[i].Add(&m2[i], &m[i])
+	}
+	sum, _ := c.Encode(m2)
+	for i := range cw {
+		cw2[i].Add(&cw2[i], &cw[i])
+	}
+	if !equal(sum, cw2) {
+		t.Fatal("the encoding should be linear")
+	}
+
+	if _, err := c.Encode(m[1:]); err != ErrMessageSize {
+		t.Fatal("expected ErrMessageSize")
+	}
+}
+
+func TestRecoverErasures(t *testing.T) {
+	c := NewCode(32, 2)
+	cw, _ := c.Encode(randomMessage(c.Dimension()))
+
+	for _, nbErasures := range []int{0, 1, c.Length() - c.Dimension()} {
+		t.Run(strconv.Itoa(nbErasures), func(t *testing.T) {
+			word := make([]fr.Element, len(cw))
+			copy(word, cw)
+			erased := make([]bool, len(cw))
+			for _, i := range rand.Perm(len(cw))[:nbErasures] {
+				erased[i] = true
+				word[i].SetRandom()
+			}
+
+			res, err := c.RecoverErasures(word, erased)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(res, cw) {
+				t.Fatal("wrong recovered codeword")
+			}
+		})
+	}
+
+	// too many erasures
+	erased := make([]bool, len(cw))
+	for _, i := range rand.Perm(len(cw))[:c.Length()-c.Dimension()+1] {
+		erased[i] = true
+	}
+	if _, err := c.RecoverErasures(cw, erased); err != ErrTooManyErasures {
+		t.Fatal("expected ErrTooManyErasures")
+	}
+
+	// an error among the remaining elements
+	erased = make([]bool, len(cw))
+	for _, i := range rand.Perm(len(cw))[:c.Length()-c.Dimension()-1] {
+		erased[i] = true
+	}
+	word := make([]fr.Element, len(cw))
+	copy(word, cw)
+	for i := range erased {
+		if !erased[i] {
+			word[i].SetRandom()
+			break
+		}
+	}
+	if _, err := c.RecoverErasures(word, erased); err != ErrDecoding {
+		t.Fatal("expected ErrDecoding")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, k := range []int{16, 256} {
+		c := NewCode(uint64(k), 4)
+		cw, _ := c.Encode(randomMessage(c.Dimension()))
+		maxErrors := (c.Length() - c.Dimension()) / 2
+
+		for _, nbErrors := range []int{0, 1, maxErrors} {
+			t.Run(strconv.Itoa(k)+"/"+strconv.Itoa(nbErrors), func(t *testing.T) {
+				word := make([]fr.Element, len(cw))
+				copy(word, cw)
+				for _, i := range rand.Perm(len(cw))[:nbErrors] {
+					word[i].SetRandom()
+				}
+
+				res, err := c.Decode(word)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !equal(res, cw) {
+					t.Fatal("wrong decoded codeword")
+				}
+			})
+		}
+
+		// too many errors
+		word := make([]fr.Element, len(cw))
+		copy(word, cw)
+		for _, i := range rand.Perm(len(cw))[:maxErrors+1] {
+			word[i].SetRandom()
+		}
+		if _, err := c.Decode(word); err != ErrDecoding {
+			t.Fatal("expected ErrDecoding")
+		}
+	}
+}
+
+// --------------------------------------------------------------------
+// benches
+
+// the blob sizes of Ethereum's data availability sampling, extended with a blowup factor of 2
+var benchSizes = []int{1 << 12, 1 << 14, 1 << 16}
+
+func BenchmarkEncode(b *testing.B) {
+	for _, k := range benchSizes {
+		c := NewCode(uint64(k), 2)
+		m := randomMessage(k)
+		b.Run(strconv.Itoa(k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.Encode(m)
+			}
+		})
+	}
+}
+
+func BenchmarkRecoverErasures(b *testing.B) {
+	for _, k := range benchSizes {
+		c := NewCode(uint64(k), 2)
+		cw, _ := c.Encode(randomMessage(k))
+		erased := make([]bool, len(cw))
+		for _, i := range rand.Perm(len(cw))[:k] {
+			erased[i] = true
+		}
+		b.Run(strconv.Itoa(k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.RecoverErasures(cw, erased)
+			}
+		})
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	for _, k := range benchSizes {
+		c := NewCode(uint64(k), 2)
+		cw, _ := c.Encode(randomMessage(k))
+		for _, i := range rand.Perm(len(cw))[:k/2] {
+			cw[i].SetRandom()
+		}
+		b.Run(strconv.Itoa(k), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.Decode(cw)
+			}
+		})
+	}
+}
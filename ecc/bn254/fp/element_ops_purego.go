@@ -52,6 +52,16 @@ func Butterfly(a, b *Element) {
 	_butterflyGeneric(a, b)
 }
 
+// vectorAdd res = a + b, element-wise
+func vectorAdd(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+// vectorSub res = a - b, element-wise
+func vectorSub(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+
 func fromMont(z *Element) {
 	_fromMontGeneric(z)
 }
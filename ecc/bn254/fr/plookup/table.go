@@ -97,7 +97,7 @@ func ProveLookupTables(pk kzg.ProvingKey, f, t []fr.Vector) (ProofLookupTables,
 	if _nbColumns < len(t[0]) {
 		_nbColumns = len(t[0])
 	}
-	d := fft.NewDomain(uint64(_nbColumns))
+	d := fft.NewDomainMixedRadix(uint64(_nbColumns))
 	nbColumns := d.Cardinality
 	lfs := make([][]fr.Element, nbRows)
 	cfs := make([][]fr.Element, nbRows)
@@ -115,7 +115,7 @@ func ProveLookupTables(pk kzg.ProvingKey, f, t []fr.Vector) (ProofLookupTables,
 			lfs[i][j] = f[i][len(f[i])-1]
 		}
 		d.FFTInverse(cfs[i], fft.DIF)
-		fft.BitReverse(cfs[i])
+		fft.BitReverseInverse(cfs[i])
 		proof.fs[i], err = kzg.Commit(cfs[i], pk)
 		if err != nil {
 			return proof, err
@@ -130,7 +130,7 @@ func ProveLookupTables(pk kzg.ProvingKey, f, t []fr.Vector) (ProofLookupTables,
 			lts[i][j] = t[i][len(t[i])-1]
 		}
 		d.FFTInverse(cts[i], fft.DIF)
-		fft.BitReverse(cts[i])
+		fft.BitReverseInverse(cts[i])
 		proof.ts[i], err = kzg.Commit(cts[i], pk)
 		if err != nil {
 			return proof, err
@@ -52,6 +52,12 @@ func TestPolynomialEval(t *testing.T) {
 	if !purportedEval.Equal(&expectedEval) {
 		t.Fatal("polynomial evaluation failed")
 	}
+
+	// the zero polynomial
+	var zero Polynomial
+	if v := zero.Eval(&point); !v.IsZero() {
+		t.Fatal("the zero polynomial should evaluate to 0")
+	}
 }
 
 func TestPolynomialAddConstantInPlace(t *testing.T) {
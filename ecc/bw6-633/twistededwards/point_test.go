@@ -709,6 +709,32 @@ func TestOps(t *testing.T) {
 		genS1,
 	))
 
+	properties.Property("constant-time scalar multiplication should match ScalarMultiplication", prop.ForAll(
+		func(s big.Int) bool {
+
+			params := GetEdwardsCurve()
+
+			var p1, p2, inf PointAffine
+			inf.setInfinity()
+			p1.ScalarMultiplicationCT(&params.Base, &s)
+			p2.ScalarMultiplication(&params.Base, &s)
+			if !p1.Equal(&p2) {
+				return false
+			}
+
+			// the scalar is negative, and a multiple of the order
+			s.Neg(&s)
+			p1.ScalarMultiplicationCT(&params.Base, &s)
+			p2.ScalarMultiplication(&params.Base, &s)
+			if !p1.Equal(&p2) {
+				return false
+			}
+			p1.ScalarMultiplicationCT(&params.Base, &params.Order)
+			return p1.Equal(&inf)
+		},
+		genS1,
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 
 }
@@ -779,3 +805,17 @@ func BenchmarkScalarMulProjective(b *testing.B) {
 		doubleAndAdd.ScalarMultiplication(&a, &s)
 	}
 }
+
+func BenchmarkScalarMulCT(b *testing.B) {
+	params := GetEdwardsCurve()
+	var s big.Int
+	s.SetString("52435875175126190479447705081859658376581184513", 10)
+	s.Add(&s, &params.Order)
+
+	var res PointAffine
+
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		res.ScalarMultiplicationCT(&params.Base, &s)
+	}
+}
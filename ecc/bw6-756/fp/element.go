@@ -2643,6 +2643,709 @@ func updateFactorsDecompose(c int64) (int64, int64) {
 	return f, g
 }
 
+var (
+	_bInverseCTExponentElement *big.Int
+	_bSqrtCTExponentElement    *big.Int
+)
+
+func init() {
+	// q-2
+	_bInverseCTExponentElement = Modulus()
+	_bInverseCTExponentElement.Sub(_bInverseCTExponentElement, big.NewInt(2))
+	const sqrtCTExponentElement = "1eed5b76b77315c55824fc3c6ad19eb92f19a5f5859d13f7e464428aa2c74d998d5ce788548db3d6059025d409f55414fd63967a0dcc8dc5259a2bdb6c8d4a860554784b1bcfbda16d0bd0d0a49d80678fcc7f0d0"
+	_bSqrtCTExponentElement, _ = new(big.Int).SetString(sqrtCTExponentElement, 16)
+}
+
+// MulCT z = x * y (mod q) in constant time.
+// Mul may branch on the result of the Montgomery reduction (except in the amd64 assembly);
+// MulCT performs the final subtraction without branching.
+func (z *Element) MulCT(x, y *Element) *Element {
+	var t [13]uint64
+	var D uint64
+	var m, C uint64
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = bits.Mul64(y[0], x[0])
+	C, t[1] = madd1(y[0], x[1], C)
+	C, t[2] = madd1(y[0], x[2], C)
+	C, t[3] = madd1(y[0], x[3], C)
+	C, t[4] = madd1(y[0], x[4], C)
+	C, t[5] = madd1(y[0], x[5], C)
+	C, t[6] = madd1(y[0], x[6], C)
+	C, t[7] = madd1(y[0], x[7], C)
+	C, t[8] = madd1(y[0], x[8], C)
+	C, t[9] = madd1(y[0], x[9], C)
+	C, t[10] = madd1(y[0], x[10], C)
+	C, t[11] = madd1(y[0], x[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[1], x[0], t[0])
+	C, t[1] = madd2(y[1], x[1], t[1], C)
+	C, t[2] = madd2(y[1], x[2], t[2], C)
+	C, t[3] = madd2(y[1], x[3], t[3], C)
+	C, t[4] = madd2(y[1], x[4], t[4], C)
+	C, t[5] = madd2(y[1], x[5], t[5], C)
+	C, t[6] = madd2(y[1], x[6], t[6], C)
+	C, t[7] = madd2(y[1], x[7], t[7], C)
+	C, t[8] = madd2(y[1], x[8], t[8], C)
+	C, t[9] = madd2(y[1], x[9], t[9], C)
+	C, t[10] = madd2(y[1], x[10], t[10], C)
+	C, t[11] = madd2(y[1], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[2], x[0], t[0])
+	C, t[1] = madd2(y[2], x[1], t[1], C)
+	C, t[2] = madd2(y[2], x[2], t[2], C)
+	C, t[3] = madd2(y[2], x[3], t[3], C)
+	C, t[4] = madd2(y[2], x[4], t[4], C)
+	C, t[5] = madd2(y[2], x[5], t[5], C)
+	C, t[6] = madd2(y[2], x[6], t[6], C)
+	C, t[7] = madd2(y[2], x[7], t[7], C)
+	C, t[8] = madd2(y[2], x[8], t[8], C)
+	C, t[9] = madd2(y[2], x[9], t[9], C)
+	C, t[10] = madd2(y[2], x[10], t[10], C)
+	C, t[11] = madd2(y[2], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[3], x[0], t[0])
+	C, t[1] = madd2(y[3], x[1], t[1], C)
+	C, t[2] = madd2(y[3], x[2], t[2], C)
+	C, t[3] = madd2(y[3], x[3], t[3], C)
+	C, t[4] = madd2(y[3], x[4], t[4], C)
+	C, t[5] = madd2(y[3], x[5], t[5], C)
+	C, t[6] = madd2(y[3], x[6], t[6], C)
+	C, t[7] = madd2(y[3], x[7], t[7], C)
+	C, t[8] = madd2(y[3], x[8], t[8], C)
+	C, t[9] = madd2(y[3], x[9], t[9], C)
+	C, t[10] = madd2(y[3], x[10], t[10], C)
+	C, t[11] = madd2(y[3], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[4], x[0], t[0])
+	C, t[1] = madd2(y[4], x[1], t[1], C)
+	C, t[2] = madd2(y[4], x[2], t[2], C)
+	C, t[3] = madd2(y[4], x[3], t[3], C)
+	C, t[4] = madd2(y[4], x[4], t[4], C)
+	C, t[5] = madd2(y[4], x[5], t[5], C)
+	C, t[6] = madd2(y[4], x[6], t[6], C)
+	C, t[7] = madd2(y[4], x[7], t[7], C)
+	C, t[8] = madd2(y[4], x[8], t[8], C)
+	C, t[9] = madd2(y[4], x[9], t[9], C)
+	C, t[10] = madd2(y[4], x[10], t[10], C)
+	C, t[11] = madd2(y[4], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[5], x[0], t[0])
+	C, t[1] = madd2(y[5], x[1], t[1], C)
+	C, t[2] = madd2(y[5], x[2], t[2], C)
+	C, t[3] = madd2(y[5], x[3], t[3], C)
+	C, t[4] = madd2(y[5], x[4], t[4], C)
+	C, t[5] = madd2(y[5], x[5], t[5], C)
+	C, t[6] = madd2(y[5], x[6], t[6], C)
+	C, t[7] = madd2(y[5], x[7], t[7], C)
+	C, t[8] = madd2(y[5], x[8], t[8], C)
+	C, t[9] = madd2(y[5], x[9], t[9], C)
+	C, t[10] = madd2(y[5], x[10], t[10], C)
+	C, t[11] = madd2(y[5], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[6], x[0], t[0])
+	C, t[1] = madd2(y[6], x[1], t[1], C)
+	C, t[2] = madd2(y[6], x[2], t[2], C)
+	C, t[3] = madd2(y[6], x[3], t[3], C)
+	C, t[4] = madd2(y[6], x[4], t[4], C)
+	C, t[5] = madd2(y[6], x[5], t[5], C)
+	C, t[6] = madd2(y[6], x[6], t[6], C)
+	C, t[7] = madd2(y[6], x[7], t[7], C)
+	C, t[8] = madd2(y[6], x[8], t[8], C)
+	C, t[9] = madd2(y[6], x[9], t[9], C)
+	C, t[10] = madd2(y[6], x[10], t[10], C)
+	C, t[11] = madd2(y[6], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[7], x[0], t[0])
+	C, t[1] = madd2(y[7], x[1], t[1], C)
+	C, t[2] = madd2(y[7], x[2], t[2], C)
+	C, t[3] = madd2(y[7], x[3], t[3], C)
+	C, t[4] = madd2(y[7], x[4], t[4], C)
+	C, t[5] = madd2(y[7], x[5], t[5], C)
+	C, t[6] = madd2(y[7], x[6], t[6], C)
+	C, t[7] = madd2(y[7], x[7], t[7], C)
+	C, t[8] = madd2(y[7], x[8], t[8], C)
+	C, t[9] = madd2(y[7], x[9], t[9], C)
+	C, t[10] = madd2(y[7], x[10], t[10], C)
+	C, t[11] = madd2(y[7], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[8], x[0], t[0])
+	C, t[1] = madd2(y[8], x[1], t[1], C)
+	C, t[2] = madd2(y[8], x[2], t[2], C)
+	C, t[3] = madd2(y[8], x[3], t[3], C)
+	C, t[4] = madd2(y[8], x[4], t[4], C)
+	C, t[5] = madd2(y[8], x[5], t[5], C)
+	C, t[6] = madd2(y[8], x[6], t[6], C)
+	C, t[7] = madd2(y[8], x[7], t[7], C)
+	C, t[8] = madd2(y[8], x[8], t[8], C)
+	C, t[9] = madd2(y[8], x[9], t[9], C)
+	C, t[10] = madd2(y[8], x[10], t[10], C)
+	C, t[11] = madd2(y[8], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[9], x[0], t[0])
+	C, t[1] = madd2(y[9], x[1], t[1], C)
+	C, t[2] = madd2(y[9], x[2], t[2], C)
+	C, t[3] = madd2(y[9], x[3], t[3], C)
+	C, t[4] = madd2(y[9], x[4], t[4], C)
+	C, t[5] = madd2(y[9], x[5], t[5], C)
+	C, t[6] = madd2(y[9], x[6], t[6], C)
+	C, t[7] = madd2(y[9], x[7], t[7], C)
+	C, t[8] = madd2(y[9], x[8], t[8], C)
+	C, t[9] = madd2(y[9], x[9], t[9], C)
+	C, t[10] = madd2(y[9], x[10], t[10], C)
+	C, t[11] = madd2(y[9], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[10], x[0], t[0])
+	C, t[1] = madd2(y[10], x[1], t[1], C)
+	C, t[2] = madd2(y[10], x[2], t[2], C)
+	C, t[3] = madd2(y[10], x[3], t[3], C)
+	C, t[4] = madd2(y[10], x[4], t[4], C)
+	C, t[5] = madd2(y[10], x[5], t[5], C)
+	C, t[6] = madd2(y[10], x[6], t[6], C)
+	C, t[7] = madd2(y[10], x[7], t[7], C)
+	C, t[8] = madd2(y[10], x[8], t[8], C)
+	C, t[9] = madd2(y[10], x[9], t[9], C)
+	C, t[10] = madd2(y[10], x[10], t[10], C)
+	C, t[11] = madd2(y[10], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+	// -----------------------------------
+	// First loop
+
+	C, t[0] = madd1(y[11], x[0], t[0])
+	C, t[1] = madd2(y[11], x[1], t[1], C)
+	C, t[2] = madd2(y[11], x[2], t[2], C)
+	C, t[3] = madd2(y[11], x[3], t[3], C)
+	C, t[4] = madd2(y[11], x[4], t[4], C)
+	C, t[5] = madd2(y[11], x[5], t[5], C)
+	C, t[6] = madd2(y[11], x[6], t[6], C)
+	C, t[7] = madd2(y[11], x[7], t[7], C)
+	C, t[8] = madd2(y[11], x[8], t[8], C)
+	C, t[9] = madd2(y[11], x[9], t[9], C)
+	C, t[10] = madd2(y[11], x[10], t[10], C)
+	C, t[11] = madd2(y[11], x[11], t[11], C)
+
+	t[12], D = bits.Add64(t[12], C, 0)
+
+	// m = t[0]n'[0] mod W
+	m = t[0] * qInvNeg
+
+	// -----------------------------------
+	// Second loop
+	C = madd0(m, q0, t[0])
+	C, t[0] = madd2(m, q1, t[1], C)
+	C, t[1] = madd2(m, q2, t[2], C)
+	C, t[2] = madd2(m, q3, t[3], C)
+	C, t[3] = madd2(m, q4, t[4], C)
+	C, t[4] = madd2(m, q5, t[5], C)
+	C, t[5] = madd2(m, q6, t[6], C)
+	C, t[6] = madd2(m, q7, t[7], C)
+	C, t[7] = madd2(m, q8, t[8], C)
+	C, t[8] = madd2(m, q9, t[9], C)
+	C, t[9] = madd2(m, q10, t[10], C)
+	C, t[10] = madd2(m, q11, t[11], C)
+
+	t[11], C = bits.Add64(t[12], C, 0)
+	t[12], _ = bits.Add64(0, D, C)
+
+	// t < 2q, so z = t - q if t ⩾ q, else t
+	var b uint64
+	z[0], b = bits.Sub64(t[0], q0, 0)
+	z[1], b = bits.Sub64(t[1], q1, b)
+	z[2], b = bits.Sub64(t[2], q2, b)
+	z[3], b = bits.Sub64(t[3], q3, b)
+	z[4], b = bits.Sub64(t[4], q4, b)
+	z[5], b = bits.Sub64(t[5], q5, b)
+	z[6], b = bits.Sub64(t[6], q6, b)
+	z[7], b = bits.Sub64(t[7], q7, b)
+	z[8], b = bits.Sub64(t[8], q8, b)
+	z[9], b = bits.Sub64(t[9], q9, b)
+	z[10], b = bits.Sub64(t[10], q10, b)
+	z[11], b = bits.Sub64(t[11], q11, b)
+	_, b = bits.Sub64(t[12], 0, b)
+
+	// mask is all ones if t < q
+	mask := -b
+	z[0] = t[0]&mask | z[0]&^mask
+	z[1] = t[1]&mask | z[1]&^mask
+	z[2] = t[2]&mask | z[2]&^mask
+	z[3] = t[3]&mask | z[3]&^mask
+	z[4] = t[4]&mask | z[4]&^mask
+	z[5] = t[5]&mask | z[5]&^mask
+	z[6] = t[6]&mask | z[6]&^mask
+	z[7] = t[7]&mask | z[7]&^mask
+	z[8] = t[8]&mask | z[8]&^mask
+	z[9] = t[9]&mask | z[9]&^mask
+	z[10] = t[10]&mask | z[10]&^mask
+	z[11] = t[11]&mask | z[11]&^mask
+	return z
+}
+
+// AddCT z = x + y (mod q) in constant time
+func (z *Element) AddCT(x, y *Element) *Element {
+	var t [12]uint64
+	var carry uint64
+	t[0], carry = bits.Add64(x[0], y[0], 0)
+	t[1], carry = bits.Add64(x[1], y[1], carry)
+	t[2], carry = bits.Add64(x[2], y[2], carry)
+	t[3], carry = bits.Add64(x[3], y[3], carry)
+	t[4], carry = bits.Add64(x[4], y[4], carry)
+	t[5], carry = bits.Add64(x[5], y[5], carry)
+	t[6], carry = bits.Add64(x[6], y[6], carry)
+	t[7], carry = bits.Add64(x[7], y[7], carry)
+	t[8], carry = bits.Add64(x[8], y[8], carry)
+	t[9], carry = bits.Add64(x[9], y[9], carry)
+	t[10], carry = bits.Add64(x[10], y[10], carry)
+	t[11], carry = bits.Add64(x[11], y[11], carry)
+
+	// z = t - q if t ⩾ q, else t
+	var b uint64
+	z[0], b = bits.Sub64(t[0], q0, 0)
+	z[1], b = bits.Sub64(t[1], q1, b)
+	z[2], b = bits.Sub64(t[2], q2, b)
+	z[3], b = bits.Sub64(t[3], q3, b)
+	z[4], b = bits.Sub64(t[4], q4, b)
+	z[5], b = bits.Sub64(t[5], q5, b)
+	z[6], b = bits.Sub64(t[6], q6, b)
+	z[7], b = bits.Sub64(t[7], q7, b)
+	z[8], b = bits.Sub64(t[8], q8, b)
+	z[9], b = bits.Sub64(t[9], q9, b)
+	z[10], b = bits.Sub64(t[10], q10, b)
+	z[11], b = bits.Sub64(t[11], q11, b)
+	_, b = bits.Sub64(carry, 0, b)
+
+	// mask is all ones if t < q
+	mask := -b
+	z[0] = t[0]&mask | z[0]&^mask
+	z[1] = t[1]&mask | z[1]&^mask
+	z[2] = t[2]&mask | z[2]&^mask
+	z[3] = t[3]&mask | z[3]&^mask
+	z[4] = t[4]&mask | z[4]&^mask
+	z[5] = t[5]&mask | z[5]&^mask
+	z[6] = t[6]&mask | z[6]&^mask
+	z[7] = t[7]&mask | z[7]&^mask
+	z[8] = t[8]&mask | z[8]&^mask
+	z[9] = t[9]&mask | z[9]&^mask
+	z[10] = t[10]&mask | z[10]&^mask
+	z[11] = t[11]&mask | z[11]&^mask
+	return z
+}
+
+// SubCT z = x - y (mod q) in constant time
+func (z *Element) SubCT(x, y *Element) *Element {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	z[1], b = bits.Sub64(x[1], y[1], b)
+	z[2], b = bits.Sub64(x[2], y[2], b)
+	z[3], b = bits.Sub64(x[3], y[3], b)
+	z[4], b = bits.Sub64(x[4], y[4], b)
+	z[5], b = bits.Sub64(x[5], y[5], b)
+	z[6], b = bits.Sub64(x[6], y[6], b)
+	z[7], b = bits.Sub64(x[7], y[7], b)
+	z[8], b = bits.Sub64(x[8], y[8], b)
+	z[9], b = bits.Sub64(x[9], y[9], b)
+	z[10], b = bits.Sub64(x[10], y[10], b)
+	z[11], b = bits.Sub64(x[11], y[11], b)
+
+	// if x < y, add q back
+	mask := -b
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&mask, 0)
+	z[1], c = bits.Add64(z[1], q1&mask, c)
+	z[2], c = bits.Add64(z[2], q2&mask, c)
+	z[3], c = bits.Add64(z[3], q3&mask, c)
+	z[4], c = bits.Add64(z[4], q4&mask, c)
+	z[5], c = bits.Add64(z[5], q5&mask, c)
+	z[6], c = bits.Add64(z[6], q6&mask, c)
+	z[7], c = bits.Add64(z[7], q7&mask, c)
+	z[8], c = bits.Add64(z[8], q8&mask, c)
+	z[9], c = bits.Add64(z[9], q9&mask, c)
+	z[10], c = bits.Add64(z[10], q10&mask, c)
+	z[11], _ = bits.Add64(z[11], q11&mask, c)
+	return z
+}
+
+// ExpCT z = xᵏ (mod q) in constant time: the sequence of operations and memory accesses
+// depends on max(Bytes, ⌈k.BitLen()/8⌉), but not on the values of x and k.
+//
+// If k < 0, x is inverted with InverseCT; the sign of k is not hidden.
+func (z *Element) ExpCT(x Element, k *big.Int) *Element {
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+	}
+
+	// fixed window of 4 bits: table[i] = xⁱ
+	const w = 4
+	var table [1 << w]Element
+	table[0].SetOne()
+	table[1] = x
+	for i := 2; i < len(table); i++ {
+		table[i].MulCT(&table[i-1], &x)
+	}
+
+	// the bits of |k| are read from a fixed-size big-endian buffer, as big.Int.Bit branches on the length of k
+	nbBytes := Bytes
+	if l := (k.BitLen() + 7) / 8; l > nbBytes {
+		nbBytes = l
+	}
+	e := k.FillBytes(make([]byte, nbBytes))
+
+	var res, t Element
+	res.SetOne()
+	for i := 0; i < 2*nbBytes; i++ {
+		for j := 0; j < w; j++ {
+			res.MulCT(&res, &res)
+		}
+		// d is the i-th window of 4 bits, from the most significant one
+		d := uint(e[i/2]>>(w*(1-i%2))) & (1<<w - 1)
+		// t = table[d], scanning the whole table
+		for j := range table {
+			t.Select(int(uint(j)^d), &table[j], &t)
+		}
+		res.MulCT(&res, &t)
+	}
+
+	return z.Set(&res)
+}
+
+// InverseCT z = x⁻¹ (mod q) in constant time, as x^(q-2).
+// It is slower than Inverse, whose running time depends on x.
+//
+// if x == 0, sets and returns z = x
+func (z *Element) InverseCT(x *Element) *Element {
+	return z.ExpCT(*x, _bInverseCTExponentElement)
+}
+
+// SqrtCT z = √x (mod q) in constant time: the sequence of operations doesn't depend on x.
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; whether x is a square is not hidden.
+func (z *Element) SqrtCT(x *Element) *Element {
+	// q ≡ 1 (mod 4)
+	// constant-time Tonelli-Shanks, see https://www.rfc-editor.org/rfc/rfc9380#appendix-I.4
+	var y, w, t, b, c, tv, one Element
+	one.SetOne()
+
+	// w = x^((s-1)/2))
+	w.ExpCT(*x, _bSqrtCTExponentElement)
+
+	// t = xˢ = w * w * x
+	t.MulCT(&w, &w).MulCT(&t, x)
+
+	// y = x^((s+1)/2)) = w * x
+	y.MulCT(&w, x)
+
+	// c = nonResidue ^ s
+	c = Element{
+		17302715199413996045,
+		15077845457253267709,
+		8842885729139027579,
+		12189878420705505575,
+		12380986790262239346,
+		585111498723936856,
+		4947215576903759546,
+		1186632482028566920,
+		14543050817583235372,
+		5644943604719368358,
+		9440830989708189862,
+		1039766423535362,
+	}
+
+	for i := 82; i >= 2; i-- {
+		b = t
+		for j := 1; j < i-1; j++ {
+			b.MulCT(&b, &b)
+		}
+		// if b != 1, y = y * c and t = t * c²
+		notOne := int(b.NotEqual(&one))
+		tv.MulCT(&y, &c)
+		y.Select(notOne, &y, &tv)
+		c.MulCT(&c, &c)
+		tv.MulCT(&t, &c)
+		t.Select(notOne, &t, &tv)
+	}
+
+	// ensure we found y such that y * y = x
+	var square Element
+	square.MulCT(&y, &y)
+	if square.Equal(x) {
+		return z.Set(&y)
+	}
+	return nil
+}
+
 // negL negates in place [x | xHi] and return the new most significant word xHi
 func negL(x *Element, xHi uint64) uint64 {
 	var b uint64
@@ -17,6 +17,7 @@
 package iop
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -42,6 +43,23 @@ func buildPoly(size int, form Form) *Polynomial {
 }
 
 func TestDivideByXMinusOne(t *testing.T) {
+	sizeSystem := 8
+	var domains [2]*fft.Domain
+	domains[0] = fft.NewDomain(uint64(sizeSystem))
+	domains[1] = fft.NewDomain(ecc.NextPowerOfTwo(uint64(3 * sizeSystem)))
+	testDivideByXMinusOne(t, domains)
+}
+
+func TestDivideByXMinusOneMixedRadix(t *testing.T) {
+	// the small domain is not a power of 2 if the field has a root of unity of order 3,
+	// and the big domain has 4 times its size, so that its cardinality is a multiple of the small one's.
+	var domains [2]*fft.Domain
+	domains[0] = fft.NewDomainMixedRadix(6)
+	domains[1] = fft.NewDomainMixedRadix(4 * domains[0].Cardinality)
+	testDivideByXMinusOne(t, domains)
+}
+
+func testDivideByXMinusOne(t *testing.T, domains [2]*fft.Domain) {
 
 	f := func(x ...fr.Element) fr.Element {
 		var a, b fr.Element
@@ -56,7 +74,7 @@ func TestDivideByXMinusOne(t *testing.T) {
 	nbEntries := 3
 
 	// create an instance (f_i) where h holds
-	sizeSystem := 8
+	sizeSystem := int(domains[0].Cardinality)
 
 	form := Form{Basis: Lagrange, Layout: Regular}
 
@@ -86,10 +104,6 @@ func TestDivideByXMinusOne(t *testing.T) {
 	}
 
 	// compute the quotient where the entries are in Regular layout
-	var domains [2]*fft.Domain
-	domains[0] = fft.NewDomain(uint64(sizeSystem))
-	domains[1] = fft.NewDomain(ecc.NextPowerOfTwo(uint64(3 * sizeSystem)))
-
 	entries[0].ToCanonical(domains[0]).
 		ToRegular().
 		ToLagrangeCoset(domains[1]).
@@ -131,10 +145,7 @@ func TestDivideByXMinusOne(t *testing.T) {
 
 	var xnminusone, one fr.Element
 	one.SetOne()
-	xnminusone.Set(&x).
-		Square(&xnminusone).
-		Square(&xnminusone).
-		Square(&xnminusone).
+	xnminusone.Exp(x, big.NewInt(int64(sizeSystem))).
 		Sub(&xnminusone, &one)
 	qx.Mul(&qx, &xnminusone)
 	if !qx.Equal(&hx) {
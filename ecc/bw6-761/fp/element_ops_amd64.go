@@ -45,6 +45,16 @@ func reduce(res *Element)
 //go:noescape
 func Butterfly(a, b *Element)
 
+// vectorAdd res = a + b, element-wise
+func vectorAdd(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+// vectorSub res = a - b, element-wise
+func vectorSub(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+
 // Mul z = x * y (mod q)
 //
 // x and y must be less than q
@@ -47,8 +47,8 @@ func getPermutedPolynomials(sizePolynomials, nbPolynomials int) ([]*Polynomial,
 		numerator[i] = NewPolynomial(randomVector(sizePolynomials), Form{Basis: Lagrange, Layout: Regular})
 	}
 
-	// get permutation
-	sigma := getPermutation(sizePolynomials*nbPolynomials, 3)
+	// get permutation (7 is coprime with the cardinalities 2ᵃ⋅3ᵇ⋅5ᶜ of the domains)
+	sigma := getPermutation(sizePolynomials*nbPolynomials, 7)
 
 	// the denominator is the permuted version of the numerators
 	// concatenated
@@ -69,11 +69,19 @@ func getPermutedPolynomials(sizePolynomials, nbPolynomials int) ([]*Polynomial,
 }
 
 func TestBuildRatioShuffledVectors(t *testing.T) {
+	testBuildRatioShuffledVectors(t, fft.NewDomain(8))
+}
+
+func TestBuildRatioShuffledVectorsMixedRadix(t *testing.T) {
+	testBuildRatioShuffledVectors(t, fft.NewDomainMixedRadix(12))
+}
+
+func testBuildRatioShuffledVectors(t *testing.T, domain *fft.Domain) {
 
 	// generate random vectors, interpreted in Lagrange form,
 	// regular layout. It is enough for this test if TestPutInLagrangeForm
 	// passes.
-	sizePolynomials := 8
+	sizePolynomials := int(domain.Cardinality)
 	nbPolynomials := 4
 	numerator, denominator, _ := getPermutedPolynomials(sizePolynomials, nbPolynomials)
 
@@ -87,7 +95,6 @@ func TestBuildRatioShuffledVectors(t *testing.T) {
 
 	// build the ratio polynomial
 	expectedForm := Form{Basis: Lagrange, Layout: Regular}
-	domain := fft.NewDomain(uint64(sizePolynomials))
 	var beta fr.Element
 	beta.SetRandom()
 	ratio, err := BuildRatioShuffledVectors(numerator, denominator, beta, expectedForm, domain)
@@ -141,12 +148,12 @@ func TestBuildRatioShuffledVectors(t *testing.T) {
 	for i := 0; i < nbPolynomials; i++ {
 		numerator[i] = backupNumerator[i].Clone()
 		domain.FFTInverse(numerator[i].Coefficients(), fft.DIF)
-		fft.BitReverse(numerator[i].Coefficients())
+		fft.BitReverseInverse(numerator[i].Coefficients())
 		numerator[i].Basis = Canonical
 
 		denominator[i] = backupDenominator[i].Clone()
 		domain.FFTInverse(denominator[i].Coefficients(), fft.DIF)
-		fft.BitReverse(denominator[i].Coefficients())
+		fft.BitReverseInverse(denominator[i].Coefficients())
 		denominator[i].Basis = Canonical
 	}
 	{
@@ -214,11 +221,19 @@ func getInvariantEntriesUnderPermutation(sizePolynomials, nbPolynomials int) ([]
 }
 
 func TestBuildRatioCopyConstraint(t *testing.T) {
+	testBuildRatioCopyConstraint(t, fft.NewDomain(8))
+}
+
+func TestBuildRatioCopyConstraintMixedRadix(t *testing.T) {
+	testBuildRatioCopyConstraint(t, fft.NewDomainMixedRadix(12))
+}
+
+func testBuildRatioCopyConstraint(t *testing.T, domain *fft.Domain) {
 
 	// generate random vectors, interpreted in Lagrange form,
 	// regular layout. It is enough for this test if TestPutInLagrangeForm
 	// passes.
-	sizePolynomials := 8
+	sizePolynomials := int(domain.Cardinality)
 	nbPolynomials := 4
 	entries, sigma := getInvariantEntriesUnderPermutation(sizePolynomials, nbPolynomials)
 
@@ -230,7 +245,6 @@ func TestBuildRatioCopyConstraint(t *testing.T) {
 
 	// build the ratio polynomial
 	expectedForm := Form{Basis: Lagrange, Layout: Regular}
-	domain := fft.NewDomain(uint64(sizePolynomials))
 	var beta, gamma fr.Element
 	beta.SetRandom()
 	gamma.SetRandom()
@@ -287,7 +301,7 @@ func TestBuildRatioCopyConstraint(t *testing.T) {
 	for i := 0; i < nbPolynomials; i++ {
 		entries[i] = backupEntries[i].Clone()
 		domain.FFTInverse(entries[i].Coefficients(), fft.DIF)
-		fft.BitReverse(entries[i].Coefficients())
+		fft.BitReverseInverse(entries[i].Coefficients())
 		entries[i].Layout = Regular
 		entries[i].Basis = Canonical
 	}
@@ -0,0 +1,191 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cmd is the CLI interface for byoc
+package cmd
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator"
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+	"github.com/consensys/gnark-crypto/internal/generator/ecc"
+	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
+	"github.com/consensys/gnark-crypto/internal/generator/fft"
+	"github.com/consensys/gnark-crypto/internal/generator/kzg"
+	"github.com/consensys/gnark-crypto/internal/generator/pairing"
+	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "byoc",
+	Short: "byoc generates a curve package from a configuration file",
+	Run:   cmdGenerate,
+}
+
+// minFFTOrder is the smallest 2-adicity of r-1 for which fr/fft is generated
+const minFFTOrder = 10
+
+// flags
+var (
+	fConfig     string
+	fOutputDir  string
+	fImportPath string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&fConfig, "config", "c", "", "path to the JSON or YAML description of the curve")
+	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path of the curve package")
+	rootCmd.PersistentFlags().StringVarP(&fImportPath, "import-path", "i", "", "import path of the curve package (overrides the one of the configuration file)")
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(-1)
+	}
+}
+
+func cmdGenerate(cmd *cobra.Command, args []string) {
+	if fConfig == "" || fOutputDir == "" {
+		_ = cmd.Usage()
+		fmt.Printf("\nmissing argument: --config and --output are required\n")
+		os.Exit(-1)
+	}
+	if err := generate(); err != nil {
+		fmt.Printf("\n%s\n", err.Error())
+		os.Exit(-1)
+	}
+}
+
+func generate() error {
+	conf, err := config.LoadCurve(fConfig)
+	if err != nil {
+		return err
+	}
+	if fImportPath != "" {
+		conf.Custom.ImportPath = fImportPath
+		conf.FpImportPath = fImportPath + "/fp"
+		conf.FrImportPath = fImportPath + "/fr"
+	}
+	curveDir, err := filepath.Abs(fOutputDir)
+	if err != nil {
+		return err
+	}
+
+	conf.Fp, err = field.NewFieldConfig("fp", "Element", conf.FpModulus, true)
+	if err != nil {
+		return err
+	}
+	conf.Fr, err = field.NewFieldConfig("fr", "Element", conf.FrModulus, true)
+	if err != nil {
+		return err
+	}
+	conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
+	// the fields share the parallel package of the curve (generated with it if the curve is external)
+	parallelImportPath, err := strconv.Unquote(conf.ParallelImport())
+	if err != nil {
+		return err
+	}
+	conf.Fp.ParallelImportPath = parallelImportPath
+	conf.Fr.ParallelImportPath = parallelImportPath
+
+	if err := generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")); err != nil {
+		return err
+	}
+	if err := generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")); err != nil {
+		return err
+	}
+
+	// the curve templates are embedded in the generators, and written to a temporary directory for bavard
+	conf.TemplatesDir, err = os.MkdirTemp("", "byoc")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(conf.TemplatesDir)
+	for dir, templates := range map[string]embed.FS{
+		"ecc":     ecc.Templates,
+		"ecdsa":   ecdsa.Templates,
+		"fft":     fft.Templates,
+		"tower":   tower.Templates,
+		"pairing": pairing.Templates,
+		"kzg":     kzg.Templates,
+	} {
+		if err := writeFS(templates, filepath.Join(conf.TemplatesDir, dir)); err != nil {
+			return err
+		}
+	}
+
+	bgen := bavard.NewBatchGenerator("ConsenSys Software Inc.", 2020, "consensys/gnark-crypto")
+	if err := ecc.Generate(conf, curveDir, bgen); err != nil {
+		return err
+	}
+	if err := ecdsa.Generate(conf, curveDir, bgen); err != nil {
+		return err
+	}
+	if conf.Custom.FrMaxOrderRoot >= minFFTOrder {
+		if err := fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen); err != nil {
+			return err
+		}
+	}
+	if conf.Custom.Pairing != nil {
+		if err := tower.Generate(conf, filepath.Join(curveDir, "internal", "fptower"), bgen); err != nil {
+			return err
+		}
+		if err := pairing.Generate(conf, curveDir, bgen); err != nil {
+			return err
+		}
+		if err := kzg.Generate(conf, filepath.Join(curveDir, "fr", "kzg"), bgen); err != nil {
+			return err
+		}
+	}
+
+	c := exec.Command("gofmt", "-s", "-w", curveDir)
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return err
+	}
+
+	fmt.Printf("successfully generated %s in %s\n", conf.Name, curveDir)
+	return nil
+}
+
+// writeFS copies the files of fsys to dst
+func writeFS(fsys fs.FS, dst string) error {
+	return fs.WalkDir(fsys, ".", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return os.MkdirAll(filepath.Join(dst, path), 0755)
+		}
+		buf, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(dst, path), buf, 0644)
+	})
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package byoc (bring your own curve) generates a curve package from a JSON or YAML description of the curve.
+//
+// The generated package follows the layout of the curves of gnark-crypto: base and scalar fields (fp, fr),
+// G1 arithmetic, multi-exponentiation, serialization, hash-to-curve, ECDSA (ecdsa) and FFT on the scalar field (fr/fft),
+// with their tests, and the pairing for the BLS12 and BN curves.
+//
+// Example usage:
+//
+//	byoc -c ./grumpkin.yaml -o ./grumpkin
+//
+// where, for instance, grumpkin.yaml reads
+//
+//	name: grumpkin
+//	importPath: github.com/user/project/grumpkin
+//	description: Grumpkin forms a cycle with BN254.
+//	fp: "21888242871839275222246405745257275088548364400416034343698204186575808495617"
+//	fr: "21888242871839275222246405745257275088696311157297823662689037894645226208583"
+//	b: "-17"
+//	g1:
+//	  x: "1"
+//	  y: "17631683881184975370165255887551781615748388533673675138860"
+//	endomorphism:
+//	  thirdRootOne: "4407920970296243842393367215006156084916469457145843978461"
+//	  lambda: "2203960485148121921418603742825762020974279258880205651966"
+//
+// Numbers are given as strings, in base 10 or with a 0x prefix. Optional fields are package (the package name,
+// defaults to the name without dashes), endomorphism (enabling the GLV scalar multiplication), multiExpCRange,
+// and hashToCurve (either sswu with a, b, z and the isogeny, or svdw with z; defaults to SVDW).
+// The output directory must be part of the module of importPath.
+//
+// Only curves Y²=X³+b (j-invariant 0) whose base field has 2 spare bits (for the serialization flags) are supported;
+// fr/fft is generated if r-1 is divisible by 2¹⁰. Without the pairing key, the curve must be of prime order.
+// BLS12 and BN curves are described by their family and seed, and the generated package also has G2 on the sextic
+// twist, the extension tower (internal/fptower), the optimal ate pairing and KZG (fr/kzg):
+//
+//	pairing:
+//	  family: bn
+//	  x: "4965661367192848881"
+//	tower:
+//	  cubicNonResidue: [9, 1]
+//	g2:
+//	  x: ["10857046999023057135944570762232829481370756359578518086990519993285655852781", "11559732032986387107991004021392285783925812861821192530917403151452391805634"]
+//	  y: ["8495653923123431417604973247489272438418190587263600148770280649306958101930", "4082367875863433681332203403145435568316851327593401208105741076214120093531"]
+//
+// tower is optional: a small non-residue β (u² = β) and ξ = k+u are found if not given. The twist (D or M) is
+// the one the G2 generator is on. The optimizations of the built-in curves (GLV on G2, assembly, dedicated
+// subgroup checks) are not generated.
+//
+// # Warning
+//
+// The generated code is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package main
+
+import "github.com/consensys/gnark-crypto/ecc/byoc/cmd"
+
+func main() {
+	cmd.Execute()
+}
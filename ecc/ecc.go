@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package ecc provides bls12-381, bls12-377, bls12-378, bn254, bw6-761, bls24-315, bls24-317, bw6-633, bls12-378, bw6-756, secp256k1 and stark-curve elliptic curves implementation (+pairing).
+// Package ecc provides bls12-381, bls12-377, bls12-378, bn254, bw6-761, bls24-315, bls24-317, bw6-633, bls12-378, bw6-756, secp256k1, secp256r1, stark-curve, grumpkin, pallas, vesta, mnt4-298 and mnt6-298 elliptic curves implementation (+pairing).
 //
 // Also
 //
@@ -24,6 +24,7 @@ limitations under the License.
 //   - MiMC
 //   - twisted edwards "companion curves"
 //   - EdDSA (on the "companion" twisted edwards curves)
+//   - generic type constraints over the pairing-friendly curves
 package ecc
 
 import (
@@ -50,11 +51,17 @@ const (
 	BW6_756
 	STARK_CURVE
 	SECP256K1
+	GRUMPKIN
+	PALLAS
+	VESTA
+	SECP256R1
+	MNT4_298
+	MNT6_298
 )
 
 // Implemented return the list of curves fully implemented in gnark-crypto
 func Implemented() []ID {
-	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_633, BLS12_378, BW6_756, BLS24_317, STARK_CURVE, SECP256K1}
+	return []ID{BN254, BLS12_377, BLS12_381, BW6_761, BLS24_315, BW6_633, BLS12_378, BW6_756, BLS24_317, STARK_CURVE, SECP256K1, GRUMPKIN, PALLAS, VESTA, SECP256R1, MNT4_298, MNT6_298}
 }
 
 func (id ID) String() string {
@@ -100,6 +107,18 @@ func (id ID) config() *config.Curve {
 		return &config.STARK_CURVE
 	case SECP256K1:
 		return &config.SECP256K1
+	case GRUMPKIN:
+		return &config.GRUMPKIN
+	case PALLAS:
+		return &config.PALLAS
+	case VESTA:
+		return &config.VESTA
+	case SECP256R1:
+		return &config.SECP256R1
+	case MNT4_298:
+		return &config.MNT4_298
+	case MNT6_298:
+		return &config.MNT6_298
 	default:
 		panic("unimplemented ecc ID")
 	}
@@ -0,0 +1,26 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eddsa provides the Ed25519 and Ed25519ph signature schemes
+// on the edwards25519 curve, as specified in RFC 8032.
+//
+// Keys, signatures and the hashing of messages follow the RFC, so that
+// signatures are interoperable with other Ed25519 implementations.
+// Verification uses the cofactored equation [8][S]B = [8]R + [8][k]A,
+// which makes single and batch verification agree on every input.
+//
+// # See also
+//
+// https://datatracker.ietf.org/doc/html/rfc8032
+package eddsa
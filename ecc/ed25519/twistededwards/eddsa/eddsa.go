@@ -0,0 +1,308 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+	"github.com/consensys/gnark-crypto/ecc/ed25519/twistededwards"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+var errNotOnCurve = errors.New("point not on curve")
+var errSeedSize = errors.New("seed must be 32 bytes long")
+var errBatchSize = errors.New("public keys, signatures and messages must have the same length")
+
+const (
+	// SeedSize is the size in bytes of a private key seed, as in RFC 8032
+	SeedSize = 32
+
+	sizeFr         = fr.Bytes
+	sizePublicKey  = sizeFr
+	sizeSignature  = 2 * sizeFr
+	sizePrivateKey = SeedSize + sizePublicKey
+)
+
+// dom2(1, "") prefix of Ed25519ph, https://datatracker.ietf.org/doc/html/rfc8032#section-5.1
+var dom2Ph = []byte("SigEd25519 no Ed25519 collisions\x01\x00")
+
+// PublicKey eddsa signature object
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type PublicKey struct {
+	A twistededwards.PointAffine
+}
+
+// PrivateKey private key of an eddsa instance
+type PrivateKey struct {
+	PublicKey PublicKey      // copy of the associated public key
+	seed      [SeedSize]byte // private key as defined in RFC 8032
+	scalar    fr.Element     // secret scalar s, derived from the seed
+	prefix    [32]byte       // second half of SHA-512(seed), used to derive the nonces
+}
+
+// Signature represents an eddsa signature
+// cf https://en.wikipedia.org/wiki/EdDSA for notation
+type Signature struct {
+	R twistededwards.PointAffine
+	S [sizeFr]byte // little endian, as in RFC 8032
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(r io.Reader) (*PrivateKey, error) {
+	var seed [SeedSize]byte
+	if _, err := io.ReadFull(r, seed[:]); err != nil {
+		return nil, err
+	}
+	return NewKeyFromSeed(seed[:])
+}
+
+// NewKeyFromSeed derives a key pair from a 32-byte seed
+// https://datatracker.ietf.org/doc/html/rfc8032#section-5.1.5
+func NewKeyFromSeed(seed []byte) (*PrivateKey, error) {
+	if len(seed) != SeedSize {
+		return nil, errSeedSize
+	}
+	var priv PrivateKey
+	copy(priv.seed[:], seed)
+
+	h := sha512.Sum512(seed)
+
+	// prune the key
+	h[0] &= 0xF8
+	h[31] &= 0x7F
+	h[31] |= 0x40
+
+	// s is the first 32 bytes in little endian. It is reduced modulo the
+	// group order, which doesn't change [s]B.
+	var s [sizeFr]byte
+	for i := 0; i < sizeFr; i++ {
+		s[i] = h[sizeFr-1-i]
+	}
+	priv.scalar.SetBytes(s[:])
+	copy(priv.prefix[:], h[32:])
+
+	var bScalar big.Int
+	c := twistededwards.GetEdwardsCurve()
+	priv.PublicKey.A.ScalarMultiplicationCT(&c.Base, priv.scalar.BigInt(&bScalar))
+
+	return &priv, nil
+}
+
+// Seed returns the seed the private key was derived from
+func (privKey *PrivateKey) Seed() []byte {
+	res := make([]byte, SeedSize)
+	copy(res, privKey.seed[:])
+	return res
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// Sign signs a message.
+//
+// If hFunc is nil, the message is signed with Ed25519 (https://datatracker.ietf.org/doc/html/rfc8032#section-5.1.6).
+// Otherwise the message is pre-hashed with hFunc and signed with Ed25519ph, for
+// which RFC 8032 specifies hFunc = SHA-512.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	dom, msg, err := prehash(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	c := twistededwards.GetEdwardsCurve()
+
+	// r = SHA-512(dom || prefix || M) mod ℓ
+	r := hashToScalar(dom, privKey.prefix[:], msg)
+
+	// R = [r]B
+	var res Signature
+	var bR big.Int
+	res.R.ScalarMultiplicationCT(&c.Base, r.BigInt(&bR))
+
+	// S = r + k*s mod ℓ, k = SHA-512(dom || R || A || M) mod ℓ
+	k := challenge(dom, &res.R, &privKey.PublicKey.A, msg)
+	var s fr.Element
+	s.Mul(&k, &privKey.scalar).Add(&s, &r)
+	fr.LittleEndian.PutElement(&res.S, s)
+
+	return res.Bytes(), nil
+}
+
+// Verify verifies an eddsa signature
+//
+// hFunc must be the one used to sign the message, i.e. nil for Ed25519
+// or the pre-hash function for Ed25519ph.
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+
+	// verify that pubKey is on the curve
+	if !pub.A.IsOnCurve() {
+		return false, errNotOnCurve
+	}
+
+	// Deserialize the signature, this checks that R is on the curve and S < ℓ
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	s, _ := fr.LittleEndian.Element(&sig.S)
+
+	dom, msg, err := prehash(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	k := challenge(dom, &sig.R, &pub.A, msg)
+
+	// [8][S]B = [8]R + [8][k]A
+	c := twistededwards.GetEdwardsCurve()
+	var sB, kA, R twistededwards.PointExtended
+	var bs, bk big.Int
+	sB.FromAffine(&c.Base)
+	sB.ScalarMultiplication(&sB, s.BigInt(&bs))
+	kA.FromAffine(&pub.A)
+	kA.ScalarMultiplication(&kA, k.BigInt(&bk))
+	R.FromAffine(&sig.R)
+
+	sB.Add(&sB, kA.Neg(&kA))
+	sB.Add(&sB, R.Neg(&R))
+
+	return mulByCofactor(&sB).IsZero(), nil
+}
+
+// VerifyBatch verifies several signatures at once.
+//
+// It checks the random linear combination [8]([∑zᵢSᵢ]B - ∑[zᵢ]Rᵢ - ∑[zᵢkᵢ]Aᵢ) = 0
+// for random 128-bit zᵢ, and returns true iff every signature is valid, up to
+// a negligible probability. signatures[i] must be a signature of messages[i]
+// by publicKeys[i], and hFunc is as in Verify.
+func VerifyBatch(publicKeys []*PublicKey, signatures, messages [][]byte, hFunc hash.Hash) (bool, error) {
+	if len(publicKeys) != len(signatures) || len(publicKeys) != len(messages) {
+		return false, errBatchSize
+	}
+
+	var sum fr.Element
+	var identity twistededwards.PointAffine
+	var acc, tmp twistededwards.PointExtended
+	var bz big.Int
+	identity.Y.SetOne()
+	acc.FromAffine(&identity)
+
+	var zBytes [16]byte
+	for i := range publicKeys {
+		if !publicKeys[i].A.IsOnCurve() {
+			return false, errNotOnCurve
+		}
+		var sig Signature
+		if _, err := sig.SetBytes(signatures[i]); err != nil {
+			return false, err
+		}
+		s, _ := fr.LittleEndian.Element(&sig.S)
+
+		dom, msg, err := prehash(messages[i], hFunc)
+		if err != nil {
+			return false, err
+		}
+		k := challenge(dom, &sig.R, &publicKeys[i].A, msg)
+
+		if _, err := io.ReadFull(rand.Reader, zBytes[:]); err != nil {
+			return false, err
+		}
+		var z fr.Element
+		z.SetBytes(zBytes[:])
+
+		// ∑zᵢSᵢ
+		s.Mul(&s, &z)
+		sum.Add(&sum, &s)
+
+		// - [zᵢ]Rᵢ - [zᵢkᵢ]Aᵢ
+		tmp.FromAffine(&sig.R)
+		tmp.ScalarMultiplication(&tmp, z.BigInt(&bz))
+		acc.Add(&acc, tmp.Neg(&tmp))
+
+		k.Mul(&k, &z)
+		tmp.FromAffine(&publicKeys[i].A)
+		tmp.ScalarMultiplication(&tmp, k.BigInt(&bz))
+		acc.Add(&acc, tmp.Neg(&tmp))
+	}
+
+	c := twistededwards.GetEdwardsCurve()
+	tmp.FromAffine(&c.Base)
+	tmp.ScalarMultiplication(&tmp, sum.BigInt(&bz))
+	acc.Add(&acc, &tmp)
+
+	return mulByCofactor(&acc).IsZero(), nil
+}
+
+// prehash returns the domain separator and the message to sign, following
+// Ed25519 if hFunc is nil and Ed25519ph otherwise.
+func prehash(message []byte, hFunc hash.Hash) (dom, msg []byte, err error) {
+	if hFunc == nil {
+		return nil, message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, nil, err
+	}
+	return dom2Ph, hFunc.Sum(nil), nil
+}
+
+// challenge returns k = SHA-512(dom || R || A || M) mod ℓ
+func challenge(dom []byte, R, A *twistededwards.PointAffine, msg []byte) fr.Element {
+	bR := R.Bytes()
+	bA := A.Bytes()
+	return hashToScalar(dom, bR[:], bA[:], msg)
+}
+
+// hashToScalar interprets SHA-512(data[0] || data[1] || ...) as a little endian
+// integer and reduces it modulo ℓ
+func hashToScalar(data ...[]byte) fr.Element {
+	h := sha512.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+	digest := h.Sum(nil)
+	for i, j := 0, len(digest)-1; i < j; i, j = i+1, j-1 {
+		digest[i], digest[j] = digest[j], digest[i]
+	}
+	var res fr.Element
+	res.SetBytes(digest)
+	return res
+}
+
+// mulByCofactor sets p to [8]p and returns it
+func mulByCofactor(p *twistededwards.PointExtended) *twistededwards.PointExtended {
+	return p.Double(p).Double(p).Double(p)
+}
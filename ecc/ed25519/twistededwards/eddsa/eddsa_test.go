@@ -0,0 +1,358 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	crand "crypto/rand"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/twistededwards"
+)
+
+func Example() {
+	// create a eddsa key pair
+	privateKey, _ := GenerateKey(crand.Reader)
+	publicKey := privateKey.PublicKey
+
+	// sign the message with Ed25519 (no pre-hash function)
+	msg := []byte("message")
+	signature, _ := privateKey.Sign(msg, nil)
+
+	// verifies signature
+	isValid, _ := publicKey.Verify(signature, msg, nil)
+	if !isValid {
+		fmt.Println("1. invalid signature")
+	} else {
+		fmt.Println("1. valid signature")
+	}
+
+	// Output: 1. valid signature
+}
+
+// test vectors from https://datatracker.ietf.org/doc/html/rfc8032#section-7
+func TestRFC8032(t *testing.T) {
+	vectors := []struct {
+		seed, publicKey, message, signature string
+		preHashed                           bool
+	}{
+		{
+			seed:      "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60",
+			publicKey: "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			message:   "",
+			signature: "e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b",
+		},
+		{
+			seed:      "4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb",
+			publicKey: "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c",
+			message:   "72",
+			signature: "92a009a9f0d4cab8720e820b5f642540a2b27b5416503f8fb3762223ebdb69da085ac1e43e15996e458f3613d0f11d8c387b2eaeb4302aeeb00d291612bb0c00",
+		},
+		{
+			seed:      "c5aa8df43f9f837bedb7442f31dcb7b166d38535076f094b85ce3a2e0b4458f7",
+			publicKey: "fc51cd8e6218a1a38da47ed00230f0580816ed13ba3303ac5deb911548908025",
+			message:   "af82",
+			signature: "6291d657deec24024827e69c3abe01a30ce548a284743a445e3680d7db5ac3ac18ff9b538d16f290ae67f760984dc6594a7c15e9716ed28dc027beceea1ec40a",
+		},
+		{
+			// Ed25519ph, "abc"
+			seed:      "833fe62409237b9d62ec77587520911e9a759cec1d19755b7da901b96dca3d42",
+			publicKey: "ec172b93ad5e563bf4932c70e1245034c35467ef2efd4d64ebf819683467e2bf",
+			message:   "616263",
+			signature: "98a70222f0b8121aa9d30f813d683f809e462b469c7ff87639499bb94e6dae4131f85042463c2a355a2003d062adf5aaa10b8c61e636062aaad11c2a26083406",
+			preHashed: true,
+		},
+	}
+
+	for i, v := range vectors {
+		seed, _ := hex.DecodeString(v.seed)
+		message, _ := hex.DecodeString(v.message)
+		expectedPub, _ := hex.DecodeString(v.publicKey)
+		expectedSig, _ := hex.DecodeString(v.signature)
+
+		privKey, err := NewKeyFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(privKey.PublicKey.Bytes(), expectedPub) {
+			t.Fatalf("vector %d: wrong public key", i)
+		}
+
+		var pub PublicKey
+		if _, err := pub.SetBytes(expectedPub); err != nil {
+			t.Fatal(err)
+		}
+		if !pub.Equal(&privKey.PublicKey) {
+			t.Fatalf("vector %d: public key decoding failed", i)
+		}
+
+		hFunc := sha512.New()
+		if !v.preHashed {
+			hFunc = nil
+		}
+		sig, err := privKey.Sign(message, hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, expectedSig) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+		res, err := pub.Verify(expectedSig, message, hFunc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res {
+			t.Fatalf("vector %d: valid signature rejected", i)
+		}
+	}
+}
+
+func TestStdlibInterop(t *testing.T) {
+	seed := make([]byte, SeedSize)
+	for i := 0; i < 10; i++ {
+		if _, err := crand.Read(seed); err != nil {
+			t.Fatal(err)
+		}
+		stdKey := ed25519.NewKeyFromSeed(seed)
+		privKey, err := NewKeyFromSeed(seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(stdKey, privKey.Bytes()) {
+			t.Fatal("private keys differ from crypto/ed25519")
+		}
+		msg := make([]byte, i*17)
+		if _, err := crand.Read(msg); err != nil {
+			t.Fatal(err)
+		}
+		sig, err := privKey.Sign(msg, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(sig, ed25519.Sign(stdKey, msg)) {
+			t.Fatal("signatures differ from crypto/ed25519")
+		}
+	}
+}
+
+func TestSerialization(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	privKey1, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey1 := privKey1.PublicKey
+
+	privKey2, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey2 := privKey2.PublicKey
+
+	pubKeyBin1 := pubKey1.Bytes()
+	if _, err := pubKey2.SetBytes(pubKeyBin1); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(pubKeyBin1, pubKey2.Bytes()) {
+		t.Fatal("Error serialize(deserialize(.))")
+	}
+
+	privKeyBin1 := privKey1.Bytes()
+	if _, err := privKey2.SetBytes(privKeyBin1); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(privKeyBin1, privKey2.Bytes()) {
+		t.Fatal("Error serialize(deserialize(.))")
+	}
+
+	// the public key must match the seed
+	privKeyBin1[0] ^= 1
+	if _, err := privKey2.SetBytes(privKeyBin1); err == nil {
+		t.Fatal("private key with a public key not matching the seed should be rejected")
+	}
+}
+
+func TestEddsaSHA512(t *testing.T) {
+
+	src := rand.NewSource(0)
+	r := rand.New(src)
+
+	hFunc := sha512.New()
+
+	// create eddsa obj and sign a message
+	privKey, err := GenerateKey(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := privKey.PublicKey
+
+	signature, err := privKey.Sign([]byte("message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// verifies correct msg
+	res, err := pubKey.Verify(signature, []byte("message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("Verifiy correct signature should return true")
+	}
+
+	// verifies wrong msg
+	res, err = pubKey.Verify(signature, []byte("wrong_message"), hFunc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res {
+		t.Fatal("Verfiy wrong signature should be false")
+	}
+
+	// Ed25519 and Ed25519ph signatures are domain separated
+	res, err = pubKey.Verify(signature, []byte("message"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res {
+		t.Fatal("Ed25519ph signature should not verify as an Ed25519 signature")
+	}
+}
+
+func TestNonCanonicalS(t *testing.T) {
+	privKey, err := GenerateKey(crand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("message")
+	signature, err := privKey.Sign(msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// S+ℓ satisfies the verification equation but must be rejected
+	var sig Signature
+	if _, err := sig.SetBytes(signature); err != nil {
+		t.Fatal(err)
+	}
+	var s big.Int
+	sLE := sig.S
+	for i := 0; i < sizeFr/2; i++ {
+		sLE[i], sLE[sizeFr-1-i] = sLE[sizeFr-1-i], sLE[i]
+	}
+	s.SetBytes(sLE[:])
+	c := twistededwards.GetEdwardsCurve()
+	s.Add(&s, &c.Order)
+	s.FillBytes(sLE[:])
+	for i := 0; i < sizeFr; i++ {
+		signature[sizeFr+i] = sLE[sizeFr-1-i]
+	}
+
+	if _, err := privKey.PublicKey.Verify(signature, msg, nil); err == nil {
+		t.Fatal("non canonical S should be rejected")
+	}
+}
+
+func TestVerifyBatch(t *testing.T) {
+	const n = 8
+	publicKeys := make([]*PublicKey, n)
+	signatures := make([][]byte, n)
+	messages := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(crand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		publicKeys[i] = &privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("message %d", i))
+		if signatures[i], err = privKey.Sign(messages[i], nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	res, err := VerifyBatch(publicKeys, signatures, messages, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res {
+		t.Fatal("valid batch should verify")
+	}
+
+	// swapping two messages invalidates the batch
+	messages[0], messages[1] = messages[1], messages[0]
+	res, err = VerifyBatch(publicKeys, signatures, messages, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res {
+		t.Fatal("invalid batch should not verify")
+	}
+
+	if _, err := VerifyBatch(publicKeys, signatures, messages[1:], nil); err == nil {
+		t.Fatal("batch with mismatched lengths should be rejected")
+	}
+}
+
+// benchmarks
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, err := GenerateKey(crand.Reader)
+	if err != nil {
+		b.Fatal(err)
+	}
+	pubKey := privKey.PublicKey
+	msg := []byte("message")
+	signature, err := privKey.Sign(msg, nil)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pubKey.Verify(signature, msg, nil)
+	}
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	const n = 64
+	publicKeys := make([]*PublicKey, n)
+	signatures := make([][]byte, n)
+	messages := make([][]byte, n)
+	for i := 0; i < n; i++ {
+		privKey, err := GenerateKey(crand.Reader)
+		if err != nil {
+			b.Fatal(err)
+		}
+		publicKeys[i] = &privKey.PublicKey
+		messages[i] = []byte(fmt.Sprintf("message %d", i))
+		if signatures[i], err = privKey.Sign(messages[i], nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifyBatch(publicKeys, signatures, messages, nil)
+	}
+}
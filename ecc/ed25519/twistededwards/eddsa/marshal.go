@@ -0,0 +1,115 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eddsa
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+)
+
+var errSeedMismatch = errors.New("public key doesn't match the seed")
+
+// Bytes returns the binary representation of the public key
+// follows https://tools.ietf.org/html/rfc8032#section-5.1.2
+// and returns a compressed representation of the point (x,y):
+// y in little endian, with the least significant bit of x
+// in the most significant bit.
+func (pk *PublicKey) Bytes() []byte {
+	var res [sizePublicKey]byte
+	pkBin := pk.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizeFr], pkBin[:])
+	return res[:]
+}
+
+// SetBytes sets p from binary representation in buf,
+// encoded as in https://tools.ietf.org/html/rfc8032#section-5.1.2.
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	n := 0
+	if len(buf) < sizePublicKey {
+		return n, io.ErrShortBuffer
+	}
+	if _, err := pk.A.SetBytes(buf[:sizeFr]); err != nil {
+		return 0, err
+	}
+	n += sizeFr
+	return n, nil
+}
+
+// Bytes returns the binary representation of privKey
+// as seed||publicKey, as in Go's crypto/ed25519.
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [sizePrivateKey]byte
+	pubkBin := privKey.PublicKey.A.Bytes()
+	subtle.ConstantTimeCopy(1, res[:SeedSize], privKey.seed[:])
+	subtle.ConstantTimeCopy(1, res[SeedSize:], pubkBin[:])
+	return res[:]
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as seed||publicKey. The key pair is derived from the seed
+// and must match publicKey.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	k, err := NewKeyFromSeed(buf[:SeedSize])
+	if err != nil {
+		return 0, err
+	}
+	pubkBin := k.PublicKey.A.Bytes()
+	if subtle.ConstantTimeCompare(pubkBin[:], buf[SeedSize:sizePrivateKey]) != 1 {
+		return 0, errSeedMismatch
+	}
+	*privKey = *k
+	return sizePrivateKey, nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size 2*sizeFr R||S where
+// * R is the compressed point, as in PublicKey.Bytes()
+// * S=r+h(R,A,M) mod ℓ in little endian
+func (sig *Signature) Bytes() []byte {
+	var res [sizeSignature]byte
+	sigRBin := sig.R.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizeFr], sigRBin[:])
+	subtle.ConstantTimeCopy(1, res[sizeFr:], sig.S[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary, interpreted as R||S
+// (see Bytes()). Following https://tools.ietf.org/html/rfc8032#section-5.1.7,
+// it fails if R is not a valid point encoding or if S ≥ ℓ.
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	n := 0
+	if len(buf) < sizeSignature {
+		return n, io.ErrShortBuffer
+	}
+	if _, err := sig.R.SetBytes(buf[:sizeFr]); err != nil {
+		return 0, err
+	}
+	n += sizeFr
+	subtle.ConstantTimeCopy(1, sig.S[:], buf[sizeFr:2*sizeFr])
+	if _, err := fr.LittleEndian.Element(&sig.S); err != nil {
+		return n, err
+	}
+	n += sizeFr
+	return n, nil
+}
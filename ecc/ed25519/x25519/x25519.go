@@ -0,0 +1,144 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package x25519 implements the X25519 Diffie-Hellman function on Curve25519,
+// as specified in RFC 7748, using a constant-time Montgomery ladder.
+//
+// Curve25519 (v²=u³+486662u²+u) is birationally equivalent to the edwards25519
+// curve of package twistededwards, and shares its base field fp.
+//
+// # See also
+//
+// https://datatracker.ietf.org/doc/html/rfc7748
+package x25519
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/ed25519/fp"
+)
+
+const (
+	// ScalarSize is the size of the scalar input to X25519
+	ScalarSize = 32
+	// PointSize is the size of the point input to X25519
+	PointSize = fp.Bytes
+)
+
+// Basepoint is the canonical Curve25519 generator, u = 9
+var Basepoint []byte
+
+var errInputSize = errors.New("x25519: bad input length")
+var errLowOrder = errors.New("x25519: low order point")
+
+// a24 = (486662-2)/4
+var a24 fp.Element
+
+// pMinus2 is used to invert in constant time with Fermat's little theorem
+var pMinus2 big.Int
+
+func init() {
+	Basepoint = make([]byte, PointSize)
+	Basepoint[0] = 9
+
+	a24.SetUint64(121665)
+
+	pMinus2.Sub(fp.Modulus(), big.NewInt(2))
+}
+
+// X25519 returns the u-coordinate of [scalar]point, where point is the
+// u-coordinate of a point on Curve25519, following https://datatracker.ietf.org/doc/html/rfc7748#section-5
+//
+// Scalars and u-coordinates are 32-byte little endian strings. The scalar is
+// clamped, and the most significant bit of the u-coordinate is ignored.
+// X25519 returns an error if the result is the all-zero value, which happens
+// when point has a low order.
+func X25519(scalar, point []byte) ([]byte, error) {
+	if len(scalar) != ScalarSize || len(point) != PointSize {
+		return nil, errInputSize
+	}
+
+	// decode the scalar
+	var k [ScalarSize]byte
+	copy(k[:], scalar)
+	k[0] &= 248
+	k[31] &= 127
+	k[31] |= 64
+
+	// decode u, non canonical values are reduced modulo p
+	var uBE [PointSize]byte
+	for i := 0; i < PointSize; i++ {
+		uBE[i] = point[PointSize-1-i]
+	}
+	uBE[0] &= 0x7f
+	var u fp.Element
+	u.SetBytes(uBE[:])
+
+	var x2, z2, x3, z3 fp.Element
+	x2.SetOne()
+	x3.Set(&u)
+	z3.SetOne()
+
+	var A, AA, B, BB, E, C, D, DA, CB fp.Element
+	swap := 0
+	for t := 254; t >= 0; t-- {
+		kt := int(k[t/8]>>(t%8)) & 1
+		swap ^= kt
+		cswap(swap, &x2, &x3)
+		cswap(swap, &z2, &z3)
+		swap = kt
+
+		A.Add(&x2, &z2)
+		AA.Square(&A)
+		B.Sub(&x2, &z2)
+		BB.Square(&B)
+		E.Sub(&AA, &BB)
+		C.Add(&x3, &z3)
+		D.Sub(&x3, &z3)
+		DA.Mul(&D, &A)
+		CB.Mul(&C, &B)
+
+		x3.Add(&DA, &CB).Square(&x3)
+		z3.Sub(&DA, &CB).Square(&z3).Mul(&z3, &u)
+		x2.Mul(&AA, &BB)
+		z2.Mul(&a24, &E).Add(&z2, &AA).Mul(&z2, &E)
+	}
+	cswap(swap, &x2, &x3)
+	cswap(swap, &z2, &z3)
+
+	// x2 * z2^(p-2)
+	z2.Exp(z2, &pMinus2)
+	x2.Mul(&x2, &z2)
+
+	if x2.IsZero() {
+		return nil, errLowOrder
+	}
+
+	res := make([]byte, PointSize)
+	xBE := x2.Bytes()
+	for i := 0; i < PointSize; i++ {
+		res[i] = xBE[PointSize-1-i]
+	}
+	return res, nil
+}
+
+// cswap swaps a and b if swap = 1, in constant time
+func cswap(swap int, a, b *fp.Element) {
+	var t0, t1 fp.Element
+	t0.Select(swap, a, b)
+	t1.Select(swap, b, a)
+	a.Set(&t0)
+	b.Set(&t1)
+}
@@ -0,0 +1,161 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package x25519
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// test vectors from https://datatracker.ietf.org/doc/html/rfc7748#section-5.2
+func TestRFC7748(t *testing.T) {
+	vectors := []struct {
+		scalar, u, expected string
+	}{
+		{
+			scalar:   "a546e36bf0527c9d3b16154b82465edd62144c0ac1fc5a18506a2244ba449ac4",
+			u:        "e6db6867583030db3594c1a424b15f7c726624ec26b3353b10a903a6d0ab1c4c",
+			expected: "c3da55379de9c6908e94ea4df28d084f32eccf03491c71f754b4075577a28552",
+		},
+		{
+			scalar:   "4b66e9d4d1b4673c5ad22691957d6af5c11b6421e0ea01d42ca4169e7918ba0d",
+			u:        "e5210f12786811d3f4b7959d0538ae2c31dbe7106fc03c3efc4cd549c715a493",
+			expected: "95cbde9476e8907d7aade45cb4b873f88b595a68799fa152e6f8f7647aac7957",
+		},
+	}
+	for i, v := range vectors {
+		scalar, _ := hex.DecodeString(v.scalar)
+		u, _ := hex.DecodeString(v.u)
+		res, err := X25519(scalar, u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(res) != v.expected {
+			t.Fatalf("vector %d: wrong result", i)
+		}
+	}
+}
+
+func TestIterated(t *testing.T) {
+	iterations := 1000
+	expected := "684cf59ba83309552800ef566f2f4d3c1c3887c49360e3875f2eb94d99532c51"
+	if testing.Short() {
+		iterations = 1
+		expected = "422c8e7a6227d7bca1350b3e2bb7279f7897b87bb6854b783c60e80311ae3079"
+	}
+
+	k := make([]byte, ScalarSize)
+	copy(k, Basepoint)
+	u := make([]byte, PointSize)
+	copy(u, Basepoint)
+	for i := 0; i < iterations; i++ {
+		res, err := X25519(k, u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		copy(u, k)
+		copy(k, res)
+	}
+	if hex.EncodeToString(k) != expected {
+		t.Fatal("wrong result")
+	}
+}
+
+// https://datatracker.ietf.org/doc/html/rfc7748#section-6.1
+func TestDiffieHellman(t *testing.T) {
+	alicePriv, _ := hex.DecodeString("77076d0a7318a57d3c16c17251b26645df4c2f87ebc0992ab177fba51db92c2a")
+	bobPriv, _ := hex.DecodeString("5dab087e624a8a4b79e17f8b83800ee66f3bb1292618b6fd1c2f8b27ff88e0eb")
+
+	alicePub, err := X25519(alicePriv, Basepoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bobPub, err := X25519(bobPriv, Basepoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(alicePub) != "8520f0098930a754748b7ddcb43ef75a0dbf3a0d26381af4eba4a98eaa9b4e6a" {
+		t.Fatal("wrong public key")
+	}
+	if hex.EncodeToString(bobPub) != "de9edb7d7b7dc1b4d35b61c2ece435373f8343c85b78674dadfc7e146f882b4f" {
+		t.Fatal("wrong public key")
+	}
+
+	k1, err := X25519(alicePriv, bobPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	k2, err := X25519(bobPriv, alicePub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(k1, k2) || hex.EncodeToString(k1) != "4a5d9d5ba4ce2de1728e3bf480350f25e07e21c947d19e3376f09b3c1e161742" {
+		t.Fatal("wrong shared secret")
+	}
+}
+
+func TestLowOrder(t *testing.T) {
+	scalar := make([]byte, ScalarSize)
+	if _, err := rand.Read(scalar); err != nil {
+		t.Fatal(err)
+	}
+	// u = 0 and u = 1 have order 4 and 1 respectively
+	for _, b := range []byte{0, 1} {
+		u := make([]byte, PointSize)
+		u[0] = b
+		if _, err := X25519(scalar, u); err == nil {
+			t.Fatal("low order point should be rejected")
+		}
+	}
+}
+
+func TestXCryptoInterop(t *testing.T) {
+	scalar := make([]byte, ScalarSize)
+	u := make([]byte, PointSize)
+	for i := 0; i < 10; i++ {
+		if _, err := rand.Read(scalar); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := rand.Read(u); err != nil {
+			t.Fatal(err)
+		}
+		expected, err := curve25519.X25519(scalar, u)
+		if err != nil {
+			continue
+		}
+		res, err := X25519(scalar, u)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(res, expected) {
+			t.Fatal("result differs from golang.org/x/crypto/curve25519")
+		}
+	}
+}
+
+func BenchmarkX25519(b *testing.B) {
+	scalar := make([]byte, ScalarSize)
+	if _, err := rand.Read(scalar); err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		X25519(scalar, Basepoint)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright © 2020 ConsenSys
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ecc
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field"
+)
+
+// The type constraints below are satisfied by the types of every pairing-friendly
+// curve package (bn254, bls12-381, bw6-761, ...). They allow a protocol to be
+// written once as a generic function and instantiated for each curve at compile
+// time, with no runtime dispatch.
+//
+// Go has no associated types, so a generic function takes the value types as
+// explicit type parameters and the pointer constraints are inferred:
+//
+//	func Verify[
+//		Fr, G1Aff, G1Jac, G2Aff, G2Jac, GT any,
+//		FrPtr ecc.Field[Fr],
+//		G1Ptr ecc.AffinePoint[G1Aff, G1Jac, Fr],
+//		G2Ptr ecc.AffinePoint[G2Aff, G2Jac, Fr],
+//		GTPtr ecc.Target[GT],
+//		C ecc.Curve[G1Aff, G1Jac, G2Aff, G2Jac, GT],
+//	](curve C, ...) error
+//
+//	err := Verify[fr.Element, bn254.G1Affine, bn254.G1Jac, bn254.G2Affine, bn254.G2Jac, bn254.GT](bn254.Curve{}, ...)
+
+// Field is satisfied by a pointer to a prime field element, such as *fr.Element
+// or *fp.Element. See field.Element.
+type Field[E any] interface {
+	field.Element[E]
+}
+
+// AffinePoint is satisfied by a pointer to a point in affine coordinates, such
+// as *G1Affine or *G2Affine. J is the matching Jacobian type and Fr the scalar
+// field element type.
+type AffinePoint[A, J, Fr any] interface {
+	*A
+	Set(a *A) *A
+	Add(a, b *A) *A
+	Sub(a, b *A) *A
+	Double(a *A) *A
+	Neg(a *A) *A
+	ScalarMultiplication(a *A, s *big.Int) *A
+	FromJacobian(p *J) *A
+	MultiExp(points []A, scalars []Fr, config MultiExpConfig) (*A, error)
+	Equal(a *A) bool
+	IsInfinity() bool
+	IsOnCurve() bool
+	IsInSubGroup() bool
+	Marshal() []byte
+	Unmarshal(buf []byte) error
+	SetBytes(buf []byte) (int, error)
+	String() string
+}
+
+// JacobianPoint is satisfied by a pointer to a point in Jacobian coordinates,
+// such as *G1Jac or *G2Jac. A is the matching affine type and Fr the scalar
+// field element type.
+type JacobianPoint[J, A, Fr any] interface {
+	*J
+	Set(a *J) *J
+	AddAssign(a *J) *J
+	SubAssign(a *J) *J
+	AddMixed(a *A) *J
+	Double(q *J) *J
+	DoubleAssign() *J
+	Neg(a *J) *J
+	ScalarMultiplication(a *J, s *big.Int) *J
+	FromAffine(q *A) *J
+	MultiExp(points []A, scalars []Fr, config MultiExpConfig) (*J, error)
+	Equal(a *J) bool
+	IsOnCurve() bool
+	IsInSubGroup() bool
+	String() string
+}
+
+// Target is satisfied by a pointer to an element of the pairing target group GT.
+type Target[T any] interface {
+	*T
+	Set(x *T) *T
+	SetOne() *T
+	SetRandom() (*T, error)
+	SetBytes(e []byte) error
+	Mul(x, y *T) *T
+	Square(x *T) *T
+	Inverse(x *T) *T
+	Conjugate(x *T) *T
+	Exp(x T, k *big.Int) *T
+	CyclotomicExp(x T, k *big.Int) *T
+	Equal(x *T) bool
+	IsOne() bool
+	IsInSubGroup() bool
+	String() string
+}
+
+// Curve is satisfied by the zero-sized Curve type of each pairing-friendly curve
+// package (e.g. bn254.Curve). It gives generic code access to the package-level
+// pairing functions.
+type Curve[G1Aff, G1Jac, G2Aff, G2Jac, GT any] interface {
+	ID() ID
+	Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Aff, g2Aff G2Aff)
+	Pair(P []G1Aff, Q []G2Aff) (GT, error)
+	PairingCheck(P []G1Aff, Q []G2Aff) (bool, error)
+	MillerLoop(P []G1Aff, Q []G2Aff) (GT, error)
+	FinalExponentiation(z *GT, _z ...*GT) GT
+}
@@ -0,0 +1,168 @@
+package ecc_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378"
+	fr_bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317"
+	fr_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633"
+	fr_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr"
+	bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756"
+	fr_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	mnt4298 "github.com/consensys/gnark-crypto/ecc/mnt4-298"
+	fr_mnt4298 "github.com/consensys/gnark-crypto/ecc/mnt4-298/fr"
+	mnt6298 "github.com/consensys/gnark-crypto/ecc/mnt6-298"
+	fr_mnt6298 "github.com/consensys/gnark-crypto/ecc/mnt6-298/fr"
+)
+
+// checkGeneric exercises a pairing curve through the ecc type constraints only.
+func checkGeneric[
+	Fr, G1Aff, G1Jac, G2Aff, G2Jac, GT any,
+	FrPtr ecc.Field[Fr],
+	G1AffPtr ecc.AffinePoint[G1Aff, G1Jac, Fr],
+	G1JacPtr ecc.JacobianPoint[G1Jac, G1Aff, Fr],
+	G2AffPtr ecc.AffinePoint[G2Aff, G2Jac, Fr],
+	G2JacPtr ecc.JacobianPoint[G2Jac, G2Aff, Fr],
+	GTPtr ecc.Target[GT],
+	C ecc.Curve[G1Aff, G1Jac, G2Aff, G2Jac, GT],
+](t *testing.T, curve C) {
+	t.Helper()
+
+	const nbPoints = 4
+	_, g2Jac, g1, g2 := curve.Generators()
+
+	// random scalars
+	var a, b Fr
+	var ab big.Int
+	if _, err := FrPtr(&a).SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FrPtr(&b).SetRandom(); err != nil {
+		t.Fatal(err)
+	}
+	_a := FrPtr(&a).BigInt(new(big.Int))
+	_b := FrPtr(&b).BigInt(new(big.Int))
+	ab.Mul(_a, _b).Mod(&ab, curve.ID().ScalarField())
+
+	// e([a]g1, [b]g2) == e([ab]g1, g2)
+	var ag1, abg1 G1Aff
+	var bg2 G2Aff
+	G1AffPtr(&ag1).ScalarMultiplication(&g1, _a)
+	G1AffPtr(&abg1).ScalarMultiplication(&g1, &ab)
+	var bg2Jac G2Jac
+	G2JacPtr(&bg2Jac).ScalarMultiplication(&g2Jac, _b)
+	G2AffPtr(&bg2).FromJacobian(&bg2Jac)
+	if !G2AffPtr(&bg2).IsInSubGroup() {
+		t.Fatal("[b]g2 not in G2")
+	}
+
+	left, err := curve.Pair([]G1Aff{ag1}, []G2Aff{bg2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	right, err := curve.Pair([]G1Aff{abg1}, []G2Aff{g2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !GTPtr(&left).Equal(&right) || GTPtr(&left).IsOne() || !GTPtr(&left).IsInSubGroup() {
+		t.Fatal("bilinearity check failed")
+	}
+
+	// e([a]g1, [b]g2) · e(-[ab]g1, g2) == 1
+	var negAbg1 G1Aff
+	G1AffPtr(&negAbg1).Neg(&abg1)
+	ok, err := curve.PairingCheck([]G1Aff{ag1, negAbg1}, []G2Aff{bg2, g2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("pairing check failed")
+	}
+
+	// FinalExponentiation(MillerLoop) == Pair
+	ml, err := curve.MillerLoop([]G1Aff{ag1}, []G2Aff{bg2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fe := curve.FinalExponentiation(&ml); !GTPtr(&fe).Equal(&left) {
+		t.Fatal("FinalExponentiation(MillerLoop) != Pair")
+	}
+
+	// MultiExp(g1, [1, 2, 3, ...]) == [1 + 2 + 3 + ...]g1
+	points := make([]G1Aff, nbPoints)
+	scalars := make([]Fr, nbPoints)
+	for i := 0; i < nbPoints; i++ {
+		G1AffPtr(&points[i]).Set(&g1)
+		FrPtr(&scalars[i]).SetUint64(uint64(i + 1))
+	}
+	var msm, expected G1Jac
+	if _, err := G1JacPtr(&msm).MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	G1JacPtr(&expected).FromAffine(&g1)
+	G1JacPtr(&expected).ScalarMultiplication(&expected, big.NewInt(nbPoints*(nbPoints+1)/2))
+	if !G1JacPtr(&msm).Equal(&expected) {
+		t.Fatal("MultiExp mismatch")
+	}
+
+	// serialization round trip
+	var decoded G1Aff
+	if err := G1AffPtr(&decoded).Unmarshal(G1AffPtr(&ag1).Marshal()); err != nil {
+		t.Fatal(err)
+	}
+	if !G1AffPtr(&decoded).Equal(&ag1) {
+		t.Fatal("Unmarshal(Marshal) mismatch")
+	}
+}
+
+func TestGenericCurve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("bn254", func(t *testing.T) {
+		checkGeneric[fr_bn254.Element, bn254.G1Affine, bn254.G1Jac, bn254.G2Affine, bn254.G2Jac, bn254.GT](t, bn254.Curve{})
+	})
+	t.Run("bls12-377", func(t *testing.T) {
+		checkGeneric[fr_bls12377.Element, bls12377.G1Affine, bls12377.G1Jac, bls12377.G2Affine, bls12377.G2Jac, bls12377.GT](t, bls12377.Curve{})
+	})
+	t.Run("bls12-378", func(t *testing.T) {
+		checkGeneric[fr_bls12378.Element, bls12378.G1Affine, bls12378.G1Jac, bls12378.G2Affine, bls12378.G2Jac, bls12378.GT](t, bls12378.Curve{})
+	})
+	t.Run("bls12-381", func(t *testing.T) {
+		checkGeneric[fr_bls12381.Element, bls12381.G1Affine, bls12381.G1Jac, bls12381.G2Affine, bls12381.G2Jac, bls12381.GT](t, bls12381.Curve{})
+	})
+	t.Run("bls24-315", func(t *testing.T) {
+		checkGeneric[fr_bls24315.Element, bls24315.G1Affine, bls24315.G1Jac, bls24315.G2Affine, bls24315.G2Jac, bls24315.GT](t, bls24315.Curve{})
+	})
+	t.Run("bls24-317", func(t *testing.T) {
+		checkGeneric[fr_bls24317.Element, bls24317.G1Affine, bls24317.G1Jac, bls24317.G2Affine, bls24317.G2Jac, bls24317.GT](t, bls24317.Curve{})
+	})
+	t.Run("bw6-761", func(t *testing.T) {
+		checkGeneric[fr_bw6761.Element, bw6761.G1Affine, bw6761.G1Jac, bw6761.G2Affine, bw6761.G2Jac, bw6761.GT](t, bw6761.Curve{})
+	})
+	t.Run("bw6-633", func(t *testing.T) {
+		checkGeneric[fr_bw6633.Element, bw6633.G1Affine, bw6633.G1Jac, bw6633.G2Affine, bw6633.G2Jac, bw6633.GT](t, bw6633.Curve{})
+	})
+	t.Run("bw6-756", func(t *testing.T) {
+		checkGeneric[fr_bw6756.Element, bw6756.G1Affine, bw6756.G1Jac, bw6756.G2Affine, bw6756.G2Jac, bw6756.GT](t, bw6756.Curve{})
+	})
+	t.Run("mnt4-298", func(t *testing.T) {
+		checkGeneric[fr_mnt4298.Element, mnt4298.G1Affine, mnt4298.G1Jac, mnt4298.G2Affine, mnt4298.G2Jac, mnt4298.GT](t, mnt4298.Curve{})
+	})
+	t.Run("mnt6-298", func(t *testing.T) {
+		checkGeneric[fr_mnt6298.Element, mnt6298.G1Affine, mnt6298.G1Jac, mnt6298.G2Affine, mnt6298.G2Jac, mnt6298.GT](t, mnt6298.Curve{})
+	})
+}
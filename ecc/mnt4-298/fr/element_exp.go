@@ -0,0 +1,980 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, 779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f24bad5a77686694)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10101    = _10 + _10011
+	//	_10111    = _10 + _10101
+	//	_11001    = _10 + _10111
+	//	_11011    = _10 + _11001
+	//	_11101    = _10 + _11011
+	//	_11101000 = _11101 << 3
+	//	_11101111 = _111 + _11101000
+	//	i37       = ((_11101111 << 6 + _1111) << 5 + _1111) << 5
+	//	i52       = ((_1111 + i37) << 6 + _1101) << 6 + _10001
+	//	i71       = ((i52 << 5 + _11001) << 4 + _1101) << 8
+	//	i85       = ((_10011 + i71) << 4 + _11) << 7 + _1001
+	//	i104      = ((i85 << 7 + _10011) << 4 + _1011) << 6
+	//	i122      = ((_10011 + i104) << 5 + _11011) << 10 + _10101
+	//	i143      = ((i122 << 7 + _10001) << 5 + _11011) << 7
+	//	i155      = ((_10101 + i143) << 5 + _11011) << 4 + _1011
+	//	i173      = ((i155 << 6 + _1001) << 3 + _11) << 7
+	//	i186      = ((_1101 + i173) << 4 + _111) << 6 + _1111
+	//	i210      = ((i186 << 6 + _10001) << 10 + _11001) << 6
+	//	i221      = ((_10011 + i210) << 5 + _11101) << 3 + _101
+	//	i246      = ((i221 << 6 + _1001) << 6 + _10011) << 11
+	//	i262      = ((_1111 + i246) << 6 + _1001) << 7 + _10111
+	//	i278      = ((i262 << 5 + _1011) << 6 + _10101) << 3
+	//	i293      = ((_101 + i278) << 7 + _11101) << 5 + _11011
+	//	i309      = ((i293 << 2 + 1) << 9 + _11001) << 3
+	//	return      ((_101 + i309) << 5 + _101) << 2
+	//
+	// Operations: 259 squares 59 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t2 = x^0x2
+	t2.Square(&x)
+
+	// Step 2: t12 = x^0x3
+	t12.Mul(&x, t2)
+
+	// Step 3: z = x^0x5
+	z.Mul(t2, t12)
+
+	// Step 4: t10 = x^0x7
+	t10.Mul(t2, z)
+
+	// Step 5: t6 = x^0x9
+	t6.Mul(t2, t10)
+
+	// Step 6: t4 = x^0xb
+	t4.Mul(t2, t6)
+
+	// Step 7: t11 = x^0xd
+	t11.Mul(t2, t4)
+
+	// Step 8: t7 = x^0xf
+	t7.Mul(t2, t11)
+
+	// Step 9: t9 = x^0x11
+	t9.Mul(t2, t7)
+
+	// Step 10: t8 = x^0x13
+	t8.Mul(t2, t9)
+
+	// Step 11: t3 = x^0x15
+	t3.Mul(t2, t8)
+
+	// Step 12: t5 = x^0x17
+	t5.Mul(t2, t3)
+
+	// Step 13: t0 = x^0x19
+	t0.Mul(t2, t5)
+
+	// Step 14: t1 = x^0x1b
+	t1.Mul(t2, t0)
+
+	// Step 15: t2 = x^0x1d
+	t2.Mul(t2, t1)
+
+	// Step 18: t13 = x^0xe8
+	t13.Square(t2)
+	for s := 1; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 19: t13 = x^0xef
+	t13.Mul(t10, t13)
+
+	// Step 25: t13 = x^0x3bc0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 26: t13 = x^0x3bcf
+	t13.Mul(t7, t13)
+
+	// Step 31: t13 = x^0x779e0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 32: t13 = x^0x779ef
+	t13.Mul(t7, t13)
+
+	// Step 37: t13 = x^0xef3de0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 38: t13 = x^0xef3def
+	t13.Mul(t7, t13)
+
+	// Step 44: t13 = x^0x3bcf7bc0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 45: t13 = x^0x3bcf7bcd
+	t13.Mul(t11, t13)
+
+	// Step 51: t13 = x^0xef3def340
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 52: t13 = x^0xef3def351
+	t13.Mul(t9, t13)
+
+	// Step 57: t13 = x^0x1de7bde6a20
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 58: t13 = x^0x1de7bde6a39
+	t13.Mul(t0, t13)
+
+	// Step 62: t13 = x^0x1de7bde6a390
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 63: t13 = x^0x1de7bde6a39d
+	t13.Mul(t11, t13)
+
+	// Step 71: t13 = x^0x1de7bde6a39d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 72: t13 = x^0x1de7bde6a39d13
+	t13.Mul(t8, t13)
+
+	// Step 76: t13 = x^0x1de7bde6a39d130
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 77: t13 = x^0x1de7bde6a39d133
+	t13.Mul(t12, t13)
+
+	// Step 84: t13 = x^0xef3def351ce89980
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 85: t13 = x^0xef3def351ce89989
+	t13.Mul(t6, t13)
+
+	// Step 92: t13 = x^0x779ef79a8e744cc480
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 93: t13 = x^0x779ef79a8e744cc493
+	t13.Mul(t8, t13)
+
+	// Step 97: t13 = x^0x779ef79a8e744cc4930
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 98: t13 = x^0x779ef79a8e744cc493b
+	t13.Mul(t4, t13)
+
+	// Step 104: t13 = x^0x1de7bde6a39d133124ec0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 105: t13 = x^0x1de7bde6a39d133124ed3
+	t13.Mul(t8, t13)
+
+	// Step 110: t13 = x^0x3bcf7bcd473a266249da60
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 111: t13 = x^0x3bcf7bcd473a266249da7b
+	t13.Mul(t1, t13)
+
+	// Step 121: t13 = x^0xef3def351ce899892769ec00
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 122: t13 = x^0xef3def351ce899892769ec15
+	t13.Mul(t3, t13)
+
+	// Step 129: t13 = x^0x779ef79a8e744cc493b4f60a80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 130: t13 = x^0x779ef79a8e744cc493b4f60a91
+	t13.Mul(t9, t13)
+
+	// Step 135: t13 = x^0xef3def351ce899892769ec15220
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 136: t13 = x^0xef3def351ce899892769ec1523b
+	t13.Mul(t1, t13)
+
+	// Step 143: t13 = x^0x779ef79a8e744cc493b4f60a91d80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 144: t13 = x^0x779ef79a8e744cc493b4f60a91d95
+	t13.Mul(t3, t13)
+
+	// Step 149: t13 = x^0xef3def351ce899892769ec1523b2a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 150: t13 = x^0xef3def351ce899892769ec1523b2bb
+	t13.Mul(t1, t13)
+
+	// Step 154: t13 = x^0xef3def351ce899892769ec1523b2bb0
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 155: t13 = x^0xef3def351ce899892769ec1523b2bbb
+	t13.Mul(t4, t13)
+
+	// Step 161: t13 = x^0x3bcf7bcd473a266249da7b0548ecaeec0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 162: t13 = x^0x3bcf7bcd473a266249da7b0548ecaeec9
+	t13.Mul(t6, t13)
+
+	// Step 165: t13 = x^0x1de7bde6a39d133124ed3d82a476577648
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 166: t12 = x^0x1de7bde6a39d133124ed3d82a47657764b
+	t12.Mul(t12, t13)
+
+	// Step 173: t12 = x^0xef3def351ce899892769ec1523b2bbb2580
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 174: t11 = x^0xef3def351ce899892769ec1523b2bbb258d
+	t11.Mul(t11, t12)
+
+	// Step 178: t11 = x^0xef3def351ce899892769ec1523b2bbb258d0
+	for s := 0; s < 4; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 179: t10 = x^0xef3def351ce899892769ec1523b2bbb258d7
+	t10.Mul(t10, t11)
+
+	// Step 185: t10 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 186: t10 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf
+	t10.Mul(t7, t10)
+
+	// Step 192: t10 = x^0xef3def351ce899892769ec1523b2bbb258d73c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 193: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d1
+	t9.Mul(t9, t10)
+
+	// Step 203: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf4400
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 204: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf4419
+	t9.Mul(t0, t9)
+
+	// Step 210: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10640
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 211: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653
+	t9.Mul(t8, t9)
+
+	// Step 216: t9 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca60
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 217: t9 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7d
+	t9.Mul(t2, t9)
+
+	// Step 220: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653e8
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 221: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed
+	t9.Mul(z, t9)
+
+	// Step 227: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb40
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 228: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb49
+	t9.Mul(t6, t9)
+
+	// Step 234: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed240
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 235: t8 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed253
+	t8.Mul(t8, t9)
+
+	// Step 246: t8 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f6929800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 247: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f
+	t7.Mul(t7, t8)
+
+	// Step 253: t7 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 254: t6 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c9
+	t6.Mul(t6, t7)
+
+	// Step 261: t6 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e480
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 262: t5 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e497
+	t5.Mul(t5, t6)
+
+	// Step 267: t5 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92e0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 268: t4 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb
+	t4.Mul(t4, t5)
+
+	// Step 274: t4 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f24bac0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 275: t3 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f24bad5
+	t3.Mul(t3, t4)
+
+	// Step 278: t3 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6a8
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 279: t3 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad
+	t3.Mul(z, t3)
+
+	// Step 286: t3 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb5680
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 287: t2 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569d
+	t2.Mul(t2, t3)
+
+	// Step 292: t2 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad3a0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 293: t1 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad3bb
+	t1.Mul(t1, t2)
+
+	// Step 295: t1 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eec
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 296: t1 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed
+	t1.Mul(&x, t1)
+
+	// Step 305: t1 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 306: t0 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19
+	t0.Mul(t0, t1)
+
+	// Step 309: t0 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed0c8
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 310: t0 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed0cd
+	t0.Mul(z, t0)
+
+	// Step 315: t0 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19a0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 316: z = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19a5
+	z.Mul(z, t0)
+
+	// Step 318: z = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f24bad5a77686694
+	for s := 0; s < 2; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19a5200000000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_111      = _10 + _101
+	//	_1001     = _10 + _111
+	//	_1011     = _10 + _1001
+	//	_1101     = _10 + _1011
+	//	_1111     = _10 + _1101
+	//	_10001    = _10 + _1111
+	//	_10011    = _10 + _10001
+	//	_10101    = _10 + _10011
+	//	_10111    = _10 + _10101
+	//	_11001    = _10 + _10111
+	//	_11011    = _10 + _11001
+	//	_11101    = _10 + _11011
+	//	_11101000 = _11101 << 3
+	//	_11101111 = _111 + _11101000
+	//	i37       = ((_11101111 << 6 + _1111) << 5 + _1111) << 5
+	//	i52       = ((_1111 + i37) << 6 + _1101) << 6 + _10001
+	//	i71       = ((i52 << 5 + _11001) << 4 + _1101) << 8
+	//	i85       = ((_10011 + i71) << 4 + _11) << 7 + _1001
+	//	i104      = ((i85 << 7 + _10011) << 4 + _1011) << 6
+	//	i122      = ((_10011 + i104) << 5 + _11011) << 10 + _10101
+	//	i143      = ((i122 << 7 + _10001) << 5 + _11011) << 7
+	//	i155      = ((_10101 + i143) << 5 + _11011) << 4 + _1011
+	//	i173      = ((i155 << 6 + _1001) << 3 + _11) << 7
+	//	i186      = ((_1101 + i173) << 4 + _111) << 6 + _1111
+	//	i210      = ((i186 << 6 + _10001) << 10 + _11001) << 6
+	//	i221      = ((_10011 + i210) << 5 + _11101) << 3 + _101
+	//	i246      = ((i221 << 6 + _1001) << 6 + _10011) << 11
+	//	i262      = ((_1111 + i246) << 6 + _1001) << 7 + _10111
+	//	i278      = ((i262 << 5 + _1011) << 6 + _10101) << 3
+	//	i293      = ((_101 + i278) << 7 + _11101) << 5 + _11011
+	//	i309      = ((i293 << 2 + 1) << 9 + _11001) << 3
+	//	i320      = ((_101 + i309) << 5 + _101) << 3 + 1
+	//	return      i320 << 33
+	//
+	// Operations: 293 squares 60 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13 Element
+	// Step 1: t2 = x^0x2
+	t2.Square(&x)
+
+	// Step 2: t12 = x^0x3
+	t12.Mul(&x, t2)
+
+	// Step 3: z = x^0x5
+	z.Mul(t2, t12)
+
+	// Step 4: t10 = x^0x7
+	t10.Mul(t2, z)
+
+	// Step 5: t6 = x^0x9
+	t6.Mul(t2, t10)
+
+	// Step 6: t4 = x^0xb
+	t4.Mul(t2, t6)
+
+	// Step 7: t11 = x^0xd
+	t11.Mul(t2, t4)
+
+	// Step 8: t7 = x^0xf
+	t7.Mul(t2, t11)
+
+	// Step 9: t9 = x^0x11
+	t9.Mul(t2, t7)
+
+	// Step 10: t8 = x^0x13
+	t8.Mul(t2, t9)
+
+	// Step 11: t3 = x^0x15
+	t3.Mul(t2, t8)
+
+	// Step 12: t5 = x^0x17
+	t5.Mul(t2, t3)
+
+	// Step 13: t0 = x^0x19
+	t0.Mul(t2, t5)
+
+	// Step 14: t1 = x^0x1b
+	t1.Mul(t2, t0)
+
+	// Step 15: t2 = x^0x1d
+	t2.Mul(t2, t1)
+
+	// Step 18: t13 = x^0xe8
+	t13.Square(t2)
+	for s := 1; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 19: t13 = x^0xef
+	t13.Mul(t10, t13)
+
+	// Step 25: t13 = x^0x3bc0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 26: t13 = x^0x3bcf
+	t13.Mul(t7, t13)
+
+	// Step 31: t13 = x^0x779e0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 32: t13 = x^0x779ef
+	t13.Mul(t7, t13)
+
+	// Step 37: t13 = x^0xef3de0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 38: t13 = x^0xef3def
+	t13.Mul(t7, t13)
+
+	// Step 44: t13 = x^0x3bcf7bc0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 45: t13 = x^0x3bcf7bcd
+	t13.Mul(t11, t13)
+
+	// Step 51: t13 = x^0xef3def340
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 52: t13 = x^0xef3def351
+	t13.Mul(t9, t13)
+
+	// Step 57: t13 = x^0x1de7bde6a20
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 58: t13 = x^0x1de7bde6a39
+	t13.Mul(t0, t13)
+
+	// Step 62: t13 = x^0x1de7bde6a390
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 63: t13 = x^0x1de7bde6a39d
+	t13.Mul(t11, t13)
+
+	// Step 71: t13 = x^0x1de7bde6a39d00
+	for s := 0; s < 8; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 72: t13 = x^0x1de7bde6a39d13
+	t13.Mul(t8, t13)
+
+	// Step 76: t13 = x^0x1de7bde6a39d130
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 77: t13 = x^0x1de7bde6a39d133
+	t13.Mul(t12, t13)
+
+	// Step 84: t13 = x^0xef3def351ce89980
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 85: t13 = x^0xef3def351ce89989
+	t13.Mul(t6, t13)
+
+	// Step 92: t13 = x^0x779ef79a8e744cc480
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 93: t13 = x^0x779ef79a8e744cc493
+	t13.Mul(t8, t13)
+
+	// Step 97: t13 = x^0x779ef79a8e744cc4930
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 98: t13 = x^0x779ef79a8e744cc493b
+	t13.Mul(t4, t13)
+
+	// Step 104: t13 = x^0x1de7bde6a39d133124ec0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 105: t13 = x^0x1de7bde6a39d133124ed3
+	t13.Mul(t8, t13)
+
+	// Step 110: t13 = x^0x3bcf7bcd473a266249da60
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 111: t13 = x^0x3bcf7bcd473a266249da7b
+	t13.Mul(t1, t13)
+
+	// Step 121: t13 = x^0xef3def351ce899892769ec00
+	for s := 0; s < 10; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 122: t13 = x^0xef3def351ce899892769ec15
+	t13.Mul(t3, t13)
+
+	// Step 129: t13 = x^0x779ef79a8e744cc493b4f60a80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 130: t13 = x^0x779ef79a8e744cc493b4f60a91
+	t13.Mul(t9, t13)
+
+	// Step 135: t13 = x^0xef3def351ce899892769ec15220
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 136: t13 = x^0xef3def351ce899892769ec1523b
+	t13.Mul(t1, t13)
+
+	// Step 143: t13 = x^0x779ef79a8e744cc493b4f60a91d80
+	for s := 0; s < 7; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 144: t13 = x^0x779ef79a8e744cc493b4f60a91d95
+	t13.Mul(t3, t13)
+
+	// Step 149: t13 = x^0xef3def351ce899892769ec1523b2a0
+	for s := 0; s < 5; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 150: t13 = x^0xef3def351ce899892769ec1523b2bb
+	t13.Mul(t1, t13)
+
+	// Step 154: t13 = x^0xef3def351ce899892769ec1523b2bb0
+	for s := 0; s < 4; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 155: t13 = x^0xef3def351ce899892769ec1523b2bbb
+	t13.Mul(t4, t13)
+
+	// Step 161: t13 = x^0x3bcf7bcd473a266249da7b0548ecaeec0
+	for s := 0; s < 6; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 162: t13 = x^0x3bcf7bcd473a266249da7b0548ecaeec9
+	t13.Mul(t6, t13)
+
+	// Step 165: t13 = x^0x1de7bde6a39d133124ed3d82a476577648
+	for s := 0; s < 3; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 166: t12 = x^0x1de7bde6a39d133124ed3d82a47657764b
+	t12.Mul(t12, t13)
+
+	// Step 173: t12 = x^0xef3def351ce899892769ec1523b2bbb2580
+	for s := 0; s < 7; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 174: t11 = x^0xef3def351ce899892769ec1523b2bbb258d
+	t11.Mul(t11, t12)
+
+	// Step 178: t11 = x^0xef3def351ce899892769ec1523b2bbb258d0
+	for s := 0; s < 4; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 179: t10 = x^0xef3def351ce899892769ec1523b2bbb258d7
+	t10.Mul(t10, t11)
+
+	// Step 185: t10 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 186: t10 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf
+	t10.Mul(t7, t10)
+
+	// Step 192: t10 = x^0xef3def351ce899892769ec1523b2bbb258d73c0
+	for s := 0; s < 6; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 193: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d1
+	t9.Mul(t9, t10)
+
+	// Step 203: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf4400
+	for s := 0; s < 10; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 204: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf4419
+	t9.Mul(t0, t9)
+
+	// Step 210: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10640
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 211: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653
+	t9.Mul(t8, t9)
+
+	// Step 216: t9 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca60
+	for s := 0; s < 5; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 217: t9 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7d
+	t9.Mul(t2, t9)
+
+	// Step 220: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653e8
+	for s := 0; s < 3; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 221: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed
+	t9.Mul(z, t9)
+
+	// Step 227: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb40
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 228: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb49
+	t9.Mul(t6, t9)
+
+	// Step 234: t9 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed240
+	for s := 0; s < 6; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 235: t8 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed253
+	t8.Mul(t8, t9)
+
+	// Step 246: t8 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f6929800
+	for s := 0; s < 11; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 247: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f
+	t7.Mul(t7, t8)
+
+	// Step 253: t7 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c0
+	for s := 0; s < 6; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 254: t6 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c9
+	t6.Mul(t6, t7)
+
+	// Step 261: t6 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e480
+	for s := 0; s < 7; s++ {
+		t6.Square(t6)
+	}
+
+	// Step 262: t5 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e497
+	t5.Mul(t5, t6)
+
+	// Step 267: t5 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92e0
+	for s := 0; s < 5; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 268: t4 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb
+	t4.Mul(t4, t5)
+
+	// Step 274: t4 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f24bac0
+	for s := 0; s < 6; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 275: t3 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6b9e88329f692980f24bad5
+	t3.Mul(t3, t4)
+
+	// Step 278: t3 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6a8
+	for s := 0; s < 3; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 279: t3 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad
+	t3.Mul(z, t3)
+
+	// Step 286: t3 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb5680
+	for s := 0; s < 7; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 287: t2 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569d
+	t2.Mul(t2, t3)
+
+	// Step 292: t2 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad3a0
+	for s := 0; s < 5; s++ {
+		t2.Square(t2)
+	}
+
+	// Step 293: t1 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad3bb
+	t1.Mul(t1, t2)
+
+	// Step 295: t1 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eec
+	for s := 0; s < 2; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 296: t1 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed
+	t1.Mul(&x, t1)
+
+	// Step 305: t1 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda00
+	for s := 0; s < 9; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 306: t0 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19
+	t0.Mul(t0, t1)
+
+	// Step 309: t0 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed0c8
+	for s := 0; s < 3; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 310: t0 = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed0cd
+	t0.Mul(z, t0)
+
+	// Step 315: t0 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19a0
+	for s := 0; s < 5; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 316: z = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19a5
+	z.Mul(z, t0)
+
+	// Step 319: z = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed0cd28
+	for s := 0; s < 3; s++ {
+		z.Square(z)
+	}
+
+	// Step 320: z = x^0xef3def351ce899892769ec1523b2bbb258d73d10653ed25301e4975ab4eed0cd29
+	z.Mul(&x, z)
+
+	// Step 353: z = x^0x1de7bde6a39d133124ed3d82a47657764b1ae7a20ca7da4a603c92eb569dda19a5200000000
+	for s := 0; s < 33; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
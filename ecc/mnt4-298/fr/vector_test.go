@@ -0,0 +1,177 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestVectorSort(t *testing.T) {
+	assert := require.New(t)
+
+	v := make(Vector, 3)
+	v[0].SetUint64(2)
+	v[1].SetUint64(3)
+	v[2].SetUint64(1)
+
+	sort.Sort(v)
+
+	assert.Equal("[1,2,3]", v.String())
+}
+
+func TestVectorRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 3)
+	v1[0].SetUint64(2)
+	v1[1].SetUint64(3)
+	v1[2].SetUint64(1)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+}
+
+func TestVectorEmptyRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	v1 := make(Vector, 0)
+
+	b, err := v1.MarshalBinary()
+	assert.NoError(err)
+
+	var v2 Vector
+
+	err = v2.UnmarshalBinary(b)
+	assert.NoError(err)
+
+	assert.True(reflect.DeepEqual(v1, v2))
+}
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	for _, size := range []int{0, 1, 2, 7, 64, 257} {
+		a, b := randomVector(size), randomVector(size)
+		if size > 1 {
+			// edge cases for the reduction
+			a[0].SetOne()
+			a[0].Neg(&a[0]) // q - 1
+			b[0] = a[0]
+			a[1].SetZero()
+			b[1].SetOne()
+		}
+		var c Element
+		c.SetRandom()
+
+		// results must match the generic (purego) path
+		expected, got := make(Vector, size), make(Vector, size)
+
+		addVecGeneric(expected, a, b)
+		got.Add(a, b)
+		assert.True(reflect.DeepEqual(expected, got), "Add mismatch for size %d", size)
+		got.Add(a, b, 3)
+		assert.True(reflect.DeepEqual(expected, got), "parallel Add mismatch for size %d", size)
+
+		subVecGeneric(expected, a, b)
+		got.Sub(a, b)
+		assert.True(reflect.DeepEqual(expected, got), "Sub mismatch for size %d", size)
+		got.Sub(a, b, 3)
+		assert.True(reflect.DeepEqual(expected, got), "parallel Sub mismatch for size %d", size)
+
+		mulVecGeneric(expected, a, b)
+		got.Mul(a, b, 3)
+		assert.True(reflect.DeepEqual(expected, got), "Mul mismatch for size %d", size)
+
+		scalarMulVecGeneric(expected, a, &c)
+		got.ScalarMul(a, &c, 3)
+		assert.True(reflect.DeepEqual(expected, got), "ScalarMul mismatch for size %d", size)
+
+		// Sum(a ⊙ b) == <a, b>
+		got.Mul(a, b)
+		sum, innerProduct := got.Sum(), a.InnerProduct(b)
+		assert.True(sum.Equal(&innerProduct), "InnerProduct mismatch for size %d", size)
+		sum, innerProduct = got.Sum(5), a.InnerProduct(b, 5)
+		assert.True(sum.Equal(&innerProduct), "parallel InnerProduct mismatch for size %d", size)
+
+		// (a + b) - b == a, in place
+		got = make(Vector, size)
+		copy(got, a)
+		got.Add(got, b)
+		got.Sub(got, b)
+		assert.True(reflect.DeepEqual(a, got), "in place Add/Sub mismatch for size %d", size)
+	}
+
+	assert.Panics(func() {
+		v := make(Vector, 2)
+		v.Add(v, make(Vector, 3))
+	})
+}
+
+func BenchmarkVectorOps(b *testing.B) {
+	const N = 1 << 20
+	a, c := randomVector(N), randomVector(N)
+	res := make(Vector, N)
+	var s Element
+	s.SetRandom()
+
+	for _, nbTasks := range []int{1, 8} {
+		b.Run(fmt.Sprintf("add/tasks=%d", nbTasks), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Add(a, c, nbTasks)
+			}
+		})
+		b.Run(fmt.Sprintf("sub/tasks=%d", nbTasks), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Sub(a, c, nbTasks)
+			}
+		})
+		b.Run(fmt.Sprintf("mul/tasks=%d", nbTasks), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.Mul(a, c, nbTasks)
+			}
+		})
+		b.Run(fmt.Sprintf("scalarMul/tasks=%d", nbTasks), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				res.ScalarMul(a, &s, nbTasks)
+			}
+		})
+		b.Run(fmt.Sprintf("innerProduct/tasks=%d", nbTasks), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = a.InnerProduct(c, nbTasks)
+			}
+		})
+	}
+}
+
+func randomVector(size int) Vector {
+	v := make(Vector, size)
+	for i := range v {
+		v[i].SetRandom()
+	}
+	return v
+}
@@ -0,0 +1,199 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt4-298/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE2Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE2()
+	genB := GenE2()
+	genE := GenFp()
+
+	properties.Property("[MNT4-298] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT4-298] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT4-298] BatchInvertE2 should output the same result as Inverse", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvertE2([]E2{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT4-298] square and mul should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] MulByElement MulByElement inverse should leave an element invariant", prop.ForAll(
+		func(a *E2, b fp.Element) bool {
+			var c E2
+			var d fp.Element
+			d.Inverse(&b)
+			c.MulByElement(a, &b).MulByElement(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[MNT4-298] MulByNonResidue should be the same as multiplying by u", prop.ForAll(
+		func(a *E2) bool {
+			var b, c, u E2
+			u.A1.SetOne()
+			b.Mul(a, &u)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] Legendre on square should output 1", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] square(sqrt) should leave an element invariant", prop.ForAll(
+		func(a *E2) bool {
+			var b, c, d, e E2
+			b.Square(a)
+			c.Sqrt(&b)
+			d.Square(&c)
+			e.Neg(a)
+			return (c.Equal(a) || c.Equal(&e)) && d.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] Conjugate of x in E2 should be equal to x^q", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Conjugate(a)
+			c.Exp(*a, fp.Modulus())
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] Exp by a negative exponent should be the inverse of Exp", prop.ForAll(
+		func(a *E2, e fp.Element) bool {
+			var b, c E2
+			var k big.Int
+			e.BigInt(&k)
+			b.Exp(*a, &k).Inverse(&b)
+			k.Neg(&k)
+			c.Exp(*a, &k)
+			return c.Equal(&b)
+		},
+		genA,
+		genE,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE2Mul(b *testing.B) {
+	var a, c E2
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE2Square(b *testing.B) {
+	var a E2
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE2Inverse(b *testing.B) {
+	var a E2
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE2Sqrt(b *testing.B) {
+	var a E2
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
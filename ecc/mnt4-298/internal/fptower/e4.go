@@ -0,0 +1,382 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/mnt4-298/fp"
+)
+
+var bigIntPool = sync.Pool{
+	New: func() interface{} {
+		return new(big.Int)
+	},
+}
+
+// E4 is a degree two finite field extension of fp2: 𝔽p⁴[v] = 𝔽p²/v²-u
+type E4 struct {
+	B0, B1 E2
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// String puts E4 in string form
+func (z *E4) String() string {
+	return z.B0.String() + "+(" + z.B1.String() + ")*v"
+}
+
+// SetString sets a E4 from string
+func (z *E4) SetString(s0, s1, s2, s3 string) *E4 {
+	z.B0.SetString(s0, s1)
+	z.B1.SetString(s2, s3)
+	return z
+}
+
+// Set copies x into z and returns z
+func (z *E4) Set(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1 = x.B1
+	return z
+}
+
+// SetZero sets an E4 elmt to zero
+func (z *E4) SetZero() *E4 {
+	*z = E4{}
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E4) SetOne() *E4 {
+	*z = E4{}
+	z.B0.A0.SetOne()
+	return z
+}
+
+// SetRandom used only in tests
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if the element is zero, false otherwise
+func (z *E4) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E4) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// Add sets z=x+y in E4 and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z=2*x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg negates an E4 element
+func (z *E4) Neg(x *E4) *E4 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Mul sets z=x*y in E4 and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	var a, b, c E2
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	z.B0.MulByNonResidue(&c).Add(&z.B0, &b)
+	return z
+}
+
+// Square sets z=x*x in E4 and returns z
+func (z *E4) Square(x *E4) *E4 {
+	// Algorithm 22 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c2, c3 E2
+	c0.Sub(&x.B0, &x.B1)
+	c3.MulByNonResidue(&x.B1).Neg(&c3).Add(&x.B0, &c3)
+	c2.Mul(&x.B0, &x.B1)
+	c0.Mul(&c0, &c3).Add(&c0, &c2)
+	z.B1.Double(&c2)
+	c2.MulByNonResidue(&c2)
+	z.B0.Add(&c0, &c2)
+
+	return z
+}
+
+// CyclotomicSquare squares an element of the cyclotomic subgroup of order p²+1
+// using the norm-one relation b0² - u*b1² = 1
+func (z *E4) CyclotomicSquare(x *E4) *E4 {
+	var t E2
+	var one fp.Element
+	one.SetOne()
+	t.Mul(&x.B0, &x.B1)
+	z.B0.Square(&x.B0).Double(&z.B0)
+	z.B0.A0.Sub(&z.B0.A0, &one)
+	z.B1.Double(&t)
+	return z
+}
+
+// Inverse sets z to the inverse of x in E4 and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) Inverse(x *E4) *E4 {
+	// Algorithm 23 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, tmp E2
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	tmp.MulByNonResidue(&t1)
+	t0.Sub(&t0, &tmp)
+	t1.Inverse(&t0)
+	z.B0.Mul(&x.B0, &t1)
+	z.B1.Mul(&x.B1, &t1).Neg(&z.B1)
+
+	return z
+}
+
+// BatchInvertE4 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E4
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Exp sets z=xᵏ (mod q⁴) and returns it
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q⁴) == (x⁻¹)ᵏ (mod q⁴)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	var res E4
+	var ops [3]E4
+
+	res.SetOne()
+	ops[0].Set(&x)
+	ops[1].Square(&ops[0])
+	ops[2].Set(&ops[0]).Mul(&ops[2], &ops[1])
+
+	b := e.Bytes()
+	for i := range b {
+		w := b[i]
+		mask := byte(0xc0)
+		for j := 0; j < 4; j++ {
+			res.Square(&res).Square(&res)
+			c := (w & mask) >> (6 - 2*j)
+			if c != 0 {
+				res.Mul(&res, &ops[c-1])
+			}
+			mask = mask >> 2
+		}
+	}
+	z.Set(&res)
+
+	return z
+}
+
+// CyclotomicExp sets z=xᵏ (mod q⁴) and returns it
+// uses 2-NAF decomposition
+// x must be in the cyclotomic subgroup
+func (z *E4) CyclotomicExp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert (=conjugate)
+		// if k < 0: xᵏ (mod q⁴) == (x⁻¹)ᵏ (mod q⁴)
+		x.Conjugate(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	var res, xInv E4
+	xInv.InverseUnitary(&x)
+	res.SetOne()
+	eNAF := make([]int8, e.BitLen()+3)
+	n := ecc.NafDecomposition(e, eNAF[:])
+	for i := n - 1; i >= 0; i-- {
+		res.CyclotomicSquare(&res)
+		if eNAF[i] == 1 {
+			res.Mul(&res, &x)
+		} else if eNAF[i] == -1 {
+			res.Mul(&res, &xInv)
+		}
+	}
+	z.Set(&res)
+	return z
+}
+
+// InverseUnitary inverses a unitary element
+func (z *E4) InverseUnitary(x *E4) *E4 {
+	return z.Conjugate(x)
+}
+
+// Conjugate sets z to x conjugated (i.e. x^(p²)) and returns z
+func (z *E4) Conjugate(x *E4) *E4 {
+	*z = *x
+	z.B1.Neg(&z.B1)
+	return z
+}
+
+// Frobenius sets z to x^p and returns z
+func (z *E4) Frobenius(x *E4) *E4 {
+	z.B0.Conjugate(&x.B0)
+	z.B1.Conjugate(&x.B1).MulByElement(&z.B1, &frobCoeff)
+	return z
+}
+
+// SizeOfGT represents the size in bytes that a GT element need in binary form
+const SizeOfGT = fp.Bytes * 4
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte array.
+// z.B1.A1 | z.B1.A0 | z.B0.A1 | z.B0.A0
+func (z *E4) Bytes() (r [SizeOfGT]byte) {
+
+	offset := 0
+	var buf [fp.Bytes]byte
+
+	buf = z.B1.A1.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B1.A0.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B0.A1.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+	offset += fp.Bytes
+
+	buf = z.B0.A0.Bytes()
+	copy(r[offset:offset+fp.Bytes], buf[:])
+
+	return
+}
+
+// SetBytes interprets e as the bytes of a big-endian GT
+// sets z to that value (in Montgomery form), and returns z.
+// z.B1.A1 | z.B1.A0 | z.B0.A1 | z.B0.A0
+func (z *E4) SetBytes(e []byte) error {
+	if len(e) != SizeOfGT {
+		return errors.New("invalid buffer size")
+	}
+	offset := 0
+	z.B1.A1.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B1.A0.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B0.A1.SetBytes(e[offset : offset+fp.Bytes])
+	offset += fp.Bytes
+	z.B0.A0.SetBytes(e[offset : offset+fp.Bytes])
+
+	return nil
+}
+
+// IsInSubGroup ensures GT/E4 is in correct subgroup
+func (z *E4) IsInSubGroup() bool {
+	var a, b E4
+
+	// check z^(Φ₄(p)) = z^(p²+1) == 1
+	a.Conjugate(z).Mul(&a, z)
+	if !a.IsOne() {
+		return false
+	}
+
+	// check z^r = z^(p-(t-1)) == 1
+	a.Frobenius(z)
+	b.CyclotomicExp(*z, &loopCount)
+
+	return a.Equal(&b)
+}
@@ -0,0 +1,219 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt4-298/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE4Serialization(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE4()
+
+	properties.Property("[MNT4-298] SetBytes(Bytes()) should stay constant", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE4Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE4()
+	genB := GenE4()
+	genE := GenFp()
+
+	properties.Property("[MNT4-298] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E4) bool {
+			var c E4
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT4-298] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT4-298] square and mul should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] a + conj(a), a - conj(a) should be in E2", prop.ForAll(
+		func(a *E4) bool {
+			var b, c, d E4
+			var e, f, g E2
+			b.Conjugate(a)
+			c.Add(a, &b)
+			d.Sub(a, &b)
+			e.Double(&a.B0)
+			f.Double(&a.B1)
+			return c.B1.Equal(&g) && d.B0.Equal(&g) && e.Equal(&c.B0) && f.Equal(&d.B1)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] Frobenius of x in E4 should be equal to x^q", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Frobenius(a)
+			c.Exp(*a, fp.Modulus())
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] Conjugate of x in E4 should be equal to x^(p²)", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			var e big.Int
+			e.Exp(fp.Modulus(), big.NewInt(2), nil)
+			b.Conjugate(a)
+			c.Exp(*a, &e)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] cyclotomic square and square should be the same in the cyclotomic subgroup", prop.ForAll(
+		func(a *E4) bool {
+			var b, c, d E4
+			b.Conjugate(a)
+			a.Inverse(a)
+			b.Mul(&b, a)
+			c.Square(&b)
+			d.CyclotomicSquare(&b)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT4-298] Exp and CyclotomicExp should be the same in the cyclotomic subgroup", prop.ForAll(
+		func(a *E4, e fp.Element) bool {
+			var b, c, d E4
+			var k big.Int
+			b.Conjugate(a)
+			a.Inverse(a)
+			b.Mul(&b, a)
+			e.BigInt(&k)
+			k.Neg(&k)
+			c.Exp(b, &k)
+			d.CyclotomicExp(b, &k)
+			return c.Equal(&d)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[MNT4-298] a random element should not be in GT", prop.ForAll(
+		func(a *E4) bool {
+			return !a.IsInSubGroup()
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE4Mul(b *testing.B) {
+	var a, c E4
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE4Square(b *testing.B) {
+	var a E4
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE4Cyclosquare(b *testing.B) {
+	var a E4
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.CyclotomicSquare(&a)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	var a E4
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import "github.com/consensys/gnark-crypto/ecc/mnt4-298/fp"
+
+// frobCoeff = 17^((p-1)/4), so that v^p = frobCoeff ⋅ v
+var frobCoeff = fp.Element{
+	16439849825752526567,
+	14772594681319164557,
+	16175669228740845684,
+	4590896976404796446,
+	3810243174413,
+}
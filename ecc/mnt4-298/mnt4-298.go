@@ -0,0 +1,134 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mnt4298 efficient elliptic curve, pairing and multi-exponentiation implementation for mnt4-298.
+//
+// mnt4-298: A Miyaji--Nakabayashi--Takano curve
+//
+//	embedding degree k=4
+//	𝔽r: r=0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad3bb4334a400000001
+//	𝔽p: p=0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd65a71660001
+//	(E/𝔽p): Y²=X³+2X+b
+//	(Eₜ/𝔽p²): Y² = X³+2u²X+u³b (quadratic twist)
+//	r ∣ #E(Fp) and r ∣ #Eₜ(𝔽p²)
+//
+// mnt4-298 and mnt6-298 form a 2-cycle: the scalar field of one is the base field
+// of the other. E(𝔽p) has prime order r.
+//
+// Extension fields tower:
+//
+//	𝔽p²[u] = 𝔽p/u²-17
+//	𝔽p⁴[v] = 𝔽p²/v²-u
+//
+// optimal Ate loop size:
+//
+//	t-1 where t is the trace of the Frobenius
+//
+// Security: well below the 128-bit level (r is 298 bits and p⁴ is 1192 bits).
+// The curve is provided for compatibility with existing recursive proof systems.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package mnt4298
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/mnt4-298/fp"
+	"github.com/consensys/gnark-crypto/ecc/mnt4-298/internal/fptower"
+)
+
+// ID mnt4-298 ID
+const ID = ecc.MNT4_298
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// aTwistCurveCoeff, bTwistCurveCoeff are the coefficients of the twist (defined over 𝔽p²)
+var aTwistCurveCoeff fptower.E2
+var bTwistCurveCoeff fptower.E2
+
+// generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+var g1Gen G1Jac
+var g2Gen G2Jac
+
+var g1GenAff G1Affine
+var g2GenAff G2Affine
+
+// point at infinity
+var g1Infinity G1Jac
+var g2Infinity G2Jac
+
+// optimal Ate loop counter
+var loopCounter [150]int8
+
+// loopCount = t-1 where t is the trace of the Frobenius
+var loopCount big.Int
+
+// expose the tower -- github.com/consensys/gnark uses it in a gnark circuit
+
+// 𝔽p²
+type E2 = fptower.E2
+
+// 𝔽p⁴
+type E4 = fptower.E4
+
+func init() {
+	aCurveCoeff.SetUint64(2)
+	bCurveCoeff.SetString("423894536526684178289416011533888240029318103673896002803341544124054745019340795360841685")
+	// a ⋅ u², b ⋅ u³
+	aTwistCurveCoeff.A0.SetUint64(34)
+	bTwistCurveCoeff.A1.SetString("67372828414711144619833451280373307321534573815811166723479321465776723059456513877937430")
+
+	g1Gen.X.SetString("60760244141852568949126569781626075788424196370144486719385562369396875346601926534016838")
+	g1Gen.Y.SetString("363732850702582978263902770815145784459747722357071843971107674179038674942891694705904306")
+	g1Gen.Z.SetOne()
+
+	g2Gen.X.SetString("438374926219350099854919100077809681842783509163790991847867546339851681564223481322252708",
+		"37620953615500480110935514360923278605464476459712393277679280819942849043649216370485641")
+	g2Gen.Y.SetString("37437409008528968268352521034936931842973546441370663118543015118291998305624025037512482",
+		"424621479598893882672393190337420680597584695892317197646113820787463109735345923009077489")
+	g2Gen.Z.SetString("1",
+		"0")
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+	g2Infinity.X.SetOne()
+	g2Infinity.Y.SetOne()
+
+	// 2-NAF decomposition of t-1 little endian
+	loopCount.SetString("689871209842287392837045615510547309923794944", 10)
+	ecc.NafDecomposition(&loopCount, loopCounter[:])
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
+	g1Aff = g1GenAff
+	g2Aff = g2GenAff
+	g1Jac = g1Gen
+	g2Jac = g2Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
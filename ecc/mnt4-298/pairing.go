@@ -0,0 +1,257 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mnt4298
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt4-298/internal/fptower"
+)
+
+// GT target group of the pairing
+type GT = fptower.E4
+
+// lineEvaluation is a line ℓ = (r0 + r1⋅xP) + r2⋅yP⋅v through points of the twist,
+// stored independently of the point P it is evaluated at
+type lineEvaluation struct {
+	r0 fptower.E2
+	r1 fptower.E2
+	r2 fptower.E2
+}
+
+// Pair calculates the reduced pairing for a set of points
+// ∏ᵢ e(Pᵢ, Qᵢ).
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func Pair(P []G1Affine, Q []G2Affine) (GT, error) {
+	f, err := MillerLoop(P, Q)
+	if err != nil {
+		return GT{}, err
+	}
+	return FinalExponentiation(&f), nil
+}
+
+// PairingCheck calculates the reduced pairing for a set of points and returns True if the result is One
+// ∏ᵢ e(Pᵢ, Qᵢ) =? 1
+//
+// This function doesn't check that the inputs are in the correct subgroup. See IsInSubGroup.
+func PairingCheck(P []G1Affine, Q []G2Affine) (bool, error) {
+	f, err := Pair(P, Q)
+	if err != nil {
+		return false, err
+	}
+	var one GT
+	one.SetOne()
+	return f.Equal(&one), nil
+}
+
+// FinalExponentiation computes the exponentiation (∏ᵢ zᵢ)ᵈ
+// where d = (p⁴-1)/r = (p²-1) ⋅ (p²+1)/r = (p²-1) ⋅ (p+t)
+// with t-1 the optimal Ate loop size
+func FinalExponentiation(z *GT, _z ...*GT) GT {
+
+	var result GT
+	result.Set(z)
+
+	for _, e := range _z {
+		result.Mul(&result, e)
+	}
+
+	var t [2]GT
+
+	// Easy part
+	// (p²-1)
+	t[0].Conjugate(&result)
+	result.Inverse(&result)
+	result.Mul(&result, &t[0])
+
+	// Hard part
+	// p+t = p+(t-1)+1
+	t[0].Frobenius(&result)
+	t[1].CyclotomicExp(result, &loopCount)
+	result.Mul(&result, &t[0]).
+		Mul(&result, &t[1])
+
+	return result
+}
+
+// MillerLoop computes the multi-Miller loop
+// ∏ᵢ MillerLoop(Pᵢ, Qᵢ)
+func MillerLoop(P []G1Affine, Q []G2Affine) (GT, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return GT{}, errors.New("invalid inputs sizes")
+	}
+
+	// filter infinity points
+	p := make([]G1Affine, 0, n)
+	q := make([]G2Affine, 0, n)
+
+	for k := 0; k < n; k++ {
+		if P[k].IsInfinity() || Q[k].IsInfinity() {
+			continue
+		}
+		p = append(p, P[k])
+		q = append(q, Q[k])
+	}
+
+	n = len(p)
+
+	// Jacobian points for Q
+	qJac := make([]G2Jac, n)
+	qNeg := make([]G2Affine, n)
+	for k := 0; k < n; k++ {
+		qJac[k].FromAffine(&q[k])
+		qNeg[k].Neg(&q[k])
+	}
+
+	var result, line GT
+	result.SetOne()
+	var l lineEvaluation
+
+	// loopCounter[149] = 1
+	for i := len(loopCounter) - 2; i >= 0; i-- {
+		// (∏ᵢfᵢ)²
+		// mutualize the square among n Miller loops
+		result.Square(&result)
+
+		for k := 0; k < n; k++ {
+			// qJac[k] ← 2qJac[k] and l the tangent ℓ passing 2qJac[k]
+			qJac[k].doubleStep(&l)
+			// ℓ × result
+			l.eval(&line, &p[k])
+			result.Mul(&result, &line)
+
+			if loopCounter[i] == 1 {
+				// qJac[k] ← qJac[k]+Q[k] and
+				// l the line ℓ passing qJac[k] and Q[k]
+				qJac[k].addMixedStep(&l, &q[k])
+				l.eval(&line, &p[k])
+				result.Mul(&result, &line)
+			} else if loopCounter[i] == -1 {
+				// qJac[k] ← qJac[k]-Q[k] and
+				// l the line ℓ passing qJac[k] and -Q[k]
+				qJac[k].addMixedStep(&l, &qNeg[k])
+				l.eval(&line, &p[k])
+				result.Mul(&result, &line)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// eval sets z to the line l evaluated at P, i.e. (r0 + r1⋅xP) + r2⋅yP⋅v
+func (l *lineEvaluation) eval(z *GT, P *G1Affine) {
+	z.B0.MulByElement(&l.r1, &P.X).
+		Add(&z.B0, &l.r0)
+	z.B1.MulByElement(&l.r2, &P.Y)
+}
+
+// doubleStep doubles a point in Jacobian coordinates on the twist Y²=X³+a'X+b',
+// and computes the tangent line in the Miller loop
+// https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian.html#doubling-dbl-2007-bl
+func (p *G2Jac) doubleStep(evaluations *lineEvaluation) {
+
+	var XX, YY, YYYY, ZZ, S, M, T fptower.E2
+
+	XX.Square(&p.X)
+	YY.Square(&p.Y)
+	YYYY.Square(&YY)
+	ZZ.Square(&p.Z)
+
+	// M = 3⋅XX + a'⋅ZZ²
+	T.Square(&ZZ).Mul(&T, &aTwistCurveCoeff)
+	M.Double(&XX).Add(&M, &XX).Add(&M, &T)
+
+	// Line evaluation
+	// ℓ = (M⋅X - 2⋅YY - M⋅ZZ⋅u⋅xP) + 2⋅Y⋅Z⋅ZZ⋅u⋅yP⋅v
+	evaluations.r0.Mul(&M, &p.X)
+	T.Double(&YY)
+	evaluations.r0.Sub(&evaluations.r0, &T)
+	evaluations.r1.Mul(&M, &ZZ).
+		MulByNonResidue(&evaluations.r1).
+		Neg(&evaluations.r1)
+
+	// S = 4⋅X⋅YY
+	S.Mul(&p.X, &YY).
+		Double(&S).
+		Double(&S)
+
+	// Z3 = 2⋅Y⋅Z
+	p.Z.Mul(&p.Z, &p.Y).
+		Double(&p.Z)
+	evaluations.r2.Mul(&p.Z, &ZZ).
+		MulByNonResidue(&evaluations.r2)
+
+	// X3 = M² - 2⋅S
+	T.Double(&S)
+	p.X.Square(&M).
+		Sub(&p.X, &T)
+
+	// Y3 = M⋅(S - X3) - 8⋅YYYY
+	YYYY.Double(&YYYY).
+		Double(&YYYY).
+		Double(&YYYY)
+	p.Y.Sub(&S, &p.X).
+		Mul(&p.Y, &M).
+		Sub(&p.Y, &YYYY)
+}
+
+// addMixedStep adds a point in Jacobian coordinates and a point in affine
+// coordinates on the twist, and computes the line through them in the Miller loop
+// https://hyperelliptic.org/EFD/g1p/auto-shortw-jacobian.html#addition-madd
+func (p *G2Jac) addMixedStep(evaluations *lineEvaluation, a *G2Affine) {
+
+	var ZZ, H, HH, HHH, R, V, T fptower.E2
+
+	ZZ.Square(&p.Z)
+
+	// H = xQ⋅ZZ - X, R = yQ⋅Z⋅ZZ - Y
+	H.Mul(&a.X, &ZZ).
+		Sub(&H, &p.X)
+	R.Mul(&a.Y, &ZZ).
+		Mul(&R, &p.Z).
+		Sub(&R, &p.Y)
+
+	// Z3 = Z⋅H
+	p.Z.Mul(&p.Z, &H)
+
+	// Line evaluation
+	// ℓ = (R⋅xQ - yQ⋅Z3 - R⋅u⋅xP) + Z3⋅u⋅yP⋅v
+	evaluations.r0.Mul(&R, &a.X)
+	T.Mul(&a.Y, &p.Z)
+	evaluations.r0.Sub(&evaluations.r0, &T)
+	evaluations.r1.MulByNonResidue(&R).
+		Neg(&evaluations.r1)
+	evaluations.r2.MulByNonResidue(&p.Z)
+
+	HH.Square(&H)
+	HHH.Mul(&HH, &H)
+	V.Mul(&p.X, &HH)
+
+	// X3 = R² - H³ - 2⋅X⋅H²
+	T.Double(&V)
+	p.X.Square(&R).
+		Sub(&p.X, &HHH).
+		Sub(&p.X, &T)
+
+	// Y3 = R⋅(X⋅H² - X3) - Y⋅H³
+	HHH.Mul(&HHH, &p.Y)
+	p.Y.Sub(&V, &p.X).
+		Mul(&p.Y, &R).
+		Sub(&p.Y, &HHH)
+}
@@ -0,0 +1,787 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fr
+
+// expBySqrtExp is equivalent to z.Exp(x, ef3def351ce899892769ec1523b2bbb258d744cc3a906a78d794480384b24335969c59)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expBySqrtExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_101      = _10 + _11
+	//	_1000     = _11 + _101
+	//	_1010     = _10 + _1000
+	//	_1100     = _10 + _1010
+	//	_1111     = _11 + _1100
+	//	_10000    = 1 + _1111
+	//	_10100    = _101 + _1111
+	//	_11001    = _101 + _10100
+	//	_110010   = 2*_11001
+	//	_1001011  = _11001 + _110010
+	//	_1001101  = _10 + _1001011
+	//	_1011001  = _1100 + _1001101
+	//	_1100011  = _1010 + _1011001
+	//	_1100110  = _11 + _1100011
+	//	_1110110  = _10000 + _1100110
+	//	_10000010 = _1100 + _1110110
+	//	_11000011 = _1001101 + _1110110
+	//	i20       = 2*_11000011
+	//	i21       = _110010 + i20
+	//	i22       = _1100110 + i20
+	//	i23       = _1100 + i22
+	//	i24       = _10000010 + i23
+	//	i25       = _1111 + i24
+	//	i26       = _10100 + i25
+	//	i27       = _1100 + i26
+	//	i28       = i21 + i23
+	//	i29       = i26 + i28
+	//	i31       = 2*(_101 + i29)
+	//	i34       = 2*(i28 + i31) + 1
+	//	i35       = _1001011 + i34
+	//	i36       = i29 + i35
+	//	i37       = i22 + i36
+	//	i38       = i31 + i37
+	//	i39       = i24 + i38
+	//	i40       = i20 + i39
+	//	i41       = i27 + i40
+	//	i42       = i35 + i36
+	//	i43       = i37 + i41
+	//	i44       = _1110110 + i43
+	//	i45       = _1111 + i44
+	//	i46       = i40 + i41
+	//	i47       = i44 + i45
+	//	i48       = i35 + i47
+	//	i49       = i23 + i48
+	//	i85       = ((_1000 + i49) << 16 + i49) << 17 + i40
+	//	i137      = ((i85 << 18 + i47) << 18 + i48) << 14
+	//	i174      = ((i34 + i137) << 18 + _1100 + i46) << 16
+	//	i211      = ((i46 + i174) << 17 + i38) << 17 + i44
+	//	i248      = (i211 << 16 + i42) << 18 + i24 + i49
+	//	i302      = ((i248 << 12 + i25) << 23 + i39) << 17
+	//	i322      = (2*(i42 + i302) + _1100011) << 16 + i43
+	//	return      i322 << 10 + _1011001
+	//
+	// Operations: 269 squares 64 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t0 = x^0x3
+	t0.Mul(&x, z)
+
+	// Step 3: t3 = x^0x5
+	t3.Mul(z, t0)
+
+	// Step 4: t15 = x^0x8
+	t15.Mul(t0, t3)
+
+	// Step 5: t1 = x^0xa
+	t1.Mul(z, t15)
+
+	// Step 6: t10 = x^0xc
+	t10.Mul(z, t1)
+
+	// Step 7: t13 = x^0xf
+	t13.Mul(t0, t10)
+
+	// Step 8: t4 = x^0x10
+	t4.Mul(&x, t13)
+
+	// Step 9: t2 = x^0x14
+	t2.Mul(t3, t13)
+
+	// Step 10: t6 = x^0x19
+	t6.Mul(t3, t2)
+
+	// Step 11: t5 = x^0x32
+	t5.Square(t6)
+
+	// Step 12: t8 = x^0x4b
+	t8.Mul(t6, t5)
+
+	// Step 13: t6 = x^0x4d
+	t6.Mul(z, t8)
+
+	// Step 14: z = x^0x59
+	z.Mul(t10, t6)
+
+	// Step 15: t1 = x^0x63
+	t1.Mul(t1, z)
+
+	// Step 16: t0 = x^0x66
+	t0.Mul(t0, t1)
+
+	// Step 17: t7 = x^0x76
+	t7.Mul(t4, t0)
+
+	// Step 18: t4 = x^0x82
+	t4.Mul(t10, t7)
+
+	// Step 19: t6 = x^0xc3
+	t6.Mul(t6, t7)
+
+	// Step 20: t14 = x^0x186
+	t14.Square(t6)
+
+	// Step 21: t11 = x^0x1b8
+	t11.Mul(t5, t14)
+
+	// Step 22: t0 = x^0x1ec
+	t0.Mul(t0, t14)
+
+	// Step 23: t5 = x^0x1f8
+	t5.Mul(t10, t0)
+
+	// Step 24: t6 = x^0x27a
+	t6.Mul(t4, t5)
+
+	// Step 25: t4 = x^0x289
+	t4.Mul(t13, t6)
+
+	// Step 26: t2 = x^0x29d
+	t2.Mul(t2, t4)
+
+	// Step 27: t9 = x^0x2a9
+	t9.Mul(t10, t2)
+
+	// Step 28: t11 = x^0x3b0
+	t11.Mul(t11, t5)
+
+	// Step 29: t2 = x^0x64d
+	t2.Mul(t2, t11)
+
+	// Step 30: t3 = x^0x652
+	t3.Mul(t3, t2)
+
+	// Step 31: t3 = x^0xca4
+	t3.Square(t3)
+
+	// Step 32: t11 = x^0x1054
+	t11.Mul(t11, t3)
+
+	// Step 33: t11 = x^0x20a8
+	t11.Square(t11)
+
+	// Step 34: t11 = x^0x20a9
+	t11.Mul(&x, t11)
+
+	// Step 35: t12 = x^0x20f4
+	t12.Mul(t8, t11)
+
+	// Step 36: t2 = x^0x2741
+	t2.Mul(t2, t12)
+
+	// Step 37: t0 = x^0x292d
+	t0.Mul(t0, t2)
+
+	// Step 38: t8 = x^0x35d1
+	t8.Mul(t3, t0)
+
+	// Step 39: t3 = x^0x384b
+	t3.Mul(t6, t8)
+
+	// Step 40: t14 = x^0x39d1
+	t14.Mul(t14, t3)
+
+	// Step 41: t9 = x^0x3c7a
+	t9.Mul(t9, t14)
+
+	// Step 42: t2 = x^0x4835
+	t2.Mul(t12, t2)
+
+	// Step 43: t0 = x^0x65a7
+	t0.Mul(t0, t9)
+
+	// Step 44: t7 = x^0x661d
+	t7.Mul(t7, t0)
+
+	// Step 45: t13 = x^0x662c
+	t13.Mul(t13, t7)
+
+	// Step 46: t9 = x^0x764b
+	t9.Mul(t14, t9)
+
+	// Step 47: t13 = x^0xcc49
+	t13.Mul(t7, t13)
+
+	// Step 48: t12 = x^0xed3d
+	t12.Mul(t12, t13)
+
+	// Step 49: t5 = x^0xef35
+	t5.Mul(t5, t12)
+
+	// Step 50: t15 = x^0xef3d
+	t15.Mul(t15, t5)
+
+	// Step 66: t15 = x^0xef3d0000
+	for s := 0; s < 16; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 67: t15 = x^0xef3def35
+	t15.Mul(t5, t15)
+
+	// Step 84: t15 = x^0x1de7bde6a0000
+	for s := 0; s < 17; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 85: t14 = x^0x1de7bde6a39d1
+	t14.Mul(t14, t15)
+
+	// Step 103: t14 = x^0x779ef79a8e7440000
+	for s := 0; s < 18; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 104: t13 = x^0x779ef79a8e744cc49
+	t13.Mul(t13, t14)
+
+	// Step 122: t13 = x^0x1de7bde6a39d1331240000
+	for s := 0; s < 18; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 123: t12 = x^0x1de7bde6a39d133124ed3d
+	t12.Mul(t12, t13)
+
+	// Step 137: t12 = x^0x779ef79a8e744cc493b4f4000
+	for s := 0; s < 14; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 138: t11 = x^0x779ef79a8e744cc493b4f60a9
+	t11.Mul(t11, t12)
+
+	// Step 156: t11 = x^0x1de7bde6a39d133124ed3d82a40000
+	for s := 0; s < 18; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 157: t10 = x^0x1de7bde6a39d133124ed3d82a4000c
+	t10.Mul(t10, t11)
+
+	// Step 158: t10 = x^0x1de7bde6a39d133124ed3d82a47657
+	t10.Mul(t9, t10)
+
+	// Step 174: t10 = x^0x1de7bde6a39d133124ed3d82a476570000
+	for s := 0; s < 16; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 175: t9 = x^0x1de7bde6a39d133124ed3d82a47657764b
+	t9.Mul(t9, t10)
+
+	// Step 192: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec960000
+	for s := 0; s < 17; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 193: t8 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1
+	t8.Mul(t8, t9)
+
+	// Step 210: t8 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba20000
+	for s := 0; s < 17; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 211: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba2661d
+	t7.Mul(t7, t8)
+
+	// Step 227: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba2661d0000
+	for s := 0; s < 16; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 228: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba2661d4835
+	t7.Mul(t2, t7)
+
+	// Step 246: t7 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d40000
+	for s := 0; s < 18; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 247: t6 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4027a
+	t6.Mul(t6, t7)
+
+	// Step 248: t5 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af
+	t5.Mul(t5, t6)
+
+	// Step 260: t5 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af000
+	for s := 0; s < 12; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 261: t4 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af289
+	t4.Mul(t4, t5)
+
+	// Step 284: t4 = x^0xef3def351ce899892769ec1523b2bbb258d744cc3a906a78d7944800000
+	for s := 0; s < 23; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 285: t3 = x^0xef3def351ce899892769ec1523b2bbb258d744cc3a906a78d794480384b
+	t3.Mul(t3, t4)
+
+	// Step 302: t3 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070960000
+	for s := 0; s < 17; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 303: t2 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070964835
+	t2.Mul(t2, t3)
+
+	// Step 304: t2 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c906a
+	t2.Square(t2)
+
+	// Step 305: t1 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd
+	t1.Mul(t1, t2)
+
+	// Step 321: t1 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd0000
+	for s := 0; s < 16; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 322: t0 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd65a7
+	t0.Mul(t0, t1)
+
+	// Step 332: t0 = x^0xef3def351ce899892769ec1523b2bbb258d744cc3a906a78d794480384b24335969c00
+	for s := 0; s < 10; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 333: z = x^0xef3def351ce899892769ec1523b2bbb258d744cc3a906a78d794480384b24335969c59
+	z.Mul(z, t0)
+
+	return z
+}
+
+// expByLegendreExp is equivalent to z.Exp(x, 1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070964866b2d38b30000)
+//
+// uses github.com/mmcloughlin/addchain v0.4.0 to generate a shorter addition chain
+func (z *Element) expByLegendreExp(x Element) *Element {
+	// addition chain:
+	//
+	//	_10       = 2*1
+	//	_11       = 1 + _10
+	//	_100      = 1 + _11
+	//	_101      = 1 + _100
+	//	_1000     = _11 + _101
+	//	_1100     = _100 + _1000
+	//	_1111     = _11 + _1100
+	//	_10000    = 1 + _1111
+	//	_10100    = _100 + _10000
+	//	_10110    = _10 + _10100
+	//	_11001    = _11 + _10110
+	//	_110010   = 2*_11001
+	//	_1001011  = _11001 + _110010
+	//	_1001101  = _10 + _1001011
+	//	_1100011  = _10110 + _1001101
+	//	_1100110  = _11 + _1100011
+	//	_1110110  = _10000 + _1100110
+	//	_1111010  = _100 + _1110110
+	//	_10110011 = _1001101 + _1100110
+	//	_11000011 = _10000 + _10110011
+	//	i21       = 2*_11000011
+	//	i22       = _110010 + i21
+	//	i23       = _1100110 + i21
+	//	i24       = _1100 + i23
+	//	i25       = _1111010 + i24
+	//	i26       = _1000 + i25
+	//	i27       = _1111 + i26
+	//	i28       = _10100 + i27
+	//	i29       = _1100 + i28
+	//	i30       = i22 + i24
+	//	i31       = i28 + i30
+	//	i33       = 2*(_101 + i31)
+	//	i36       = 2*(i30 + i33) + 1
+	//	i37       = _1001011 + i36
+	//	i38       = i31 + i37
+	//	i39       = i23 + i38
+	//	i40       = i33 + i39
+	//	i41       = i26 + i40
+	//	i42       = i21 + i41
+	//	i43       = i29 + i42
+	//	i44       = i37 + i38
+	//	i45       = i39 + i43
+	//	i46       = _1110110 + i45
+	//	i47       = _1111 + i46
+	//	i48       = i42 + i43
+	//	i49       = i46 + i47
+	//	i50       = i37 + i49
+	//	i51       = i24 + i50
+	//	i52       = _1000 + i51
+	//	i105      = ((i52 << 16 + i51) << 17 + i42) << 18
+	//	i140      = ((i49 + i105) << 18 + i50) << 14 + i36
+	//	i177      = (i140 << 18 + i48 + _1100) << 16 + i48
+	//	i229      = ((i177 << 17 + i40) << 17 + i46) << 16
+	//	i262      = ((i44 + i229) << 18 + i52 + i25) << 12
+	//	i305      = ((i27 + i262) << 23 + i41) << 17 + i44
+	//	i335      = ((2*i305 + _1100011) << 16 + i45) << 11
+	//	return      (_10110011 + i335) << 16
+	//
+	// Operations: 286 squares 66 multiplies
+
+	// Allocate Temporaries.
+	var (
+		t0  = new(Element)
+		t1  = new(Element)
+		t2  = new(Element)
+		t3  = new(Element)
+		t4  = new(Element)
+		t5  = new(Element)
+		t6  = new(Element)
+		t7  = new(Element)
+		t8  = new(Element)
+		t9  = new(Element)
+		t10 = new(Element)
+		t11 = new(Element)
+		t12 = new(Element)
+		t13 = new(Element)
+		t14 = new(Element)
+		t15 = new(Element)
+		t16 = new(Element)
+	)
+
+	// var t0,t1,t2,t3,t4,t5,t6,t7,t8,t9,t10,t11,t12,t13,t14,t15,t16 Element
+	// Step 1: z = x^0x2
+	z.Square(&x)
+
+	// Step 2: t0 = x^0x3
+	t0.Mul(&x, z)
+
+	// Step 3: t3 = x^0x4
+	t3.Mul(&x, t0)
+
+	// Step 4: t8 = x^0x5
+	t8.Mul(&x, t3)
+
+	// Step 5: t6 = x^0x8
+	t6.Mul(t0, t8)
+
+	// Step 6: t10 = x^0xc
+	t10.Mul(t3, t6)
+
+	// Step 7: t13 = x^0xf
+	t13.Mul(t0, t10)
+
+	// Step 8: t5 = x^0x10
+	t5.Mul(&x, t13)
+
+	// Step 9: t2 = x^0x14
+	t2.Mul(t3, t5)
+
+	// Step 10: t1 = x^0x16
+	t1.Mul(z, t2)
+
+	// Step 11: t7 = x^0x19
+	t7.Mul(t0, t1)
+
+	// Step 12: t4 = x^0x32
+	t4.Square(t7)
+
+	// Step 13: t12 = x^0x4b
+	t12.Mul(t7, t4)
+
+	// Step 14: z = x^0x4d
+	z.Mul(z, t12)
+
+	// Step 15: t1 = x^0x63
+	t1.Mul(t1, z)
+
+	// Step 16: t0 = x^0x66
+	t0.Mul(t0, t1)
+
+	// Step 17: t7 = x^0x76
+	t7.Mul(t5, t0)
+
+	// Step 18: t3 = x^0x7a
+	t3.Mul(t3, t7)
+
+	// Step 19: z = x^0xb3
+	z.Mul(z, t0)
+
+	// Step 20: t5 = x^0xc3
+	t5.Mul(t5, z)
+
+	// Step 21: t14 = x^0x186
+	t14.Square(t5)
+
+	// Step 22: t11 = x^0x1b8
+	t11.Mul(t4, t14)
+
+	// Step 23: t0 = x^0x1ec
+	t0.Mul(t0, t14)
+
+	// Step 24: t15 = x^0x1f8
+	t15.Mul(t10, t0)
+
+	// Step 25: t5 = x^0x272
+	t5.Mul(t3, t15)
+
+	// Step 26: t3 = x^0x27a
+	t3.Mul(t6, t5)
+
+	// Step 27: t4 = x^0x289
+	t4.Mul(t13, t3)
+
+	// Step 28: t2 = x^0x29d
+	t2.Mul(t2, t4)
+
+	// Step 29: t9 = x^0x2a9
+	t9.Mul(t10, t2)
+
+	// Step 30: t11 = x^0x3b0
+	t11.Mul(t11, t15)
+
+	// Step 31: t2 = x^0x64d
+	t2.Mul(t2, t11)
+
+	// Step 32: t8 = x^0x652
+	t8.Mul(t8, t2)
+
+	// Step 33: t8 = x^0xca4
+	t8.Square(t8)
+
+	// Step 34: t11 = x^0x1054
+	t11.Mul(t11, t8)
+
+	// Step 35: t11 = x^0x20a8
+	t11.Square(t11)
+
+	// Step 36: t11 = x^0x20a9
+	t11.Mul(&x, t11)
+
+	// Step 37: t12 = x^0x20f4
+	t12.Mul(t12, t11)
+
+	// Step 38: t2 = x^0x2741
+	t2.Mul(t2, t12)
+
+	// Step 39: t0 = x^0x292d
+	t0.Mul(t0, t2)
+
+	// Step 40: t8 = x^0x35d1
+	t8.Mul(t8, t0)
+
+	// Step 41: t3 = x^0x384b
+	t3.Mul(t3, t8)
+
+	// Step 42: t14 = x^0x39d1
+	t14.Mul(t14, t3)
+
+	// Step 43: t9 = x^0x3c7a
+	t9.Mul(t9, t14)
+
+	// Step 44: t2 = x^0x4835
+	t2.Mul(t12, t2)
+
+	// Step 45: t0 = x^0x65a7
+	t0.Mul(t0, t9)
+
+	// Step 46: t7 = x^0x661d
+	t7.Mul(t7, t0)
+
+	// Step 47: t13 = x^0x662c
+	t13.Mul(t13, t7)
+
+	// Step 48: t9 = x^0x764b
+	t9.Mul(t14, t9)
+
+	// Step 49: t13 = x^0xcc49
+	t13.Mul(t7, t13)
+
+	// Step 50: t12 = x^0xed3d
+	t12.Mul(t12, t13)
+
+	// Step 51: t15 = x^0xef35
+	t15.Mul(t15, t12)
+
+	// Step 52: t6 = x^0xef3d
+	t6.Mul(t6, t15)
+
+	// Step 68: t16 = x^0xef3d0000
+	t16.Square(t6)
+	for s := 1; s < 16; s++ {
+		t16.Square(t16)
+	}
+
+	// Step 69: t15 = x^0xef3def35
+	t15.Mul(t15, t16)
+
+	// Step 86: t15 = x^0x1de7bde6a0000
+	for s := 0; s < 17; s++ {
+		t15.Square(t15)
+	}
+
+	// Step 87: t14 = x^0x1de7bde6a39d1
+	t14.Mul(t14, t15)
+
+	// Step 105: t14 = x^0x779ef79a8e7440000
+	for s := 0; s < 18; s++ {
+		t14.Square(t14)
+	}
+
+	// Step 106: t13 = x^0x779ef79a8e744cc49
+	t13.Mul(t13, t14)
+
+	// Step 124: t13 = x^0x1de7bde6a39d1331240000
+	for s := 0; s < 18; s++ {
+		t13.Square(t13)
+	}
+
+	// Step 125: t12 = x^0x1de7bde6a39d133124ed3d
+	t12.Mul(t12, t13)
+
+	// Step 139: t12 = x^0x779ef79a8e744cc493b4f4000
+	for s := 0; s < 14; s++ {
+		t12.Square(t12)
+	}
+
+	// Step 140: t11 = x^0x779ef79a8e744cc493b4f60a9
+	t11.Mul(t11, t12)
+
+	// Step 158: t11 = x^0x1de7bde6a39d133124ed3d82a40000
+	for s := 0; s < 18; s++ {
+		t11.Square(t11)
+	}
+
+	// Step 159: t11 = x^0x1de7bde6a39d133124ed3d82a4764b
+	t11.Mul(t9, t11)
+
+	// Step 160: t10 = x^0x1de7bde6a39d133124ed3d82a47657
+	t10.Mul(t10, t11)
+
+	// Step 176: t10 = x^0x1de7bde6a39d133124ed3d82a476570000
+	for s := 0; s < 16; s++ {
+		t10.Square(t10)
+	}
+
+	// Step 177: t9 = x^0x1de7bde6a39d133124ed3d82a47657764b
+	t9.Mul(t9, t10)
+
+	// Step 194: t9 = x^0x3bcf7bcd473a266249da7b0548ecaeec960000
+	for s := 0; s < 17; s++ {
+		t9.Square(t9)
+	}
+
+	// Step 195: t8 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1
+	t8.Mul(t8, t9)
+
+	// Step 212: t8 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba20000
+	for s := 0; s < 17; s++ {
+		t8.Square(t8)
+	}
+
+	// Step 213: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba2661d
+	t7.Mul(t7, t8)
+
+	// Step 229: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba2661d0000
+	for s := 0; s < 16; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 230: t7 = x^0x779ef79a8e744cc493b4f60a91d95dd92c6ba2661d4835
+	t7.Mul(t2, t7)
+
+	// Step 248: t7 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d40000
+	for s := 0; s < 18; s++ {
+		t7.Square(t7)
+	}
+
+	// Step 249: t6 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4ef3d
+	t6.Mul(t6, t7)
+
+	// Step 250: t5 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af
+	t5.Mul(t5, t6)
+
+	// Step 262: t5 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af000
+	for s := 0; s < 12; s++ {
+		t5.Square(t5)
+	}
+
+	// Step 263: t4 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af289
+	t4.Mul(t4, t5)
+
+	// Step 286: t4 = x^0xef3def351ce899892769ec1523b2bbb258d744cc3a906a78d7944800000
+	for s := 0; s < 23; s++ {
+		t4.Square(t4)
+	}
+
+	// Step 287: t3 = x^0xef3def351ce899892769ec1523b2bbb258d744cc3a906a78d794480384b
+	t3.Mul(t3, t4)
+
+	// Step 304: t3 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070960000
+	for s := 0; s < 17; s++ {
+		t3.Square(t3)
+	}
+
+	// Step 305: t2 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070964835
+	t2.Mul(t2, t3)
+
+	// Step 306: t2 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c906a
+	t2.Square(t2)
+
+	// Step 307: t1 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd
+	t1.Mul(t1, t2)
+
+	// Step 323: t1 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd0000
+	for s := 0; s < 16; s++ {
+		t1.Square(t1)
+	}
+
+	// Step 324: t0 = x^0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd65a7
+	t0.Mul(t0, t1)
+
+	// Step 335: t0 = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070964866b2d3800
+	for s := 0; s < 11; s++ {
+		t0.Square(t0)
+	}
+
+	// Step 336: z = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070964866b2d38b3
+	z.Mul(z, t0)
+
+	// Step 352: z = x^0x1de7bde6a39d133124ed3d82a47657764b1ae89987520d4f1af2890070964866b2d38b30000
+	for s := 0; s < 16; s++ {
+		z.Square(z)
+	}
+
+	return z
+}
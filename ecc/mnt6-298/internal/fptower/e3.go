@@ -0,0 +1,392 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+)
+
+// E3 is a degree three finite field extension of fp: 𝔽p³[u] = 𝔽p/u³-5
+type E3 struct {
+	A0, A1, A2 fp.Element
+}
+
+// Equal returns true if z equals x, false otherwise
+// note this is more efficient than calling "z == x"
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E3) Cmp(x *E3) int {
+	if a2 := z.A2.Cmp(&x.A2); a2 != 0 {
+		return a2
+	}
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *E3) LexicographicallyLargest() bool {
+	if !z.A2.IsZero() {
+		return z.A2.LexicographicallyLargest()
+	}
+	if !z.A1.IsZero() {
+		return z.A1.LexicographicallyLargest()
+	}
+	return z.A0.LexicographicallyLargest()
+}
+
+// SetString sets a E3 elmt from string
+func (z *E3) SetString(s1, s2, s3 string) *E3 {
+	z.A0.SetString(s1)
+	z.A1.SetString(s2)
+	z.A2.SetString(s3)
+	return z
+}
+
+// SetZero sets an E3 elmt to zero
+func (z *E3) SetZero() *E3 {
+	*z = E3{}
+	return z
+}
+
+// Set Sets a E3 elmt form another E3 elmt
+func (z *E3) Set(x *E3) *E3 {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E3) SetOne() *E3 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// SetRandom set z to a random elmt
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if the element is zero, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Neg negates the E3 number
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// Add adds two elements of E3
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub two elements of E3
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double doubles an element in E3
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// String puts E3 elmt in string form
+func (z *E3) String() string {
+	return (z.A0.String() + "+(" + z.A1.String() + ")*u+(" + z.A2.String() + ")*u**2")
+}
+
+// MulByElement multiplies an element in E3 by an element in fp
+func (z *E3) MulByElement(x *E3, y *fp.Element) *E3 {
+	_y := *y
+	z.A0.Mul(&x.A0, &_y)
+	z.A1.Mul(&x.A1, &_y)
+	z.A2.Mul(&x.A2, &_y)
+	return z
+}
+
+// Mul sets z to the E3-product of x,y, returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Algorithm 13 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, c0, c1, c2, tmp fp.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c0.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2).MulByNonResidue(&c0)
+
+	tmp.Add(&x.A0, &x.A2)
+	c2.Add(&y.A0, &y.A2).Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2)
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	t2.MulByNonResidue(&t2)
+
+	z.A0.Add(&c0, &t0)
+	z.A1.Add(&c1, &t2)
+	z.A2.Add(&c2, &t1)
+
+	return z
+}
+
+// Square sets z to the E3-product of x,x, returns z
+func (z *E3) Square(x *E3) *E3 {
+
+	// Algorithm 16 from https://eprint.iacr.org/2010/354.pdf
+	var c4, c5, c1, c2, c3, c0, c6 fp.Element
+
+	c6.Double(&x.A1)
+	c4.Mul(&x.A0, &c6) // x.A0 * xA1 * 2
+	c5.Square(&x.A2)
+	c1.MulByNonResidue(&c5).Add(&c1, &c4)
+	c2.Sub(&c4, &c5)
+
+	c3.Square(&x.A0)
+	c4.Sub(&x.A0, &x.A1).Add(&c4, &x.A2)
+	c5.Mul(&c6, &x.A2) // x.A1 * xA2 * 2
+	c4.Square(&c4)
+	c0.MulByNonResidue(&c5)
+	c4.Add(&c4, &c5).Sub(&c4, &c3)
+
+	z.A0.Add(&c0, &c3)
+	z.A1 = c1
+	z.A2.Add(&c2, &c4)
+
+	return z
+}
+
+// MulByNonResidue mul x by (0,1,0)
+func (z *E3) MulByNonResidue(x *E3) *E3 {
+	z.A2, z.A1, z.A0 = x.A1, x.A0, x.A2
+	z.A0.MulByNonResidue(&z.A0)
+	return z
+}
+
+// Inverse an element in E3
+//
+// if x == 0, sets and returns z = x
+func (z *E3) Inverse(x *E3) *E3 {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	// step 9 is wrong in the paper it's t1-t4
+	var t0, t1, t2, t3, t4, t5, t6, c0, c1, c2, d1, d2 fp.Element
+	t0.Square(&x.A0)
+	t1.Square(&x.A1)
+	t2.Square(&x.A2)
+	t3.Mul(&x.A0, &x.A1)
+	t4.Mul(&x.A0, &x.A2)
+	t5.Mul(&x.A1, &x.A2)
+	c0.MulByNonResidue(&t5).Neg(&c0).Add(&c0, &t0)
+	c1.MulByNonResidue(&t2).Sub(&c1, &t3)
+	c2.Sub(&t1, &t4)
+	t6.Mul(&x.A0, &c0)
+	d1.Mul(&x.A2, &c1)
+	d2.Mul(&x.A1, &c2)
+	d1.Add(&d1, &d2).MulByNonResidue(&d1)
+	t6.Add(&t6, &d1)
+	t6.Inverse(&t6)
+	z.A0.Mul(&c0, &t6)
+	z.A1.Mul(&c1, &t6)
+	z.A2.Mul(&c2, &t6)
+
+	return z
+}
+
+// BatchInvertE3 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *E3) Div(x *E3, y *E3) *E3 {
+	var r E3
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Frobenius sets z to x^p and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobCoeffE3[0])
+	z.A2.Mul(&x.A2, &frobCoeffE3[1])
+	return z
+}
+
+// norm sets x to the norm of z, i.e. z^(1+p+p²)
+func (z *E3) norm(x *fp.Element) {
+	// N(a0+a1u+a2u²) = a0³ + 5a1³ + 25a2³ - 15a0a1a2
+	var t0, t1, t2, t3 fp.Element
+	t0.Square(&z.A0).Mul(&t0, &z.A0)
+	t1.Square(&z.A1).Mul(&t1, &z.A1).MulByNonResidue(&t1)
+	t2.Square(&z.A2).Mul(&t2, &z.A2).MulByNonResidue(&t2).MulByNonResidue(&t2)
+	t3.Mul(&z.A0, &z.A1).Mul(&t3, &z.A2).MulByNonResidue(&t3)
+	x.Double(&t3).Add(x, &t3)
+	x.Sub(&t0, x).Add(x, &t1).Add(x, &t2)
+}
+
+// Legendre returns the Legendre symbol of z
+func (z *E3) Legendre() int {
+	var n fp.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ (mod q³) and returns it
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q³) == (x⁻¹)ᵏ (mod q³)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Sqrt sets z to the square root of and returns z
+// The function does not test wether the square root
+// exists or not, it's up to the caller to call
+// Legendre beforehand (nil is returned for non-squares).
+//
+// p³ = 1 mod 4 so we use Tonelli-Shanks with p³-1 = 2ˢ⋅m, s = 34
+func (z *E3) Sqrt(x *E3) *E3 {
+	if x.IsZero() {
+		return z.SetZero()
+	}
+
+	var c, t, r, b E3
+	c.Set(&sqrtG)
+	t.Exp(*x, &sqrtM)
+	r.Exp(*x, &sqrtMPlusOneHalf)
+	s := sqrtS
+
+	for !t.IsOne() {
+		// find the least i such that t^(2^i) = 1
+		i := 0
+		b.Set(&t)
+		for !b.IsOne() {
+			b.Square(&b)
+			i++
+			if i == s {
+				return nil
+			}
+		}
+
+		b.Set(&c)
+		for j := 0; j < s-i-1; j++ {
+			b.Square(&b)
+		}
+		s = i
+		c.Square(&b)
+		t.Mul(&t, &c)
+		r.Mul(&r, &b)
+	}
+
+	return z.Set(&r)
+}
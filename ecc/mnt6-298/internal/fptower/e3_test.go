@@ -0,0 +1,199 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE3Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+	genE := GenFp()
+
+	properties.Property("[MNT6-298] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT6-298] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT6-298] BatchInvertE3 should output the same result as Inverse", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvertE3([]E3{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT6-298] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] MulByElement MulByElement inverse should leave an element invariant", prop.ForAll(
+		func(a *E3, b fp.Element) bool {
+			var c E3
+			var d fp.Element
+			d.Inverse(&b)
+			c.MulByElement(a, &b).MulByElement(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[MNT6-298] MulByNonResidue should be the same as multiplying by u", prop.ForAll(
+		func(a *E3) bool {
+			var b, c, u E3
+			u.A1.SetOne()
+			b.Mul(a, &u)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Legendre on square should output 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] square(sqrt) should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b, c, d, e E3
+			b.Square(a)
+			c.Sqrt(&b)
+			d.Square(&c)
+			e.Neg(a)
+			return (c.Equal(a) || c.Equal(&e)) && d.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Frobenius of x in E3 should be equal to x^q", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Frobenius(a)
+			c.Exp(*a, fp.Modulus())
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Exp by a negative exponent should be the inverse of Exp", prop.ForAll(
+		func(a *E3, e fp.Element) bool {
+			var b, c E3
+			var k big.Int
+			e.BigInt(&k)
+			b.Exp(*a, &k).Inverse(&b)
+			k.Neg(&k)
+			c.Exp(*a, &k)
+			return c.Equal(&b)
+		},
+		genA,
+		genE,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE3Mul(b *testing.B) {
+	var a, c E3
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE3Square(b *testing.B) {
+	var a E3
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE3Inverse(b *testing.B) {
+	var a E3
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE3Sqrt(b *testing.B) {
+	var a E3
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
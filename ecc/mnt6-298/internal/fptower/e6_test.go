@@ -0,0 +1,219 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE6Serialization(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE6()
+
+	properties.Property("[MNT6-298] SetBytes(Bytes()) should stay constant", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE6Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE6()
+	genB := GenE6()
+	genE := GenFp()
+
+	properties.Property("[MNT6-298] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E6) bool {
+			var c E6
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT6-298] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E6) bool {
+			var c, d E6
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[MNT6-298] square and mul should output the same result", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] a + conj(a), a - conj(a) should be in E3", prop.ForAll(
+		func(a *E6) bool {
+			var b, c, d E6
+			var e, f, g E3
+			b.Conjugate(a)
+			c.Add(a, &b)
+			d.Sub(a, &b)
+			e.Double(&a.B0)
+			f.Double(&a.B1)
+			return c.B1.Equal(&g) && d.B0.Equal(&g) && e.Equal(&c.B0) && f.Equal(&d.B1)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Frobenius of x in E6 should be equal to x^q", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			b.Frobenius(a)
+			c.Exp(*a, fp.Modulus())
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Conjugate of x in E6 should be equal to x^(p³)", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			var e big.Int
+			e.Exp(fp.Modulus(), big.NewInt(3), nil)
+			b.Conjugate(a)
+			c.Exp(*a, &e)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] cyclotomic square and square should be the same in the cyclotomic subgroup", prop.ForAll(
+		func(a *E6) bool {
+			var b, c, d E6
+			b.Conjugate(a)
+			a.Inverse(a)
+			b.Mul(&b, a)
+			c.Square(&b)
+			d.CyclotomicSquare(&b)
+			return c.Equal(&d)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Exp and CyclotomicExp should be the same in the cyclotomic subgroup", prop.ForAll(
+		func(a *E6, e fp.Element) bool {
+			var b, c, d E6
+			var k big.Int
+			b.Conjugate(a)
+			a.Inverse(a)
+			b.Mul(&b, a)
+			e.BigInt(&k)
+			k.Neg(&k)
+			c.Exp(b, &k)
+			d.CyclotomicExp(b, &k)
+			return c.Equal(&d)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[MNT6-298] a random element should not be in GT", prop.ForAll(
+		func(a *E6) bool {
+			return !a.IsInSubGroup()
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE6Mul(b *testing.B) {
+	var a, c E6
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE6Square(b *testing.B) {
+	var a E6
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE6Cyclosquare(b *testing.B) {
+	var a E6
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.CyclotomicSquare(&a)
+	}
+}
+
+func BenchmarkE6Inverse(b *testing.B) {
+	var a E6
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import "github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+
+// frobCoeff = 5^((p-1)/6), so that v^p = frobCoeff ⋅ v
+var frobCoeff = fp.Element{
+	16082132599480508358,
+	13952760358901798211,
+	16690322481256678962,
+	3669864258446603857,
+	2498860246474,
+}
+
+// frobCoeffE3[i] = 5^((i+1)(p-1)/3), so that (uⁱ⁺¹)^p = frobCoeffE3[i] ⋅ uⁱ⁺¹
+var frobCoeffE3 = [2]fp.Element{
+	{
+		2024292666413964138,
+		2747585670412778939,
+		2432051621477522904,
+		708676480185492666,
+		569845494279,
+	},
+	{
+		15858298261740462139,
+		4154327013322069391,
+		16379268061169908578,
+		8032216682864025148,
+		1611284836009,
+	},
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+	"github.com/leanovate/gopter"
+)
+
+// GenFp generates an Fp element
+func GenFp() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var elmt fp.Element
+
+		if _, err := elmt.SetRandom(); err != nil {
+			panic(err)
+		}
+		genResult := gopter.NewGenResult(elmt, gopter.NoShrinker)
+		return genResult
+	}
+}
+
+// GenE3 generates an E3 elmt
+func GenE3() gopter.Gen {
+	return gopter.CombineGens(
+		GenFp(),
+		GenFp(),
+		GenFp(),
+	).Map(func(values []interface{}) *E3 {
+		return &E3{A0: values[0].(fp.Element), A1: values[1].(fp.Element), A2: values[2].(fp.Element)}
+	})
+}
+
+// GenE6 generates an E6 elmt
+func GenE6() gopter.Gen {
+	return gopter.CombineGens(
+		GenE3(),
+		GenE3(),
+	).Map(func(values []interface{}) *E6 {
+		return &E6{B0: *values[0].(*E3), B1: *values[1].(*E3)}
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fptower
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+)
+
+// loopCount = |t-1| where t is the trace of the Frobenius of MNT4-298
+// (MNT6-298 has trace 2-t)
+var loopCount big.Int
+
+// Tonelli-Shanks parameters in E3: p³-1 = 2^sqrtS ⋅ sqrtM,
+// sqrtG = 5^sqrtM with 5 a quadratic non-residue in fp
+var (
+	sqrtS            int
+	sqrtM            big.Int
+	sqrtMPlusOneHalf big.Int
+	sqrtG            E3
+)
+
+func init() {
+	loopCount.SetString("689871209842287392837045615510547309923794944", 10)
+
+	var one big.Int
+	one.SetUint64(1)
+	sqrtM.Exp(fp.Modulus(), big.NewInt(3), nil).Sub(&sqrtM, &one)
+	sqrtS = int(sqrtM.TrailingZeroBits())
+	sqrtM.Rsh(&sqrtM, uint(sqrtS))
+	sqrtMPlusOneHalf.Add(&sqrtM, &one).Rsh(&sqrtMPlusOneHalf, 1)
+
+	var g E3
+	g.A0.SetUint64(5)
+	sqrtG.Exp(g, &sqrtM)
+}
@@ -0,0 +1,137 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mnt6298 efficient elliptic curve, pairing and multi-exponentiation implementation for mnt6-298.
+//
+// mnt6-298: A Miyaji--Nakabayashi--Takano curve
+//
+//	embedding degree k=6
+//	𝔽r: r=0x3bcf7bcd473a266249da7b0548ecaeec9635d1330ea41a9e35e51200e12c90cd65a71660001
+//	𝔽p: p=0x3bcf7bcd473a266249da7b0548ecaeec9635cf44194fb494c07925d6ad3bb4334a400000001
+//	(E/𝔽p): Y²=X³+11X+b
+//	(Eₜ/𝔽p³): Y² = X³+11u²X+u³b (quadratic twist)
+//	r ∣ #E(Fp) and r ∣ #Eₜ(𝔽p³)
+//
+// mnt4-298 and mnt6-298 form a 2-cycle: the scalar field of one is the base field
+// of the other. E(𝔽p) has prime order r.
+//
+// Extension fields tower:
+//
+//	𝔽p³[u] = 𝔽p/u³-5
+//	𝔽p⁶[v] = 𝔽p³/v²-u
+//
+// optimal Ate loop size:
+//
+//	t-1 where t is the trace of the Frobenius (negative, of the same size as the mnt4-298 one)
+//
+// Security: well below the 128-bit level (r is 298 bits and p⁶ is 1788 bits).
+// The curve is provided for compatibility with existing recursive proof systems.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package mnt6298
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/internal/fptower"
+)
+
+// ID mnt6-298 ID
+const ID = ecc.MNT6_298
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// aTwistCurveCoeff, bTwistCurveCoeff are the coefficients of the twist (defined over 𝔽p³)
+var aTwistCurveCoeff fptower.E3
+var bTwistCurveCoeff fptower.E3
+
+// generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+var g1Gen G1Jac
+var g2Gen G2Jac
+
+var g1GenAff G1Affine
+var g2GenAff G2Affine
+
+// point at infinity
+var g1Infinity G1Jac
+var g2Infinity G2Jac
+
+// optimal Ate loop counter
+var loopCounter [150]int8
+
+// loopCount = |t-1| where t is the trace of the Frobenius
+var loopCount big.Int
+
+// expose the tower -- github.com/consensys/gnark uses it in a gnark circuit
+
+// 𝔽p³
+type E3 = fptower.E3
+
+// 𝔽p⁶
+type E6 = fptower.E6
+
+func init() {
+	aCurveCoeff.SetUint64(11)
+	bCurveCoeff.SetString("106700080510851735677967319632585352256454251201367587890185989362936000262606668469523074")
+	// a ⋅ u², b ⋅ u³
+	aTwistCurveCoeff.A2.SetUint64(11)
+	bTwistCurveCoeff.A0.SetString("57578116384997352636487348509878309737146377454014423897662211075515354005624851787652233")
+
+	g1Gen.X.SetString("336685752883082228109289846353937104185698209371404178342968838739115829740084426881123453")
+	g1Gen.Y.SetString("402596290139780989709332707716568920777622032073762749862342374583908837063963736098549800")
+	g1Gen.Z.SetOne()
+
+	g2Gen.X.SetString("421456435772811846256826561593908322288509115489119907560382401870203318738334702321297427",
+		"103072927438548502463527009961344915021167584706439945404959058962657261178393635706405114",
+		"143029172143731852627002926324735183809768363301149009204849580478324784395590388826052558")
+	g2Gen.Y.SetString("464673596668689463130099227575639512541218133445388869383893594087634649237515554342751377",
+		"100642907501977375184575075967118071807821117960152743335603284583254620685343989304941678",
+		"123019855502969896026940545715841181300275180157288044663051565390506010149881373807142903")
+	g2Gen.Z.SetString("1",
+		"0",
+		"0")
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+	g2Infinity.X.SetOne()
+	g2Infinity.Y.SetOne()
+
+	// 2-NAF decomposition of |t-1| little endian
+	loopCount.SetString("689871209842287392837045615510547309923794944", 10)
+	ecc.NafDecomposition(&loopCount, loopCounter[:])
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g2Jac G2Jac, g1Aff G1Affine, g2Aff G2Affine) {
+	g1Aff = g1GenAff
+	g2Aff = g2GenAff
+	g1Jac = g1Gen
+	g2Jac = g2Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
@@ -0,0 +1,328 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mnt6298
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+	"github.com/consensys/gnark-crypto/ecc/mnt6-298/fr"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestPairingKnownAnswer(t *testing.T) {
+	t.Parallel()
+
+	// e(g1Gen, g2Gen), computed independently
+	var expected GT
+	expected.SetString(
+		"164491855725158968338495940419097610118705575147238536983484494646000331752452688209570819",
+		"220086337917742206042722124842323034885640268541919387176659467377361813502783162183773075",
+		"399671968475311688490138792975767277759339887728978817488708888701369214565341485269277757",
+		"404833737127542908621860116396086695089483750507098058466979656777527010710794389093696256",
+		"94994545970144067545117807756424313797023398948279447132803719103900467883169113283666836",
+		"323393384891955097162027396186621363902393394100316081019725850526951182105132441971039900",
+	)
+
+	res, err := Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Equal(&expected) {
+		t.Fatal("e(g1Gen, g2Gen) doesn't match the known answer")
+	}
+}
+
+func TestPairing(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE6()
+
+	genR1 := GenFr()
+	genR2 := GenFr()
+	genP := GenFp()
+
+	properties.Property("[MNT6-298] Having the receiver as operand (final expo) should output the same result", prop.ForAll(
+		func(a GT) bool {
+			b := a
+			b = FinalExponentiation(&a)
+			a = FinalExponentiation(&a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Exponentiating FinalExpo(a) to r should output 1", prop.ForAll(
+		func(a GT) bool {
+			b := FinalExponentiation(&a)
+			return !a.IsInSubGroup() && b.IsInSubGroup()
+		},
+		genA,
+	))
+
+	properties.Property("[MNT6-298] Exp and CyclotomicExp results must be the same in GT", prop.ForAll(
+		func(a GT, e fp.Element) bool {
+			a = FinalExponentiation(&a)
+
+			var _e, ne big.Int
+			e.BigInt(&_e)
+			ne.Neg(&_e)
+
+			var b, c GT
+			b.Exp(a, &ne)
+			b.Inverse(&b)
+			c.CyclotomicExp(a, &_e)
+
+			return b.Equal(&c)
+		},
+		genA,
+		genP,
+	))
+
+	properties.Property("[MNT6-298] bilinearity", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var res, resa, resb, resab, zero GT
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint, ab big.Int
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+			ab.Mul(&abigint, &bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			res, _ = Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+			resa, _ = Pair([]G1Affine{ag1}, []G2Affine{g2GenAff})
+			resb, _ = Pair([]G1Affine{g1GenAff}, []G2Affine{bg2})
+
+			resab.Exp(res, &ab)
+			resa.Exp(resa, &bbigint)
+			resb.Exp(resb, &abigint)
+
+			return resab.Equal(&resa) && resab.Equal(&resb) && !res.Equal(&zero)
+
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[MNT6-298] PairingCheck", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var g1GenAffNeg G1Affine
+			g1GenAffNeg.Neg(&g1GenAff)
+			tabP := []G1Affine{g1GenAff, g1GenAffNeg}
+			tabQ := []G2Affine{g2GenAff, g2GenAff}
+
+			res, _ := PairingCheck(tabP, tabQ)
+
+			return res
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestMillerLoop(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genR1 := GenFr()
+	genR2 := GenFr()
+
+	properties.Property("[MNT6-298] MillerLoop of pairs should be equal to the product of MillerLoops", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var simpleProd, factorizedProd GT
+
+			var ag1 G1Affine
+			var bg2 G2Affine
+
+			var abigint, bbigint big.Int
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			P0 := []G1Affine{g1GenAff}
+			P1 := []G1Affine{ag1}
+			Q0 := []G2Affine{g2GenAff}
+			Q1 := []G2Affine{bg2}
+
+			// FE( ML(a,b) * ML(c,d) * ML(e,f) * ML(g,h) )
+			M1, _ := MillerLoop(P0, Q0)
+			M2, _ := MillerLoop(P1, Q0)
+			M3, _ := MillerLoop(P0, Q1)
+			M4, _ := MillerLoop(P1, Q1)
+			simpleProd.Mul(&M1, &M2).Mul(&simpleProd, &M3).Mul(&simpleProd, &M4)
+			simpleProd = FinalExponentiation(&simpleProd)
+
+			tabP := []G1Affine{g1GenAff, ag1, g1GenAff, ag1}
+			tabQ := []G2Affine{g2GenAff, g2GenAff, bg2, bg2}
+
+			// FE( ML([a,c,e,g] ; [b,d,f,h]) )
+			factorizedProd, _ = Pair(tabP, tabQ)
+
+			return simpleProd.Equal(&factorizedProd)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.Property("[MNT6-298] MillerLoop should skip pairs with a point at infinity", prop.ForAll(
+		func(a, b fr.Element) bool {
+
+			var one GT
+
+			var ag1, g1Inf G1Affine
+			var bg2, g2Inf G2Affine
+
+			var abigint, bbigint big.Int
+
+			one.SetOne()
+
+			a.BigInt(&abigint)
+			b.BigInt(&bbigint)
+
+			ag1.ScalarMultiplication(&g1GenAff, &abigint)
+			bg2.ScalarMultiplication(&g2GenAff, &bbigint)
+
+			g1Inf.FromJacobian(&g1Infinity)
+			g2Inf.FromJacobian(&g2Infinity)
+
+			// e([0,c] ; [b,d])
+			tabP := []G1Affine{g1Inf, ag1}
+			tabQ := []G2Affine{g2GenAff, bg2}
+			res1, _ := Pair(tabP, tabQ)
+
+			// e([a,c] ; [0,d])
+			tabP = []G1Affine{g1GenAff, ag1}
+			tabQ = []G2Affine{g2Inf, bg2}
+			res2, _ := Pair(tabP, tabQ)
+
+			// e([0,c] ; [d,0])
+			tabP = []G1Affine{g1Inf, ag1}
+			tabQ = []G2Affine{bg2, g2Inf}
+			res3, _ := Pair(tabP, tabQ)
+
+			return res1.Equal(&res2) && !res2.Equal(&res3) && res3.Equal(&one)
+		},
+		genR1,
+		genR2,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkPairing(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Pair([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	}
+}
+
+func BenchmarkMillerLoop(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MillerLoop([]G1Affine{g1GenAff}, []G2Affine{g2GenAff})
+	}
+}
+
+func BenchmarkFinalExponentiation(b *testing.B) {
+
+	var a GT
+	a.SetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FinalExponentiation(&a)
+	}
+
+}
+
+func BenchmarkMultiMiller(b *testing.B) {
+
+	var g1GenAff G1Affine
+	var g2GenAff G2Affine
+
+	g1GenAff.FromJacobian(&g1Gen)
+	g2GenAff.FromJacobian(&g2Gen)
+
+	n := 10
+	P := make([]G1Affine, n)
+	Q := make([]G2Affine, n)
+
+	for i := 2; i <= n; i++ {
+		for j := 0; j < i; j++ {
+			P[j].Set(&g1GenAff)
+			Q[j].Set(&g2GenAff)
+		}
+		b.Run(fmt.Sprintf("%d pairs", i), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				MillerLoop(P, Q)
+			}
+		})
+	}
+}
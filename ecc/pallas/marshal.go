@@ -0,0 +1,117 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pallas
+
+import (
+	"errors"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/pallas/fp"
+)
+
+// To be compatible with the Zcash pasta_curves crate, a point is encoded
+// as its X coordinate in little endian, with the parity of Y stored in the
+// most significant bit of the last byte (fp.Bits = 255 leaves it unused).
+// The point at infinity is encoded as 32 zero bytes.
+const mYOdd byte = 0b1 << 7
+
+// SizeOfG1AffineCompressed represents the size in bytes that a G1Affine need in binary form, compressed
+const SizeOfG1AffineCompressed = fp.Bytes
+
+// Marshal converts p to a byte slice (compressed, Zcash format)
+func (p *G1Affine) Marshal() []byte {
+	b := p.Bytes()
+	return b[:]
+}
+
+// Unmarshal is an alias to SetBytes()
+func (p *G1Affine) Unmarshal(buf []byte) error {
+	_, err := p.SetBytes(buf)
+	return err
+}
+
+// Bytes returns binary representation of p
+// will store X coordinate in little endian and the parity of Y in the most significant bit
+func (p *G1Affine) Bytes() (res [SizeOfG1AffineCompressed]byte) {
+
+	// check if p is infinity point
+	if p.X.IsZero() && p.Y.IsZero() {
+		return
+	}
+
+	fp.LittleEndian.PutElement(&res, p.X)
+
+	y := p.Y.Bits()
+	if y[0]&1 == 1 {
+		res[fp.Bytes-1] |= mYOdd
+	}
+
+	return
+}
+
+// SetBytes sets p from binary representation in buf and returns number of consumed bytes
+//
+// bytes in buf must match Bytes()
+//
+// if buf is too short io.ErrShortBuffer is returned
+//
+// this check if the resulting point is on the curve (the curve has prime order)
+func (p *G1Affine) SetBytes(buf []byte) (int, error) {
+	return p.setBytes(buf, true)
+}
+
+func (p *G1Affine) setBytes(buf []byte, subGroupCheck bool) (int, error) {
+	if len(buf) < SizeOfG1AffineCompressed {
+		return 0, io.ErrShortBuffer
+	}
+
+	var bufX [fp.Bytes]byte
+	copy(bufX[:], buf[:fp.Bytes])
+	yOdd := bufX[fp.Bytes-1]&mYOdd != 0
+	bufX[fp.Bytes-1] &^= mYOdd
+
+	x, err := fp.LittleEndian.Element(&bufX)
+	if err != nil {
+		return 0, err
+	}
+
+	// infinity is encoded as X=0 with an even Y; (0, ±√5) is not on the curve
+	if x.IsZero() && !yOdd {
+		p.X.SetZero()
+		p.Y.SetZero()
+		return SizeOfG1AffineCompressed, nil
+	}
+
+	// y² = x³ + b
+	var y fp.Element
+	y.Square(&x).Mul(&y, &x).Add(&y, &bCurveCoeff)
+	if y.Sqrt(&y) == nil {
+		return 0, errors.New("invalid compressed coordinate: square root doesn't exist")
+	}
+
+	if yBits := y.Bits(); (yBits[0]&1 == 1) != yOdd {
+		y.Neg(&y)
+	}
+
+	p.X = x
+	p.Y = y
+
+	// subgroup check
+	if subGroupCheck && !p.IsInSubGroup() {
+		return 0, errors.New("invalid point: subgroup check failed")
+	}
+
+	return SizeOfG1AffineCompressed, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pallas
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	"github.com/consensys/gnark-crypto/ecc/pallas/fp"
+)
+
+func TestG1AffineSerialization(t *testing.T) {
+	t.Parallel()
+	// test round trip serialization of infinity
+	{
+		var p1, p2 G1Affine
+		p2.X.SetRandom()
+		p2.Y.SetRandom()
+		buf := p1.Bytes()
+		if buf != [SizeOfG1AffineCompressed]byte{} {
+			t.Fatal("infinity point should be encoded as zeroes")
+		}
+		n, err := p2.SetBytes(buf[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != SizeOfG1AffineCompressed {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+		if !(p2.X.IsZero() && p2.Y.IsZero()) {
+			t.Fatal("deserialization of compressed infinity point is not infinity")
+		}
+	}
+
+	// (0, y odd) is not a valid encoding
+	{
+		var p G1Affine
+		var buf [SizeOfG1AffineCompressed]byte
+		buf[SizeOfG1AffineCompressed-1] = mYOdd
+		if _, err := p.SetBytes(buf[:]); err == nil {
+			t.Fatal("expected an error for x=0 with y odd")
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[G1] Affine SetBytes(Bytes) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.Bytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineCompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// TestG1AffineSerializationZcash checks the encoding against the one of the pasta_curves crate
+// (GroupEncoding::to_bytes): x in little endian, the parity of y in the most significant bit,
+// and the identity as zeroes. The expected bytes were printed by the crate (github.com/zcash/pasta_curves
+// at 24c71faa6f19) for the same multiples of its generator (-1, 2).
+func TestG1AffineSerializationZcash(t *testing.T) {
+	t.Parallel()
+	var infinity, g2, gNeg, g3, g3Neg, gk G1Affine
+	g2.Double(&g1GenAff)
+	gNeg.Neg(&g1GenAff)
+	g3.ScalarMultiplication(&g1GenAff, big.NewInt(3))
+	g3Neg.Neg(&g3)
+	k, _ := new(big.Int).SetString("12345678901234567890", 10)
+	gk.ScalarMultiplication(&g1GenAff, k)
+
+	for _, tc := range []struct {
+		p   *G1Affine
+		hex string
+	}{
+		{&g1GenAff, "00000000ed302d991bf94c09fc98462200000000000000000000000000000040"},
+		{&g2, "030000b067c50313fcac1144eee2fe0e0000000000000000000000000000001c"},
+		{&gNeg, "00000000ed302d991bf94c09fc984622000000000000000000000000000000c0"},
+		{&g3, "63d232eb3b8af0b75cfcf55ade47f6ff4cdf4e47a7454cb8ed67a9ba6f56e788"},
+		{&g3Neg, "63d232eb3b8af0b75cfcf55ade47f6ff4cdf4e47a7454cb8ed67a9ba6f56e708"},
+		{&gk, "d73e1eb99fd8443f1f6530058f18e7e957e8c9e53e97ab5b602d21e2e4aeb59d"},
+		{&infinity, "0000000000000000000000000000000000000000000000000000000000000000"},
+	} {
+		buf := tc.p.Bytes()
+		if hex.EncodeToString(buf[:]) != tc.hex {
+			t.Fatalf("unexpected encoding %x", buf)
+		}
+		var q G1Affine
+		if err := q.Unmarshal(buf[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !q.Equal(tc.p) {
+			t.Fatal("decoded point doesn't match")
+		}
+	}
+
+	// x ≥ p is rejected
+	buf, _ := hex.DecodeString("01000000ed302d991bf94c09fc98462200000000000000000000000000000040")
+	var q G1Affine
+	if err := q.Unmarshal(buf); err == nil {
+		t.Fatal("expected an error for a non canonical x coordinate")
+	}
+}
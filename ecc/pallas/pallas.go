@@ -0,0 +1,103 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pallas efficient elliptic curve implementation for pallas. Pallas and Vesta form the Pasta cycle:
+// the base field of pallas is the scalar field of vesta and vice versa. They are used in Halo 2 and
+// other recursive proof systems.
+//
+// pallas: A j=0 curve with
+//
+//	𝔽r: r=28948022309329048855892746252171976963363056481941647379679742748393362948097
+//	𝔽p: p=28948022309329048855892746252171976963363056481941560715954676764349967630337
+//	(E/𝔽p): Y²=X³+5
+//
+// The serialization of points follows the Zcash pasta_curves crate (see marshal.go).
+// So does HashToG1, with BLAKE2b-512 in hash_to_field (see hashToFp).
+//
+// Security: estimated 126-bit level using Pollard's \rho attack
+// (r is 255 bits)
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package pallas
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/pallas/fp"
+	"github.com/consensys/gnark-crypto/ecc/pallas/fr"
+)
+
+// ID pallas ID
+const ID = ecc.PALLAS
+
+// aCurveCoeff is the a coefficients of the curve Y²=X³+ax+b
+var aCurveCoeff fp.Element
+var bCurveCoeff fp.Element
+
+// generator of the r-torsion group
+var g1Gen G1Jac
+
+var g1GenAff G1Affine
+
+// point at infinity
+var g1Infinity G1Jac
+
+// Parameters useful for the GLV scalar multiplication. The third roots define the
+// endomorphisms ϕ₁ for <G1Affine>. lambda is such that <r, ϕ-λ> lies above
+// <r> in the ring Z[ϕ]. More concretely it's the associated eigenvalue
+// of ϕ₁ restricted to <G1Affine>
+// see https://www.cosic.esat.kuleuven.be/nessie/reports/phase2/GLV.pdf
+var thirdRootOneG1 fp.Element
+var lambdaGLV big.Int
+
+// glvBasis stores R-linearly independent vectors (a,b), (c,d)
+// in ker((u,v) → u+vλ[r]), and their determinant
+var glvBasis ecc.Lattice
+
+func init() {
+	aCurveCoeff.SetUint64(0)
+	bCurveCoeff.SetUint64(5)
+
+	// (-1, 2)
+	g1Gen.X.SetOne().Neg(&g1Gen.X)
+	g1Gen.Y.SetUint64(2)
+	g1Gen.Z.SetOne()
+
+	g1GenAff.FromJacobian(&g1Gen)
+
+	// (X,Y,Z) = (1,1,0)
+	g1Infinity.X.SetOne()
+	g1Infinity.Y.SetOne()
+
+	thirdRootOneG1.SetString("8503465768106391777493614032514048814691664078728891710322960303815233784505")
+	lambdaGLV.SetString("2942865608506852014473558576493638302197734138389222805617480874486368177743", 10)
+	_r := fr.Modulus()
+	ecc.PrecomputeLattice(_r, &lambdaGLV, &glvBasis)
+
+}
+
+// Generators return the generators of the r-torsion group, resp. in ker(pi-id), ker(Tr)
+func Generators() (g1Jac G1Jac, g1Aff G1Affine) {
+	g1Aff = g1GenAff
+	g1Jac = g1Gen
+	return
+}
+
+// CurveCoefficients returns the a, b coefficients of the curve equation.
+func CurveCoefficients() (a, b fp.Element) {
+	return aCurveCoeff, bCurveCoeff
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+)
+
+// maxLogSize is such that G generates a subgroup of order 2^maxLogSize of E(𝔽): y² = x³ + a⋅x + b.
+// The domains have a cardinality up to 2^(maxLogSize-1).
+const maxLogSize = 16
+
+// the curve E, the generator G of its 2-Sylow subgroup (which is cyclic), and the shift R of the coset R + ⟨G⟩.
+// E and G were found by sampling curves y² = x⋅(x² + α⋅x + β) and halving their point (0, 0) of order 2,
+// and R is the point of smallest x-coordinate ⩾ 1 (2R ∉ ⟨G⟩, so that the x-coordinates of R + ⟨G⟩ are distinct).
+const (
+	curveA     = "23914452626269617154846528609881440340238808243928032610110248743691442644670"
+	curveB     = "98018524674975341086781139432679586577523772095148057148117067871700070388771"
+	generatorX = "23277673020301472448956363732103651495849913851235528303771960006618337115152"
+	generatorY = "33292578629124968850531341981054078193595581145778134894398477283690140516403"
+	shiftX     = "5"
+	shiftY     = "24477426811118600082480480558633293635987410581703112223358016463410112637094"
+)
+
+// isogenyChain holds the sets Lᵢ of the ECFFT. L₀ holds the x-coordinates of R + j⋅G for j < 2^maxLogSize,
+// and Lᵢ₊₁ = ψᵢ(Lᵢ), where ψᵢ(x) = x + tᵢ/(x - x0ᵢ) is the map on the x-coordinates of the 2-isogeny whose kernel
+// is the point of order 2 of the image of ⟨G⟩ (Vélu's formulas).
+// ψᵢ maps both Lᵢ[j] and Lᵢ[j + |Lᵢ|/2] to Lᵢ₊₁[j].
+type isogenyChain struct {
+	l     [][]fp.Element
+	x0, t []fp.Element
+}
+
+var (
+	chainOnce sync.Once
+	chain     isogenyChain
+)
+
+// getChain returns the isogeny chain, computing it on the first call
+func getChain() *isogenyChain {
+	chainOnce.Do(func() {
+		chain = newIsogenyChain()
+	})
+	return &chain
+}
+
+func newIsogenyChain() isogenyChain {
+	var a, gx, gy fp.Element
+	setString(&a, curveA)
+	setString(&gx, generatorX)
+	setString(&gy, generatorY)
+
+	n := 1 << maxLogSize
+	var c isogenyChain
+	c.l = make([][]fp.Element, maxLogSize)
+	c.x0 = make([]fp.Element, maxLogSize-1)
+	c.t = make([]fp.Element, maxLogSize-1)
+
+	// xs[j], ys[j] = R + j⋅G, adding 2ᵏ⋅G to the first 2ᵏ points at the k-th step
+	xs := make([]fp.Element, n)
+	ys := make([]fp.Element, n)
+	setString(&xs[0], shiftX)
+	setString(&ys[0], shiftY)
+	// kernels[k] = x(2ᵏ⋅G)
+	kernels := make([]fp.Element, maxLogSize)
+	den := make([]fp.Element, n/2)
+	for k, m := 0, 1; m < n; k, m = k+1, 2*m {
+		kernels[k] = gx
+		for j := 0; j < m; j++ {
+			den[j].Sub(&xs[j], &gx)
+		}
+		inv := fp.BatchInvert(den[:m])
+		for j := 0; j < m; j++ {
+			// λ = (y - y_G) / (x - x_G)
+			var lambda, tmp fp.Element
+			lambda.Sub(&ys[j], &gy).Mul(&lambda, &inv[j])
+			xs[j+m].Square(&lambda).Sub(&xs[j+m], &xs[j]).Sub(&xs[j+m], &gx)
+			tmp.Sub(&xs[j], &xs[j+m])
+			ys[j+m].Mul(&lambda, &tmp).Sub(&ys[j+m], &ys[j])
+		}
+		if m < n/2 {
+			doubleAffine(&gx, &gy, &a)
+		}
+	}
+	c.l[0] = xs
+
+	for i := 0; i < maxLogSize-1; i++ {
+		// the kernel of ψᵢ is the point of order 2 of the image of ⟨G⟩, image of 2^(maxLogSize-1-i)⋅G
+		k := maxLogSize - 1 - i
+		c.x0[i] = kernels[k]
+
+		// t = 3⋅x0² + a, and the curve of the image has a' = a - 5⋅t
+		var tmp fp.Element
+		c.t[i].Square(&c.x0[i])
+		tmp.Double(&c.t[i])
+		c.t[i].Add(&c.t[i], &tmp).Add(&c.t[i], &a)
+		tmp.Double(&c.t[i]).Double(&tmp).Add(&tmp, &c.t[i])
+		a.Sub(&a, &tmp)
+
+		m := len(c.l[i]) / 2
+		c.l[i+1] = c.psi(i, c.l[i][:m])
+		copy(kernels[:k], c.psi(i, kernels[:k]))
+	}
+
+	return c
+}
+
+// psi returns ψᵢ(x) for the elements x of xs
+func (c *isogenyChain) psi(i int, xs []fp.Element) []fp.Element {
+	res := make([]fp.Element, len(xs))
+	for j := range xs {
+		res[j].Sub(&xs[j], &c.x0[i])
+	}
+	res = fp.BatchInvert(res)
+	for j := range xs {
+		res[j].Mul(&res[j], &c.t[i]).Add(&res[j], &xs[j])
+	}
+	return res
+}
+
+// doubleAffine sets (x, y) to 2⋅(x, y) on y² = x³ + a⋅x + b
+func doubleAffine(x, y, a *fp.Element) {
+	// λ = (3x² + a) / 2y
+	var lambda, den, x3 fp.Element
+	lambda.Square(x)
+	den.Double(&lambda)
+	lambda.Add(&lambda, &den).Add(&lambda, a)
+	den.Double(y).Inverse(&den)
+	lambda.Mul(&lambda, &den)
+	x3.Square(&lambda).Sub(&x3, x).Sub(&x3, x)
+	// y₃ = λ⋅(x - x₃) - y
+	den.Sub(x, &x3).Mul(&den, &lambda)
+	y.Sub(&den, y)
+	x.Set(&x3)
+}
+
+func setString(z *fp.Element, s string) {
+	if _, err := z.SetString(s); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecfft provides the elliptic curve fast Fourier transform (ECFFT) on fp.
+//
+// The field has no large multiplicative subgroup of order 2ᵏ, so the radix-2 FFT does not apply.
+// Instead, following Ben-Sasson, Carmon, Kopparty and Levit (https://arxiv.org/abs/2107.08473),
+// the evaluation domains are sets of x-coordinates of a coset of a subgroup of order 2ᵏ of an elliptic curve
+// over the field, and the 2-to-1 maps replacing x ↦ x² are 2-isogenies between curves.
+//
+// A Domain of cardinality n supports:
+//   - Extend: evaluations of a polynomial of degree < n on the points of the domain ↦ evaluations on its n extension points,
+//   - LowDegreeExtension: evaluations on the points ↦ evaluations on the points of the domain of cardinality 2n,
+//   - Evaluate and Interpolate: conversions between coefficients and evaluations on the points,
+//
+// in O(n⋅log(n)) operations for Extend, and O(n⋅log²(n)) for Evaluate and Interpolate.
+package ecfft
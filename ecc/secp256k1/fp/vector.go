@@ -21,6 +21,9 @@ import (
 	"encoding/binary"
 	"io"
 	"strings"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 // Vector represents a slice of Element.
@@ -32,6 +35,9 @@ import (
 //   - encoding.BinaryMarshaler
 //   - encoding.BinaryUnmarshaler
 //   - sort.Interface
+//
+// It also provides element-wise arithmetic (Add, Sub, Mul, ScalarMul) and reductions (Sum, InnerProduct).
+// These take an optional number of tasks to split the work across goroutines, runtime.NumCPU() by default.
 type Vector []Element
 
 // MarshalBinary implements encoding.BinaryMarshaler
@@ -130,3 +136,134 @@ func (vector Vector) Less(i, j int) bool {
 func (vector Vector) Swap(i, j int) {
 	vector[i], vector[j] = vector[j], vector[i]
 }
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector, nbTasks ...int) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	res := *vector
+	parallel.Execute(len(a), func(start, end int) {
+		vectorAdd(res[start:end], a[start:end], b[start:end])
+	}, nbTasks...)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector, nbTasks ...int) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	res := *vector
+	parallel.Execute(len(a), func(start, end int) {
+		vectorSub(res[start:end], a[start:end], b[start:end])
+	}, nbTasks...)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector, nbTasks ...int) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	res := *vector
+	parallel.Execute(len(a), func(start, end int) {
+		mulVecGeneric(res[start:end], a[start:end], b[start:end])
+	}, nbTasks...)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element, nbTasks ...int) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	res := *vector
+	parallel.Execute(len(a), func(start, end int) {
+		scalarMulVecGeneric(res[start:end], a[start:end], b)
+	}, nbTasks...)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector Vector) Sum(nbTasks ...int) (res Element) {
+	var lock sync.Mutex
+	parallel.Execute(len(vector), func(start, end int) {
+		partial := sumVecGeneric(vector[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, nbTasks...)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector Vector) InnerProduct(other Vector, nbTasks ...int) (res Element) {
+	if len(vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var lock sync.Mutex
+	parallel.Execute(len(vector), func(start, end int) {
+		partial := innerProductVecGeneric(vector[start:end], other[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, nbTasks...)
+	return
+}
+
+func addVecGeneric(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *Element) {
+	if len(a) != len(res) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func sumVecGeneric(v Vector) (res Element) {
+	for i := 0; i < len(v); i++ {
+		res.Add(&res, &v[i])
+	}
+	return
+}
+
+func innerProductVecGeneric(a, b Vector) (res Element) {
+	if len(a) != len(b) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp Element
+	for i := 0; i < len(a); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
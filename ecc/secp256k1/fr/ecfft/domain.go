@@ -0,0 +1,346 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// Domain of the ECFFT of cardinality n: its points are the n x-coordinates of R + 2⋅σ⋅j⋅G,
+// and its extension points the n x-coordinates of R + σ⋅(2⋅j + 1)⋅G, where σ = 2^maxLogSize/2n (see isogenyChain).
+//
+// A polynomial of degree < n is determined by its evaluations on the points; Extend computes
+// its evaluations on the extension points.
+type Domain struct {
+	Cardinality uint64
+
+	chain  *isogenyChain
+	stride int
+
+	// levels[i] holds the precomputations of the i-th recursion of Extend
+	levels []extensionLevel
+
+	// sub is the domain of cardinality n/2, whose points (resp. extension points) are the points of even
+	// (resp. odd) index of d, used by Evaluate and Interpolate.
+	sub *Domain
+
+	// xPow[j] = xⱼ^(n/2) for the points xⱼ of d
+	xPow []fr.Element
+	// xPowInv[j] = 1/xⱼ^(n/2) for the points xⱼ of sub
+	xPowInv []fr.Element
+	// vanishingInv[j] = 1/Z(yⱼ) for the extension points yⱼ of sub, Z being the vanishing polynomial of the points of sub
+	vanishingInv []fr.Element
+	// redcSquare holds the evaluations on the points of d of Z² mod X^(n/2)
+	redcSquare []fr.Element
+}
+
+// extensionLevel holds, for the sets Sᵢ and S'ᵢ (images of the points and of the extension points of the domain
+// by ψᵢ₋₁∘…∘ψ₀) of size 2h, and their elements x:
+//   - vPow[side][j] = vᵢ(x)^(h-1) and vPowInv[side][j] = 1/vᵢ(x)^(h-1), where ψᵢ = uᵢ/vᵢ and vᵢ(x) = x - x0ᵢ,
+//   - diffInv[side][j] = 1/(x_j - x_{j+h}) for j < h, the pairs x_j, x_{j+h} having the same image by ψᵢ.
+type extensionLevel struct {
+	vPow, vPowInv, diffInv [2][]fr.Element
+}
+
+// NewDomain returns the ECFFT domain of cardinality the smallest power of 2 ⩾ m.
+// It panics if m > 2^(maxLogSize-1).
+func NewDomain(m uint64) *Domain {
+	n := ecc.NextPowerOfTwo(m)
+	if n > 1<<(maxLogSize-1) {
+		panic(fmt.Sprintf("the cardinality of the domain (%d) must be at most 2^%d", n, maxLogSize-1))
+	}
+	return newDomain(getChain(), int(n))
+}
+
+func newDomain(c *isogenyChain, n int) *Domain {
+	d := &Domain{
+		Cardinality: uint64(n),
+		chain:       c,
+		stride:      (1 << maxLogSize) / (2 * n),
+	}
+
+	for h := n / 2; h >= 1; h /= 2 {
+		i := len(d.levels)
+		var level extensionLevel
+		for side := 0; side < 2; side++ {
+			level.vPow[side] = make([]fr.Element, 2*h)
+			level.diffInv[side] = make([]fr.Element, h)
+			e := big.NewInt(int64(h - 1))
+			for j := 0; j < 2*h; j++ {
+				level.vPow[side][j].Sub(d.point(i, side, j), &c.x0[i])
+				level.vPow[side][j].Exp(level.vPow[side][j], e)
+			}
+			for j := 0; j < h; j++ {
+				level.diffInv[side][j].Sub(d.point(i, side, j), d.point(i, side, j+h))
+			}
+			level.vPowInv[side] = fr.BatchInvert(level.vPow[side])
+			level.diffInv[side] = fr.BatchInvert(level.diffInv[side])
+		}
+		d.levels = append(d.levels, level)
+	}
+
+	if n == 1 {
+		return d
+	}
+	d.sub = newDomain(c, n/2)
+
+	h := n / 2
+	e := big.NewInt(int64(h))
+	d.xPow = make([]fr.Element, n)
+	for j := range d.xPow {
+		d.xPow[j].Exp(*d.point(0, 0, j), e)
+	}
+	d.xPowInv = make([]fr.Element, h)
+	for j := range d.xPowInv {
+		d.xPowInv[j] = d.xPow[2*j]
+	}
+	d.xPowInv = fr.BatchInvert(d.xPowInv)
+	d.vanishingInv = fr.BatchInvert(d.sub.vanishing())
+
+	// Z = X^h + W on the points of sub, with deg(W) < h, and Z² mod X^h = W² mod X^h
+	w := make([]fr.Element, h)
+	for j := range w {
+		w[j].Neg(&d.xPow[2*j])
+	}
+	w = d.sub.Interpolate(w)
+	square := make([]fr.Element, h)
+	if h == 1 {
+		square[0].Square(&w[0])
+	} else {
+		// with W = W₀ + X^(h/2)⋅W₁, W² mod X^h = W₀² + 2⋅X^(h/2)⋅(W₀⋅W₁ mod X^(h/2))
+		q := h / 2
+		copy(square, d.sub.mul(w[:q], w[:q]))
+		cross := d.sub.mul(w[:q], w[q:])
+		for j := 0; j < q; j++ {
+			cross[j].Double(&cross[j])
+			square[q+j].Add(&square[q+j], &cross[j])
+		}
+	}
+	d.redcSquare = d.Evaluate(square)
+
+	return d
+}
+
+// point returns the j-th element of Sᵢ (side = 0) or S'ᵢ (side = 1), see extensionLevel
+func (d *Domain) point(i, side, j int) *fr.Element {
+	return &d.chain.l[i][d.stride*(2*j+side)]
+}
+
+// Points returns the points of the domain
+func (d *Domain) Points() []fr.Element {
+	return d.points(0)
+}
+
+// ExtensionPoints returns the extension points of the domain
+func (d *Domain) ExtensionPoints() []fr.Element {
+	return d.points(1)
+}
+
+func (d *Domain) points(side int) []fr.Element {
+	res := make([]fr.Element, d.Cardinality)
+	for j := range res {
+		res[j] = *d.point(0, side, j)
+	}
+	return res
+}
+
+// Extend returns the evaluations on the extension points of the polynomial of degree < n
+// whose evaluations on the points of the domain are a.
+func (d *Domain) Extend(a []fr.Element) []fr.Element {
+	d.checkLen(a)
+	return d.extend(a, 0, 0)
+}
+
+// LowDegreeExtension returns the evaluations on the 2n points of NewDomain(2n) of the polynomial of degree < n
+// whose evaluations on the points of the domain are a: the points of NewDomain(2n) are the points and
+// the extension points of the domain, interleaved.
+func (d *Domain) LowDegreeExtension(a []fr.Element) []fr.Element {
+	ext := d.Extend(a)
+	res := make([]fr.Element, 2*len(a))
+	for j := range a {
+		res[2*j] = a[j]
+		res[2*j+1] = ext[j]
+	}
+	return res
+}
+
+// extend maps the evaluations a of P on Sᵢ (side = 0) or S'ᵢ (side = 1) to its evaluations on the other set.
+//
+// With ψᵢ = uᵢ/vᵢ and 2h = len(a), P = vᵢ^(h-1)⋅(U(ψᵢ) + X⋅V(ψᵢ)) where deg(U), deg(V) < h.
+// For the pairs x₀, x₁ with the same image t by ψᵢ, U(t) and V(t) are the solutions of a 2×2 linear system,
+// and their evaluations on the other set are computed recursively.
+func (d *Domain) extend(a []fr.Element, i, side int) []fr.Element {
+	res := make([]fr.Element, len(a))
+	if len(a) == 1 {
+		res[0] = a[0]
+		return res
+	}
+	h := len(a) / 2
+	level := &d.levels[i]
+
+	u := make([]fr.Element, h)
+	v := make([]fr.Element, h)
+	for j := 0; j < h; j++ {
+		// P(x₀)/vᵢ(x₀)^(h-1) = U(t) + x₀⋅V(t) and P(x₁)/vᵢ(x₁)^(h-1) = U(t) + x₁⋅V(t)
+		var p0, p1 fr.Element
+		p0.Mul(&a[j], &level.vPowInv[side][j])
+		p1.Mul(&a[j+h], &level.vPowInv[side][j+h])
+		v[j].Sub(&p0, &p1).Mul(&v[j], &level.diffInv[side][j])
+		p1.Mul(d.point(i, side, j), &v[j])
+		u[j].Sub(&p0, &p1)
+	}
+
+	u = d.extend(u, i+1, side)
+	v = d.extend(v, i+1, side)
+
+	other := 1 - side
+	for j := 0; j < h; j++ {
+		res[j].Mul(d.point(i, other, j), &v[j]).Add(&res[j], &u[j]).Mul(&res[j], &level.vPow[other][j])
+		res[j+h].Mul(d.point(i, other, j+h), &v[j]).Add(&res[j+h], &u[j]).Mul(&res[j+h], &level.vPow[other][j+h])
+	}
+	return res
+}
+
+// vanishing returns the evaluations on the extension points of the vanishing polynomial of the points.
+//
+// If ψᵢ(Sᵢ) = Sᵢ₊₁ with |Sᵢ| = 2h, then Z_{Sᵢ} = vᵢ^h⋅Z_{Sᵢ₊₁}(ψᵢ), as uᵢ - t⋅vᵢ = (X - x₀)⋅(X - x₁) where ψᵢ(x₀) = ψᵢ(x₁) = t.
+func (d *Domain) vanishing() []fr.Element {
+	return d.vanishingLevel(0, int(d.Cardinality))
+}
+
+func (d *Domain) vanishingLevel(i, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	if n == 1 {
+		res[0].Sub(d.point(i, 1, 0), d.point(i, 0, 0))
+		return res
+	}
+	h := n / 2
+	z := d.vanishingLevel(i+1, h)
+	level := &d.levels[i]
+	for j := 0; j < n; j++ {
+		res[j].Sub(d.point(i, 1, j), &d.chain.x0[i]).
+			Mul(&res[j], &level.vPow[1][j]).
+			Mul(&res[j], &z[j%h])
+	}
+	return res
+}
+
+// Evaluate returns the evaluations on the points of the domain of the polynomial of coefficients p
+// (in increasing degree order), where len(p) ⩽ n.
+//
+// With P = P₀ + X^(n/2)⋅P₁, P₀ and P₁ are evaluated on the points of the sub-domain, extended to its extension points,
+// and recombined.
+func (d *Domain) Evaluate(p []fr.Element) []fr.Element {
+	n := int(d.Cardinality)
+	if len(p) > n {
+		panic(fmt.Sprintf("the polynomial has %d coefficients, more than the cardinality of the domain (%d)", len(p), n))
+	}
+	if len(p) < n {
+		p = append(append(make([]fr.Element, 0, n), p...), make([]fr.Element, n-len(p))...)
+	}
+	if n == 1 {
+		return []fr.Element{p[0]}
+	}
+
+	h := n / 2
+	e0 := d.sub.Evaluate(p[:h])
+	e1 := d.sub.Evaluate(p[h:])
+	f0 := d.sub.extend(e0, 0, 0)
+	f1 := d.sub.extend(e1, 0, 0)
+
+	res := make([]fr.Element, n)
+	for j := 0; j < h; j++ {
+		res[2*j].Mul(&d.xPow[2*j], &e1[j]).Add(&res[2*j], &e0[j])
+		res[2*j+1].Mul(&d.xPow[2*j+1], &f1[j]).Add(&res[2*j+1], &f0[j])
+	}
+	return res
+}
+
+// Interpolate returns the coefficients (in increasing degree order) of the polynomial of degree < n
+// whose evaluations on the points of the domain are a.
+//
+// With P = P₀ + X^(n/2)⋅P₁, the evaluations of P₀ = P mod X^(n/2) on the points of the sub-domain are computed
+// by a Montgomery reduction (see redc), which gives the ones of P₁, and both are interpolated recursively.
+func (d *Domain) Interpolate(a []fr.Element) []fr.Element {
+	d.checkLen(a)
+	n := len(a)
+	if n == 1 {
+		return []fr.Element{a[0]}
+	}
+	h := n / 2
+
+	// P₀ = REDC(REDC(P)⋅(Z² mod X^h))
+	r := d.redc(a)
+	for j := range r {
+		r[j].Mul(&r[j], &d.redcSquare[j])
+	}
+	r = d.redc(r)
+
+	p0 := make([]fr.Element, h)
+	p1 := make([]fr.Element, h)
+	for j := 0; j < h; j++ {
+		p0[j] = r[2*j]
+		p1[j].Sub(&a[2*j], &p0[j]).Mul(&p1[j], &d.xPowInv[j])
+	}
+	return append(d.sub.Interpolate(p0), d.sub.Interpolate(p1)...)
+}
+
+// redc returns the evaluations on the points of R = P⋅Z⁻¹ mod X^(n/2), of degree < n/2, from the ones of P,
+// of degree < n, where Z is the vanishing polynomial of the points of the sub-domain.
+//
+// Let A = -P⋅X^(-n/2) mod Z, of degree < n/2, known on the points of the sub-domain (where it's -P/X^(n/2)) and
+// extended to its extension points. Then R = (P + A⋅X^(n/2))/Z, which gives R on the extension points of the
+// sub-domain, and R is extended back to its points.
+func (d *Domain) redc(a []fr.Element) []fr.Element {
+	h := len(a) / 2
+	r := make([]fr.Element, h)
+	for j := 0; j < h; j++ {
+		r[j].Mul(&a[2*j], &d.xPowInv[j]).Neg(&r[j])
+	}
+	r = d.sub.extend(r, 0, 0)
+	for j := 0; j < h; j++ {
+		r[j].Mul(&r[j], &d.xPow[2*j+1]).Add(&r[j], &a[2*j+1]).Mul(&r[j], &d.vanishingInv[j])
+	}
+	r0 := d.sub.extend(r, 0, 1)
+
+	res := make([]fr.Element, len(a))
+	for j := 0; j < h; j++ {
+		res[2*j] = r0[j]
+		res[2*j+1] = r[j]
+	}
+	return res
+}
+
+// mul returns the coefficients of p⋅q, where len(p) + len(q) ⩽ n
+func (d *Domain) mul(p, q []fr.Element) []fr.Element {
+	ep := d.Evaluate(p)
+	eq := d.Evaluate(q)
+	for j := range ep {
+		ep[j].Mul(&ep[j], &eq[j])
+	}
+	return d.Interpolate(ep)
+}
+
+func (d *Domain) checkLen(a []fr.Element) {
+	if uint64(len(a)) != d.Cardinality {
+		panic(fmt.Sprintf("the number of evaluations (%d) must be the cardinality of the domain (%d)", len(a), d.Cardinality))
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecdsa
+
+import (
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+)
+
+// TestStdlibInterop checks that signatures are interoperable with crypto/ecdsa on P-256.
+func TestStdlibInterop(t *testing.T) {
+	msg := []byte("testing ECDSA interoperability with crypto/ecdsa")
+	digest := sha256.Sum256(msg)
+
+	// gnark-crypto signs, crypto/ecdsa verifies
+	privKey, err := GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigBin, err := privKey.Sign(msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+	stdPub := stdecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     privKey.PublicKey.A.X.BigInt(new(big.Int)),
+		Y:     privKey.PublicKey.A.Y.BigInt(new(big.Int)),
+	}
+	r, s := new(big.Int).SetBytes(sig.R[:]), new(big.Int).SetBytes(sig.S[:])
+	if !stdecdsa.Verify(&stdPub, digest[:], r, s) {
+		t.Fatal("crypto/ecdsa rejected a valid signature")
+	}
+
+	// crypto/ecdsa signs, gnark-crypto verifies
+	stdPriv, err := stdecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, s, err = stdecdsa.Sign(rand.Reader, stdPriv, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.FillBytes(sig.R[:])
+	s.FillBytes(sig.S[:])
+	var pub PublicKey
+	pub.A.X.SetBigInt(stdPriv.X)
+	pub.A.Y.SetBigInt(stdPriv.Y)
+	ok, err := pub.Verify(sig.Bytes(), msg, sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok {
+		t.Fatal("valid crypto/ecdsa signature rejected")
+	}
+}
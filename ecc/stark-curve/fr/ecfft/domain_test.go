@@ -0,0 +1,167 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+)
+
+func TestIsogenyChain(t *testing.T) {
+	var a, b, x, y, rx, ry fr.Element
+	setString(&a, curveA)
+	setString(&b, curveB)
+	setString(&x, generatorX)
+	setString(&y, generatorY)
+	setString(&rx, shiftX)
+	setString(&ry, shiftY)
+
+	isOnCurve := func(x, y *fr.Element) bool {
+		var lhs, rhs fr.Element
+		lhs.Square(y)
+		rhs.Square(x).Add(&rhs, &a).Mul(&rhs, x).Add(&rhs, &b)
+		return lhs.Equal(&rhs)
+	}
+	if !isOnCurve(&x, &y) || !isOnCurve(&rx, &ry) {
+		t.Fatal("G and R should be on the curve")
+	}
+
+	// G has order 2^maxLogSize: 2^(maxLogSize-1)⋅G has order 2
+	for i := 0; i < maxLogSize-1; i++ {
+		if y.IsZero() {
+			t.Fatal("G should have order 2^maxLogSize")
+		}
+		doubleAffine(&x, &y, &a)
+	}
+	if !y.IsZero() {
+		t.Fatal("G should have order 2^maxLogSize")
+	}
+
+	// the x-coordinates of R + ⟨G⟩ are distinct and non-zero
+	c := getChain()
+	seen := make(map[fr.Element]bool, len(c.l[0]))
+	for _, x := range c.l[0] {
+		if x.IsZero() || seen[x] {
+			t.Fatal("the x-coordinates of R + ⟨G⟩ should be distinct and non-zero")
+		}
+		seen[x] = true
+	}
+
+	// ψᵢ maps Lᵢ[j] and Lᵢ[j + |Lᵢ|/2] to Lᵢ₊₁[j]
+	for i := 0; i < maxLogSize-1; i++ {
+		m := len(c.l[i]) / 2
+		for _, j := range []int{0, 1, m - 1} {
+			images := c.psi(i, []fr.Element{c.l[i][j], c.l[i][j+m]})
+			if !images[0].Equal(&c.l[i+1][j]) || !images[1].Equal(&c.l[i+1][j]) {
+				t.Fatal("ψ should map Lᵢ to Lᵢ₊₁")
+			}
+		}
+	}
+}
+
+func TestDomain(t *testing.T) {
+	for _, n := range []int{1, 2, 4, 8, 64, 256} {
+		t.Run(strconv.Itoa(n), func(t *testing.T) {
+			d := NewDomain(uint64(n))
+			p := make([]fr.Element, n)
+			for i := range p {
+				p[i].SetRandom()
+			}
+			evals := evaluatePolynomial(p, d.Points())
+
+			if !equal(d.Evaluate(p), evals) {
+				t.Fatal("Evaluate should match the evaluations of the polynomial")
+			}
+			if !equal(d.Interpolate(evals), p) {
+				t.Fatal("Interpolate should match the coefficients of the polynomial")
+			}
+			if !equal(d.Extend(evals), evaluatePolynomial(p, d.ExtensionPoints())) {
+				t.Fatal("Extend should match the evaluations of the polynomial on the extension points")
+			}
+			if 2*n < 1<<maxLogSize {
+				d2 := NewDomain(uint64(2 * n))
+				if !equal(d.LowDegreeExtension(evals), evaluatePolynomial(p, d2.Points())) {
+					t.Fatal("LowDegreeExtension should match the evaluations of the polynomial on the points of the larger domain")
+				}
+			}
+
+			// a polynomial of lower degree
+			q := p[:n/2+1]
+			if !equal(d.Evaluate(q), evaluatePolynomial(q, d.Points())) {
+				t.Fatal("Evaluate should accept polynomials of lower degree")
+			}
+		})
+	}
+}
+
+func evaluatePolynomial(p []fr.Element, points []fr.Element) []fr.Element {
+	res := make([]fr.Element, len(points))
+	for i := range points {
+		for j := len(p) - 1; j >= 0; j-- {
+			res[i].Mul(&res[i], &points[i]).Add(&res[i], &p[j])
+		}
+	}
+	return res
+}
+
+func equal(a, b []fr.Element) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(&b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkDomain(b *testing.B) {
+	const n = 1 << 12
+	p := make([]fr.Element, n)
+	for i := range p {
+		p[i].SetRandom()
+	}
+	d := NewDomain(n)
+	evals := d.Evaluate(p)
+
+	b.Run("Extend", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.Extend(evals)
+		}
+	})
+	b.Run("Evaluate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.Evaluate(p)
+		}
+	})
+	b.Run("Interpolate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			d.Interpolate(evals)
+		}
+	})
+	b.Run("NewDomain", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewDomain(n)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package vesta
+
+// Test vectors printed by the Zcash pasta_curves crate (github.com/zcash/pasta_curves at 24c71faa6f19): u0, u1 from
+// hashtocurve::hash_to_field, Q0, Q1 from iso_map(map_to_curve_simple_swu(uᵢ)) and P from
+// vesta::Point::hash_to_curve("z.cash:test"), the domain prefix of the crate's tests.
+// The crate has no encode_to_curve, so EncodeToG1 has no reference vectors.
+func init() {
+	encodeToG1Vector = encodeTestVector{
+		dst: []byte("z.cash:test-vesta_XMD:BLAKE2b_SSWU_NU_"),
+	}
+	hashToG1Vector = hashTestVector{
+		dst: []byte("z.cash:test-vesta_XMD:BLAKE2b_SSWU_RO_"),
+		cases: []hashTestCase{
+			{
+				msg: "", P: point{"0x125c4dd7127efebc7dfaeafdedf0dae160543c2f5d7b0529cbe15a7a05749c2", "0x296c814d449475efe14c9482465c963cdce2b45465f06068d6d6597904df4817"},
+				Q0: point{"0x7a6077c920ec651e12fdaf84e419cc1228e88e997e3ae88242900a2ce48301e", "0x6216b410683382f620d5bd24a5dd4bdc7e890a353afd3fd10f12f88e92ceab6"},
+				Q1: point{"0x39241811dbde53b64b07c359826a499028b7a146653bc28aff9dc51c54f7e8de", "0x1f9e56b69e6d3b5d646fcb0033371239fdbd88a79aefb44e6b75a92e1cdf243d"},
+				u0: "0x4f6a727fd598352af3e54a0bf238a25df59f5ee0bd07c2fa146a790dfa095a9", u1: "0x35eb5628b0c1b7e74e0dfa2e74b489806c22b5548e39964a130a37cfee140a4c",
+			}, {
+				msg: "abc", P: point{"0x119937fec645cbe784db7b81aa7886abcd5da638d9348228ecb5c2526a47863d", "0x311ef415188f700f3b63c7fc2ee321320d0f5fa251fe9ce945a801ee527f4d9c"},
+				Q0: point{"0x386ce4272fd9131e15c927159016f3a8207ad1b8a9a4ee92c79e51de70a90a09", "0x11dce798fb04710c1fa4c6db2afb6953a39205f5688c90a1f211acd815806af4"},
+				Q1: point{"0x26da02935602b31f9c1b6f30fe344956e95f9cbba075e4627d6ee61ba4c13f8e", "0x39e4c8abe89f652fbb78cd893c5d12ef10af35783ed13d13849702d485d81ae1"},
+				u0: "0x49eb3a1697fa905f03acc7f3d8baf8afbbe5352f400de9f5a103473aff33a42", u1: "0xd6db3917d387185ac4b074f782ea9ce7b30f2c6c6785972ff3a474a257554ea",
+			}, {
+				msg: "abcdef0123456789", P: point{"0x3d33685a31e146ef33f8667f17e8f238ff545b260d665d871aabd7b5674ce51", "0x2d0a926bd3b2bdaa6846637f236d8c79bbe479dd747da5bc707c339e42a8078d"},
+				Q0: point{"0x24ecc9ea57b7134fb6c45c902e6ff4125b5e1d70dacad74d0d22578c9c8eae2b", "0x168e77446cb9a9762e7f3ca0c6b8bf9e6bad3560ac50984df249cc0c0bcfbbf3"},
+				Q1: point{"0x25e0f07977276c65d2beee068633d6fa9b587a01f13509445f9dbaf19ad9f714", "0x2e739e63c2bf8fcac9e4488e38c748dda9679db9f68e661ff8f27b713aebebf9"},
+				u0: "0x26450ed8e341df866afe0b1d946329d34e5194614533f8f957c2e5bbb7ac96b9", u1: "0x1b63dab67810af7a6a5437b9497afcb20a680dec129cb7508c2533876a22d500",
+			}, {
+				msg: "q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq", P: point{"0x1e8d6c9e0126f22673da7345efb04a19a46864fbf4c9cf264796f2a14bc3267e", "0x157a1e97f7591402f9b6c6afa479471c1150066d890f794298587ec09693ddc7"},
+				Q0: point{"0x1805e8138fb4c260ebce4c904f33945904966604e182def364666d1c2e59034c", "0x317e25d28aed3d842eb5e92b35fc76d57ccf2140c2c4ea6ef1703cd394fcbdff"},
+				Q1: point{"0x3c9db016bda60e9197e9a97130faa3ca816247162959800e647793b512916f68", "0x3d92f65330d44d3512904b00ab88930014a951d4ef54b981ea94059e07a49a51"},
+				u0: "0x2d89b3f3b46d4d5de6a1b749fac6d5e5714ecf3cc7a0b0dd64950414885dbf14", u1: "0x1025e35491c91bf2c3cde1b6acad4f9fc039ac9c91a344ce9e75428fc8903c7d",
+			}, {
+				msg: "a512_aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", P: point{"0x18da33599ad3a75de476dd4a052a6c02f5910ba18638ecb278785adf4d7db851", "0x2373cc5da233ddd2a6a984b5aa383da924b413e8f6c0ac8f437b47442dc31a15"},
+				Q0: point{"0x2531ed6fcd106ba7d0d8eb93b9c09951a441c7f0db3618a8b439498c51a4e439", "0x5d6af04eb0e50be8d40d7e7b0888f33d5e525b0937c29bed7395e8740ec535c"},
+				Q1: point{"0x15ba41f2f2b56edf7a10f08e0e44800edb53d3c511ad7a46723a041d21b0b657", "0x29482829045e699ea0475c3169140ccb914052de74fbfce75e3ae3a58d7ec6a6"},
+				u0: "0x3d9ad975a016b5cfa7bde336527667c4fb2a12970ad479930f844234764f07ab", u1: "0xd574ac91f2e10f9c72f6b13c2cee328a41ab07051d302a22b1cfad05e0fddbf",
+			},
+		}}
+}
@@ -0,0 +1,138 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vesta
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	fp "github.com/consensys/gnark-crypto/ecc/pallas/fr"
+)
+
+func TestG1AffineSerialization(t *testing.T) {
+	t.Parallel()
+	// test round trip serialization of infinity
+	{
+		var p1, p2 G1Affine
+		p2.X.SetRandom()
+		p2.Y.SetRandom()
+		buf := p1.Bytes()
+		if buf != [SizeOfG1AffineCompressed]byte{} {
+			t.Fatal("infinity point should be encoded as zeroes")
+		}
+		n, err := p2.SetBytes(buf[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != SizeOfG1AffineCompressed {
+			t.Fatal("invalid number of bytes consumed in buffer")
+		}
+		if !(p2.X.IsZero() && p2.Y.IsZero()) {
+			t.Fatal("deserialization of compressed infinity point is not infinity")
+		}
+	}
+
+	// (0, y odd) is not a valid encoding
+	{
+		var p G1Affine
+		var buf [SizeOfG1AffineCompressed]byte
+		buf[SizeOfG1AffineCompressed-1] = mYOdd
+		if _, err := p.SetBytes(buf[:]); err == nil {
+			t.Fatal("expected an error for x=0 with y odd")
+		}
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = nbFuzzShort
+	} else {
+		parameters.MinSuccessfulTests = nbFuzz
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[G1] Affine SetBytes(Bytes) should stay the same", prop.ForAll(
+		func(a fp.Element) bool {
+			var start, end G1Affine
+			var ab big.Int
+			a.BigInt(&ab)
+			start.ScalarMultiplication(&g1GenAff, &ab)
+
+			buf := start.Bytes()
+			n, err := end.SetBytes(buf[:])
+			if err != nil {
+				return false
+			}
+			if n != SizeOfG1AffineCompressed {
+				return false
+			}
+			return start.X.Equal(&end.X) && start.Y.Equal(&end.Y)
+		},
+		GenFp(),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// TestG1AffineSerializationZcash checks the encoding against the one of the pasta_curves crate
+// (GroupEncoding::to_bytes): x in little endian, the parity of y in the most significant bit,
+// and the identity as zeroes. The expected bytes were printed by the crate (github.com/zcash/pasta_curves
+// at 24c71faa6f19) for the same multiples of its generator (-1, 2).
+func TestG1AffineSerializationZcash(t *testing.T) {
+	t.Parallel()
+	var infinity, g2, gNeg, g3, g3Neg, gk G1Affine
+	g2.Double(&g1GenAff)
+	gNeg.Neg(&g1GenAff)
+	g3.ScalarMultiplication(&g1GenAff, big.NewInt(3))
+	g3Neg.Neg(&g3)
+	k, _ := new(big.Int).SetString("12345678901234567890", 10)
+	gk.ScalarMultiplication(&g1GenAff, k)
+
+	for _, tc := range []struct {
+		p   *G1Affine
+		hex string
+	}{
+		{&g1GenAff, "0000000021eb468cdda89409fc98462200000000000000000000000000000040"},
+		{&g2, "03000070de065fede0093144eee2fe0e0000000000000000000000000000001c"},
+		{&gNeg, "0000000021eb468cdda89409fc984622000000000000000000000000000000c0"},
+		{&g3, "5fce556feb6fee5a15560ddabae10224b026a5d0281af4c613955c39a8797837"},
+		{&g3Neg, "5fce556feb6fee5a15560ddabae10224b026a5d0281af4c613955c39a87978b7"},
+		{&gk, "c57baa8d5cc78c89cf39ea42c40933906ef5c6531c2ebbb5463d506fa8c955b2"},
+		{&infinity, "0000000000000000000000000000000000000000000000000000000000000000"},
+	} {
+		buf := tc.p.Bytes()
+		if hex.EncodeToString(buf[:]) != tc.hex {
+			t.Fatalf("unexpected encoding %x", buf)
+		}
+		var q G1Affine
+		if err := q.Unmarshal(buf[:]); err != nil {
+			t.Fatal(err)
+		}
+		if !q.Equal(tc.p) {
+			t.Fatal("decoded point doesn't match")
+		}
+	}
+
+	// x ≥ p is rejected
+	buf, _ := hex.DecodeString("0100000021eb468cdda89409fc98462200000000000000000000000000000040")
+	var q G1Affine
+	if err := q.Unmarshal(buf); err == nil {
+		t.Fatal("expected an error for a non canonical x coordinate")
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package extensions contains arithmetic operations in extensions of babybear.
+//
+// The extensions are simple radical extensions of the base field 𝔽p, p = 2013265921:
+//
+//	E2 = 𝔽p[u]/(u² - 11)
+//
+// Elements are represented by their coordinates in the basis (1, u, u², ...) and
+// all the base field coordinates are in Montgomery form.
+//
+// Higher degree extensions are built as quadratic extensions of the former:
+//
+//	E4 = E2[v]/(v² - u)
+//
+// and are represented by their coordinates B0 + B1⋅v.
+//
+// # Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package extensions
@@ -0,0 +1,407 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE2ReceiverIsOperand(t *testing.T) {
+	var a, b, c, d E2
+	a.SetRandom()
+	b.SetRandom()
+
+	c.Mul(&a, &b)
+	d.Set(&a)
+	d.Mul(&d, &b)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as first operand failed")
+	}
+	d.Set(&b)
+	d.Mul(&a, &d)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as second operand failed")
+	}
+
+	c.Square(&a)
+	d.Set(&a)
+	d.Square(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Square: receiver as operand failed")
+	}
+
+	c.Inverse(&a)
+	d.Set(&a)
+	d.Inverse(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Inverse: receiver as operand failed")
+	}
+
+	c.Frobenius(&a)
+	d.Set(&a)
+	d.Frobenius(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Frobenius: receiver as operand failed")
+	}
+}
+
+func TestE2Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE2()
+	genB := genE2()
+	genE := genBaseElement()
+
+	properties.Property("[E2] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c E2
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] mul should match the schoolbook product modulo u² - 11", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Mul(a, b)
+			schoolbookMulE2(&d, a, b)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] Div should be the same as mul by inverse", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Div(a, b)
+			d.Inverse(b).Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] BatchInvertE2 should output the same result as Inverse", prop.ForAll(
+		func(a, b *E2) bool {
+			var c, d E2
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvertE2([]E2{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E2] square and mul should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Double and add twice should output the same result", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Add(a, a)
+			c.Double(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Halve should be the inverse of Double", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Double(a)
+			b.Halve()
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Neg should be the additive inverse", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Neg(a).Add(&b, a)
+			return b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E2] MulByElement MulByElement inverse should leave an element invariant", prop.ForAll(
+		func(a *E2, b babybear.Element) bool {
+			var c E2
+			var d babybear.Element
+			d.Inverse(&b)
+			c.MulByElement(a, &b).MulByElement(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[E2] MulByNonResidue should be the same as multiplying by u", prop.ForAll(
+		func(a *E2) bool {
+			var b, c, u E2
+			u.A1.SetOne()
+			b.Mul(a, &u)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Frobenius should be the same as Exp(p)", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Frobenius(a)
+			c.Exp(*a, babybear.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Frobenius applied 2 times should be the identity", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Set(a)
+			for i := 0; i < 2; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Conjugate should be the same as Frobenius", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Conjugate(a)
+			c.Frobenius(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Exp with a negative exponent should be the inverse", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Legendre on square should output 1", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Legendre should match Euler's criterion", prop.ForAll(
+		func(a *E2) bool {
+			var b, minusOne E2
+			e := new(big.Int).Exp(babybear.Modulus(), big.NewInt(2), nil)
+			e.Sub(e, big.NewInt(1)).Rsh(e, 1)
+			b.Exp(*a, e)
+			minusOne.SetOne().Neg(&minusOne)
+			switch a.Legendre() {
+			case 1:
+				return b.IsOne()
+			case -1:
+				return b.Equal(&minusOne)
+			}
+			return false
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Sqrt of a square should be a square root", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E2
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil
+		},
+		genA,
+	))
+
+	properties.Property("[E2] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return b.Equal(a) && b.SetBytes(buf[1:]) != nil
+		},
+		genA,
+	))
+
+	properties.Property("[E2] Cmp should be consistent with LexicographicallyLargest", prop.ForAll(
+		func(a *E2) bool {
+			var b E2
+			b.Neg(a)
+			if a.IsZero() {
+				return !a.LexicographicallyLargest() && a.Cmp(&b) == 0
+			}
+			return a.LexicographicallyLargest() == (a.Cmp(&b) == 1)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE2SetBytesNonCanonical(t *testing.T) {
+	var a E2
+	buf := make([]byte, SizeOfE2)
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	if err := a.SetBytes(buf); err == nil {
+		t.Fatal("SetBytes should reject non canonical coordinates")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE2Add(b *testing.B) {
+	var a, c E2
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Add(&a, &c)
+	}
+}
+
+func BenchmarkE2Mul(b *testing.B) {
+	var a, c E2
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE2Square(b *testing.B) {
+	var a E2
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE2Inverse(b *testing.B) {
+	var a E2
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE2Sqrt(b *testing.B) {
+	var a E2
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+
+// schoolbookMulE2 computes x*y as polynomials in u and reduces with u² = 11
+func schoolbookMulE2(z, x, y *E2) {
+	a := [...]babybear.Element{x.A0, x.A1}
+	b := [...]babybear.Element{y.A0, y.A1}
+	var c [2*2 - 1]babybear.Element
+	for i := range a {
+		for j := range b {
+			var t babybear.Element
+			t.Mul(&a[i], &b[j])
+			c[i+j].Add(&c[i+j], &t)
+		}
+	}
+	var alpha babybear.Element
+	alpha.SetInt64(11)
+	for i := len(c) - 1; i >= 2; i-- {
+		var t babybear.Element
+		t.Mul(&c[i], &alpha)
+		c[i-2].Add(&c[i-2], &t)
+	}
+	z.A0 = c[0]
+	z.A1 = c[1]
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x78000001"
+	babybear, err := config.NewFieldConfig("babybear", "Element", modulus, true)
+	if err != nil {
+		panic(err)
+	}
+	babybear.ParallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
+	if err := generator.GenerateFF(babybear, "../"); err != nil {
+		panic(err)
+	}
+
+	// 11 is not a square: u²-11 is irreducible and u is not a square in E2, so that v⁴ = 11
+	e2 := config.NewTower(babybear, 2, 11)
+	e4 := config.NewQuadraticExtension(&e2, 0, 1)
+	if err := generator.GenerateExtensions("github.com/consensys/gnark-crypto/field/babybear", "../extensions", []config.Extension{e2}, e4); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated babybear field")
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !purego
+// +build !purego
+
+package binary
+
+import "golang.org/x/sys/cpu"
+
+// With PCLMULQDQ, GF(2⁶⁴) and GF(2¹²⁸) are multiplied in the isomorphic fields
+// GF(2)[x]/(x⁶⁴ + x⁴ + x³ + x + 1) and GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1), with carry-less multiplications.
+//
+// The change of basis φ from the tower is GF(2)-linear: it maps the coordinate of X₀^b₀⋅X₁^b₁⋯ to the
+// product of the images of the Xₖ^bₖ, roots of X₀² + X₀ + 1 and Xₖ² + Xₖ₋₁⋅Xₖ + 1 in the polynomial field.
+// Its inverse maps xⁱ to αⁱ, α = φ⁻¹(x) being a root of the modulus in the tower.
+// Both are evaluated with tables indexed by the bytes of their input.
+var supportPCLMULQDQ = cpu.X86.HasPCLMULQDQ
+
+var (
+	// images of X₀, …, X₅ in GF(2)[x]/(x⁶⁴ + x⁴ + x³ + x + 1), and the preimage of x
+	towerBasis64 = [6]uint64{
+		0x19c9369f278adc02, 0x447175c8e9f2810b, 0xbb1de2bff7c7b11a,
+		0x4e2af8c372ee501c, 0x559677255bac2893, 0x91f6dc67d15d2b07,
+	}
+	polyRoot64 = E64(0x8a7edf340b75fa32)
+
+	// images of X₀, …, X₆ in GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1) (low word first), and the preimage of x
+	towerBasis128 = [7][2]uint64{
+		{0x676aac9fa4b20b08, 0x295ac0b1f4731af9},
+		{0x34d2f7fba603e341, 0x500317bd159d73bb},
+		{0x77eda49fad5950db, 0xd7272761ca8e2877},
+		{0x77be5724c64eb1d2, 0xf7ee5366ad8dcdf0},
+		{0x1428b68814f535be, 0x218cc1384d3fe56b},
+		{0x994e36d0d1df3b3d, 0x2c2fcdaa2679817f},
+		{0xb7b73094152f7631, 0xe4f21e8c8ec8031c},
+	}
+	polyRoot128 = E128{0x526b13e1fedaef3d, 0xbfe0a17ee2b77754}
+)
+
+// toPoly and fromPoly tables: table[j][v] is the image of v⋅2⁸ʲ
+var (
+	toPoly64, fromPoly64   [8][256]uint64
+	toPoly128, fromPoly128 [16][256][2]uint64
+)
+
+// initPolyTables is called by init once the tables of GF(2⁸) are set
+func initPolyTables() {
+	if !supportPCLMULQDQ {
+		return
+	}
+
+	var to64, from64 [64]uint64
+	from := E64(1)
+	for i := range to64 {
+		to64[i] = 1
+		for k := range towerBasis64 {
+			if i>>k&1 == 1 {
+				to64[i] = mulPoly64(to64[i], towerBasis64[k])
+			}
+		}
+		from64[i] = uint64(from)
+		from = mul64(from, polyRoot64)
+	}
+	for j := range toPoly64 {
+		for v := range toPoly64[j] {
+			for b := 0; b < 8; b++ {
+				if v>>b&1 == 1 {
+					toPoly64[j][v] ^= to64[8*j+b]
+					fromPoly64[j][v] ^= from64[8*j+b]
+				}
+			}
+		}
+	}
+
+	var to128, from128 [128][2]uint64
+	from2 := E128{1, 0}
+	for i := range to128 {
+		to128[i] = [2]uint64{1, 0}
+		for k := range towerBasis128 {
+			if i>>k&1 == 1 {
+				to128[i] = mulPoly128(&to128[i], &towerBasis128[k])
+			}
+		}
+		from128[i] = from2
+		from2 = mul128(from2, polyRoot128)
+	}
+	for j := range toPoly128 {
+		for v := range toPoly128[j] {
+			for b := 0; b < 8; b++ {
+				if v>>b&1 == 1 {
+					toPoly128[j][v][0] ^= to128[8*j+b][0]
+					toPoly128[j][v][1] ^= to128[8*j+b][1]
+					fromPoly128[j][v][0] ^= from128[8*j+b][0]
+					fromPoly128[j][v][1] ^= from128[8*j+b][1]
+				}
+			}
+		}
+	}
+}
+
+//go:noescape
+func clmul64(x, y uint64) (lo, hi uint64)
+
+//go:noescape
+func clmul128(z *[4]uint64, x, y *[2]uint64)
+
+// mulPoly64 returns x⋅y in GF(2)[x]/(x⁶⁴ + x⁴ + x³ + x + 1)
+func mulPoly64(x, y uint64) uint64 {
+	lo, hi := clmul64(x, y)
+
+	// x⁶⁴ = x⁴ + x³ + x + 1, the bits of hi shifted out are reduced again
+	over := hi>>63 ^ hi>>61 ^ hi>>60
+	return lo ^ hi ^ hi<<1 ^ hi<<3 ^ hi<<4 ^ over ^ over<<1 ^ over<<3 ^ over<<4
+}
+
+// mulPoly128 returns x⋅y in GF(2)[x]/(x¹²⁸ + x⁷ + x² + x + 1)
+func mulPoly128(x, y *[2]uint64) [2]uint64 {
+	var z [4]uint64
+	clmul128(&z, x, y)
+
+	// x¹²⁸ = x⁷ + x² + x + 1, the bits of z[3] shifted out are reduced again
+	over := z[3]>>63 ^ z[3]>>62 ^ z[3]>>57
+	return [2]uint64{
+		z[0] ^ z[2] ^ z[2]<<1 ^ z[2]<<2 ^ z[2]<<7 ^ over ^ over<<1 ^ over<<2 ^ over<<7,
+		z[1] ^ z[3] ^ (z[3]<<1 | z[2]>>63) ^ (z[3]<<2 | z[2]>>62) ^ (z[3]<<7 | z[2]>>57),
+	}
+}
+
+func convert64(table *[8][256]uint64, x uint64) uint64 {
+	var res uint64
+	for j := range table {
+		res ^= table[j][uint8(x>>(8*j))]
+	}
+	return res
+}
+
+func convert128(table *[16][256][2]uint64, x [2]uint64) [2]uint64 {
+	var res [2]uint64
+	for j := 0; j < 8; j++ {
+		lo, hi := &table[j][uint8(x[0]>>(8*j))], &table[8+j][uint8(x[1]>>(8*j))]
+		res[0] ^= lo[0] ^ hi[0]
+		res[1] ^= lo[1] ^ hi[1]
+	}
+	return res
+}
+
+func mulElement64(x, y E64) E64 {
+	if !supportPCLMULQDQ {
+		return mul64(x, y)
+	}
+	z := mulPoly64(convert64(&toPoly64, uint64(x)), convert64(&toPoly64, uint64(y)))
+	return E64(convert64(&fromPoly64, z))
+}
+
+func mulElement128(x, y E128) E128 {
+	if !supportPCLMULQDQ {
+		return mul128(x, y)
+	}
+	a, b := convert128(&toPoly128, x), convert128(&toPoly128, y)
+	return convert128(&fromPoly128, mulPoly128(&a, &b))
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !purego
+// +build !purego
+
+#include "textflag.h"
+
+// func clmul64(x, y uint64) (lo, hi uint64)
+TEXT ·clmul64(SB), NOSPLIT, $0-32
+	MOVQ      x+0(FP), X0
+	MOVQ      y+8(FP), X1
+	PCLMULQDQ $0x00, X1, X0
+	MOVQ      X0, lo+16(FP)
+	PSRLDQ    $8, X0
+	MOVQ      X0, hi+24(FP)
+	RET
+
+// func clmul128(z *[4]uint64, x, y *[2]uint64)
+TEXT ·clmul128(SB), NOSPLIT, $0-24
+	MOVQ  z+0(FP), CX
+	MOVQ  x+8(FP), AX
+	MOVQ  y+16(FP), BX
+	MOVOU (AX), X0
+	MOVOU (BX), X1
+
+	// x₀⋅y₀, x₁⋅y₁ and x₀⋅y₁ + x₁⋅y₀
+	MOVOU     X0, X2
+	PCLMULQDQ $0x00, X1, X2
+	MOVOU     X0, X3
+	PCLMULQDQ $0x11, X1, X3
+	MOVOU     X0, X4
+	PCLMULQDQ $0x01, X1, X4
+	PCLMULQDQ $0x10, X1, X0
+	PXOR      X4, X0
+
+	// add the middle term, shifted by 64 bits
+	MOVOU  X0, X4
+	PSLLDQ $8, X4
+	PSRLDQ $8, X0
+	PXOR   X4, X2
+	PXOR   X0, X3
+
+	MOVOU X2, (CX)
+	MOVOU X3, 16(CX)
+	RET
@@ -0,0 +1,76 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !purego
+// +build !purego
+
+package binary
+
+import "testing"
+
+func TestPolyBasis(t *testing.T) {
+	if !supportPCLMULQDQ {
+		t.Skip("PCLMULQDQ is not supported")
+	}
+
+	// the images of the Xₖ satisfy the relations of the tower
+	if mulPoly64(towerBasis64[0], towerBasis64[0])^towerBasis64[0] != 1 {
+		t.Fatal("wrong relation for X₀ in GF(2⁶⁴)")
+	}
+	for k := 1; k < len(towerBasis64); k++ {
+		x := mulPoly64(towerBasis64[k], towerBasis64[k]^towerBasis64[k-1])
+		if x != 1 {
+			t.Fatalf("wrong relation for X%d in GF(2⁶⁴)", k)
+		}
+	}
+	x := mulPoly128(&towerBasis128[0], &towerBasis128[0])
+	if x[0]^towerBasis128[0][0] != 1 || x[1] != towerBasis128[0][1] {
+		t.Fatal("wrong relation for X₀ in GF(2¹²⁸)")
+	}
+	for k := 1; k < len(towerBasis128); k++ {
+		s := [2]uint64{towerBasis128[k][0] ^ towerBasis128[k-1][0], towerBasis128[k][1] ^ towerBasis128[k-1][1]}
+		if x = mulPoly128(&towerBasis128[k], &s); x != [2]uint64{1, 0} {
+			t.Fatalf("wrong relation for X%d in GF(2¹²⁸)", k)
+		}
+	}
+
+	// the change of basis and its inverse
+	for i := 0; i < 200; i++ {
+		x, y := random[E64](), random[E128]()
+		if E64(convert64(&fromPoly64, convert64(&toPoly64, uint64(x)))) != x {
+			t.Fatal("wrong change of basis in GF(2⁶⁴)")
+		}
+		if convert128(&fromPoly128, convert128(&toPoly128, y)) != y {
+			t.Fatal("wrong change of basis in GF(2¹²⁸)")
+		}
+	}
+}
+
+func TestMulPCLMULQDQ(t *testing.T) {
+	if !supportPCLMULQDQ {
+		t.Skip("PCLMULQDQ is not supported")
+	}
+
+	// the multiplications with PCLMULQDQ match the pure Go ones
+	for i := 0; i < 1000; i++ {
+		x, y := random[E64](), random[E64]()
+		if mulElement64(x, y) != mul64(x, y) {
+			t.Fatal("wrong multiplication in GF(2⁶⁴)")
+		}
+		a, b := random[E128](), random[E128]()
+		if mulElement128(a, b) != mul128(a, b) {
+			t.Fatal("wrong multiplication in GF(2¹²⁸)")
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package binary provides the binary tower fields GF(2⁸), GF(2¹⁶), GF(2³²), GF(2⁶⁴) and GF(2¹²⁸)
+// used by Binius-style proof systems, with packed vectors, the additive NTT of Lin, Chung and Han,
+// and multilinear polynomials.
+//
+// The fields form Wiedemann's tower: GF(2²) = GF(2)[X₀]/(X₀² + X₀ + 1) and
+// GF(2^(2ᵏ⁺¹)) = GF(2^(2ᵏ))[Xₖ]/(Xₖ² + Xₖ₋₁⋅Xₖ + 1). An element of GF(2^(2ᵏ⁺¹)) is stored as
+// a + b⋅Xₖ, a and b in GF(2^(2ᵏ)) being its low and high halves, so that the elements of a subfield
+// are the elements of the larger fields with zero high bits, and the addition is the exclusive or.
+//
+// The multiplication in GF(2⁸) uses logarithm tables, and the larger fields use Karatsuba's
+// algorithm on their halves. On amd64, GF(2⁶⁴) and GF(2¹²⁸) are multiplied with PCLMULQDQ when the
+// CPU supports it, in isomorphic polynomial fields; the purego build tag falls back to pure Go.
+package binary
@@ -0,0 +1,83 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+// MultiLin tracks the values of a (dense i.e. not sparse) multilinear polynomial, with the semantics of
+// polynomial.MultiLin: the variables are X₁ through Xₙ where n = log(len(.)) and
+// .[∑ᵢ 2ⁱ⁻¹ bₙ₋ᵢ] = the polynomial evaluated at (b₁, b₂, ..., bₙ).
+type MultiLin[T any, P Element[T]] []T
+
+// Fold is partial evaluation function k[X₁, X₂, ..., Xₙ] → k[X₂, ..., Xₙ] by setting X₁=r
+func (m *MultiLin[T, P]) Fold(r T) {
+	mid := len(*m) / 2
+	bottom, top := (*m)[:mid], (*m)[mid:]
+
+	// updating bookkeeping table
+	// in characteristic 2, bottom + r⋅(top - bottom) = bottom + r⋅(top + bottom)
+	for i := 0; i < mid; i++ {
+		var t T
+		P(&t).Add(&top[i], &bottom[i])
+		P(&t).Mul(&t, &r)
+		P(&bottom[i]).Add(&bottom[i], &t)
+	}
+
+	*m = (*m)[:mid]
+}
+
+// Evaluate extrapolates the value of the multilinear polynomial corresponding to m
+// on the given coordinates
+func (m MultiLin[T, P]) Evaluate(coordinates []T) T {
+	// Folding is a mutating operation
+	bkCopy := m.Clone()
+	for _, r := range coordinates {
+		bkCopy.Fold(r)
+	}
+	return bkCopy[0]
+}
+
+// Sum returns the sum of the values of m on the hypercube
+func (m MultiLin[T, P]) Sum() T {
+	var s T
+	for i := range m {
+		P(&s).Add(&s, &m[i])
+	}
+	return s
+}
+
+// Clone creates a deep copy of m
+func (m MultiLin[T, P]) Clone() MultiLin[T, P] {
+	res := make(MultiLin[T, P], len(m))
+	copy(res, m)
+	return res
+}
+
+// EqTable returns the values eq(b, coordinates) = ∏ᵢ (bᵢ⋅rᵢ + (1 - bᵢ)⋅(1 - rᵢ)) on the hypercube, with the
+// indexing of MultiLin, so that m(coordinates) = ∑_b m[b]⋅eq(b, coordinates).
+func EqTable[T any, P Element[T]](coordinates []T) MultiLin[T, P] {
+	res := make(MultiLin[T, P], 1, 1<<len(coordinates))
+	P(&res[0]).SetOne()
+	for _, r := range coordinates {
+		// each entry e splits into e⋅(1 - r) for Xᵢ = 0 and e⋅r for Xᵢ = 1, Xᵢ being the next bit
+		n := len(res)
+		res = res[:2*n]
+		for j := n - 1; j >= 0; j-- {
+			var hi T
+			P(&hi).Mul(&res[j], &r)
+			P(&res[2*j]).Add(&res[j], &hi)
+			res[2*j+1] = hi
+		}
+	}
+	return res
+}
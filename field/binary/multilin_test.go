@@ -0,0 +1,70 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+)
+
+func randomMultiLin(n int) MultiLin[E128, *E128] {
+	m := make(MultiLin[E128, *E128], 1<<n)
+	for i := range m {
+		m[i] = random[E128]()
+	}
+	return m
+}
+
+func TestMultiLinEvaluate(t *testing.T) {
+	const n = 5
+	m := randomMultiLin(n)
+
+	// on the hypercube, m[∑ᵢ 2ⁱ⁻¹ bₙ₋ᵢ] = m(b₁, ..., bₙ)
+	for b := range m {
+		coordinates := make([]E128, n)
+		for i := range coordinates {
+			coordinates[i].SetUint64(uint64(b >> (n - 1 - i) & 1))
+		}
+		if v := m.Evaluate(coordinates); !v.Equal(&m[b]) {
+			t.Fatal("wrong evaluation on the hypercube")
+		}
+	}
+
+	// m(r) = Σ m[b]⋅eq(b, r)
+	coordinates := make([]E128, n)
+	for i := range coordinates {
+		coordinates[i] = random[E128]()
+	}
+	eq := EqTable[E128](coordinates)
+	var expected E128
+	for b := range m {
+		var tmp E128
+		tmp.Mul(&m[b], &eq[b])
+		expected.Add(&expected, &tmp)
+	}
+	if v := m.Evaluate(coordinates); !v.Equal(&expected) {
+		t.Fatal("Evaluate and EqTable should agree")
+	}
+	if s := eq.Sum(); !s.IsOne() {
+		t.Fatal("the eq table should sum to 1")
+	}
+
+	// m(x) = (1 - x)⋅m(0) + x⋅m(1), for a single variable
+	line := MultiLin[E128, *E128]{random[E128](), random[E128]()}
+	x := random[E128]()
+	expected.Add(&line[0], &line[1]).Mul(&expected, &x).Add(&expected, &line[0])
+	if v := line.Evaluate([]E128{x}); !v.Equal(&expected) {
+		t.Fatal("wrong evaluation of a linear polynomial")
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import "math/bits"
+
+// NTT is the additive NTT of Lin, Chung and Han, on the affine subspaces of 2^logSize elements
+// c⋅2^logSize + U, U = {0, ..., 2^logSize - 1} being the GF(2)-span of the first logSize
+// elements βᵢ = 2ⁱ of the tower basis.
+//
+// It evaluates the polynomials in the novel polynomial basis Xⱼ = ∏ Ŵᵢ, the product being over the bits i
+// of j, where Ŵᵢ is the GF(2)-linear vanishing polynomial of span(β₀, ..., βᵢ₋₁), normalized so that
+// Ŵᵢ(βᵢ) = 1. Since Xⱼ₊₂ⁱ = Ŵᵢ⋅Xⱼ for j < 2ⁱ and Ŵᵢ is constant on the cosets of span(β₀, ..., βᵢ₋₁),
+// each layer of the transform is a butterfly (a, b) ↦ (a + t⋅b, a + t⋅b + b), in O(n⋅log(n)) operations.
+// See https://arxiv.org/abs/1404.3458.
+type NTT[T any, P Element[T]] struct {
+	logSize int
+
+	// w[i][m] = Ŵᵢ(βₘ), for i < logSize and m < 64
+	w [][]T
+
+	// twiddles[i][j] = Ŵᵢ(j⋅2ⁱ⁺¹), the twiddle of the j-th block of the layer i on U
+	twiddles [][]T
+}
+
+// NewNTT returns the additive NTT on the subspaces of 2^logSize elements.
+// It panics if the field has less than 2^logSize elements.
+func NewNTT[T any, P Element[T]](logSize int) *NTT[T, P] {
+	ntt := &NTT[T, P]{logSize: logSize}
+
+	// W₀(x) = x and Wᵢ₊₁(x) = Wᵢ(x)⋅Wᵢ(x + βᵢ) = Wᵢ(x)² + Wᵢ(βᵢ)⋅Wᵢ(x), Wᵢ being GF(2)-linear
+	w := make([]T, 64)
+	for m := range w {
+		P(&w[m]).SetUint64(1 << m)
+	}
+	ntt.w = make([][]T, logSize)
+	for i := 0; i < logSize; i++ {
+		if P(&w[i]).IsZero() {
+			panic("the field is too small for the NTT")
+		}
+		var inv T
+		P(&inv).Inverse(&w[i])
+		ntt.w[i] = make([]T, len(w))
+		for m := range w {
+			P(&ntt.w[i][m]).Mul(&w[m], &inv)
+		}
+
+		wi := w[i]
+		for m := range w {
+			var tmp T
+			P(&tmp).Mul(&wi, &w[m])
+			P(&w[m]).Square(&w[m])
+			P(&w[m]).Add(&w[m], &tmp)
+		}
+	}
+
+	ntt.twiddles = make([][]T, logSize)
+	for i := range ntt.twiddles {
+		t := make([]T, 1<<(logSize-1-i))
+		for j := 1; j < len(t); j++ {
+			// Ŵᵢ(j⋅2ⁱ⁺¹) = Ŵᵢ((j with its lowest bit cleared)⋅2ⁱ⁺¹) + Ŵᵢ(β_{i+1+lowest bit})
+			P(&t[j]).Add(&t[j&(j-1)], &ntt.w[i][i+1+bits.TrailingZeros(uint(j))])
+		}
+		ntt.twiddles[i] = t
+	}
+
+	return ntt
+}
+
+// LogSize returns the logarithm of the size of the subspaces.
+func (ntt *NTT[T, P]) LogSize() int {
+	return ntt.logSize
+}
+
+// Forward transforms in place the 2^logSize coefficients of a polynomial in the novel basis into its
+// evaluations on the coset c⋅2^logSize + U, a[x] being the evaluation at c⋅2^logSize + x.
+// The coset must lie in the field.
+func (ntt *NTT[T, P]) Forward(a []T, coset uint64) {
+	ntt.checkSize(a)
+	for i := ntt.logSize - 1; i >= 0; i-- {
+		shift := ntt.shift(i, coset)
+		half := 1 << i
+		for j := range ntt.twiddles[i] {
+			var t T
+			P(&t).Add(&ntt.twiddles[i][j], &shift)
+			block := a[j<<(i+1) : (j+1)<<(i+1)]
+			for k := 0; k < half; k++ {
+				var tmp T
+				P(&tmp).Mul(&block[k+half], &t)
+				P(&block[k]).Add(&block[k], &tmp)
+				P(&block[k+half]).Add(&block[k+half], &block[k])
+			}
+		}
+	}
+}
+
+// Inverse transforms in place the evaluations on the coset c⋅2^logSize + U into the coefficients in
+// the novel basis, undoing Forward.
+func (ntt *NTT[T, P]) Inverse(a []T, coset uint64) {
+	ntt.checkSize(a)
+	for i := 0; i < ntt.logSize; i++ {
+		shift := ntt.shift(i, coset)
+		half := 1 << i
+		for j := range ntt.twiddles[i] {
+			var t T
+			P(&t).Add(&ntt.twiddles[i][j], &shift)
+			block := a[j<<(i+1) : (j+1)<<(i+1)]
+			for k := 0; k < half; k++ {
+				var tmp T
+				P(&block[k+half]).Add(&block[k+half], &block[k])
+				P(&tmp).Mul(&block[k+half], &t)
+				P(&block[k]).Add(&block[k], &tmp)
+			}
+		}
+	}
+}
+
+// shift returns Ŵᵢ(c⋅2^logSize)
+func (ntt *NTT[T, P]) shift(i int, coset uint64) T {
+	var res T
+	for c := coset; c != 0; c &= c - 1 {
+		m := ntt.logSize + bits.TrailingZeros64(c)
+		if m >= len(ntt.w[i]) {
+			panic("the coset should lie in the field")
+		}
+		P(&res).Add(&res, &ntt.w[i][m])
+	}
+	return res
+}
+
+func (ntt *NTT[T, P]) checkSize(a []T) {
+	if len(a) != 1<<ntt.logSize {
+		panic("the NTT size should be 2^logSize")
+	}
+}
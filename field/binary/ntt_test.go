@@ -0,0 +1,118 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"strconv"
+	"testing"
+)
+
+// normalizedVanishing returns Ŵᵢ(x) = Wᵢ(x)/Wᵢ(βᵢ), Wᵢ = ∏_{u<2ⁱ} (X + u)
+func normalizedVanishing[T any, P Element[T]](i int, x T) T {
+	w := func(x T) T {
+		var res, u, tmp T
+		P(&res).SetOne()
+		for j := 0; j < 1<<i; j++ {
+			P(&u).SetUint64(uint64(j))
+			P(&tmp).Add(&x, &u)
+			P(&res).Mul(&res, &tmp)
+		}
+		return res
+	}
+	var beta T
+	P(&beta).SetUint64(1 << i)
+	num, den := w(x), w(beta)
+	P(&den).Inverse(&den)
+	P(&num).Mul(&num, &den)
+	return num
+}
+
+func testNTT[T any, P Element[T]](t *testing.T) {
+	const logSize = 4
+	ntt := NewNTT[T, P](logSize)
+	for _, coset := range []uint64{0, 1, 5} {
+		t.Run(strconv.FormatUint(coset, 10), func(t *testing.T) {
+			coeffs := make([]T, 1<<logSize)
+			for i := range coeffs {
+				coeffs[i] = random[T, P]()
+			}
+			evals := make([]T, len(coeffs))
+			copy(evals, coeffs)
+			ntt.Forward(evals, coset)
+
+			// Σⱼ cⱼ⋅Xⱼ(x), Xⱼ = ∏_{i ∈ j} Ŵᵢ
+			for x := range evals {
+				var point, expected T
+				P(&point).SetUint64(coset<<logSize | uint64(x))
+				for j := range coeffs {
+					basis := coeffs[j]
+					for i := 0; i < logSize; i++ {
+						if j>>i&1 == 1 {
+							w := normalizedVanishing[T, P](i, point)
+							P(&basis).Mul(&basis, &w)
+						}
+					}
+					P(&expected).Add(&expected, &basis)
+				}
+				if !P(&expected).Equal(&evals[x]) {
+					t.Fatal("wrong evaluation")
+				}
+			}
+
+			ntt.Inverse(evals, coset)
+			for i := range evals {
+				if !P(&evals[i]).Equal(&coeffs[i]) {
+					t.Fatal("Inverse should undo Forward")
+				}
+			}
+		})
+	}
+}
+
+func TestNTT(t *testing.T) {
+	t.Run("E8", testNTT[E8])
+	t.Run("E32", testNTT[E32])
+	t.Run("E128", testNTT[E128])
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("GF(2⁸) should be too small for an NTT of size 2⁹")
+		}
+	}()
+	NewNTT[E8](9)
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkNTT(b *testing.B) {
+	const logSize = 16
+	b.Run("E32", benchmarkNTT[E32](logSize))
+	b.Run("E128", benchmarkNTT[E128](logSize))
+}
+
+func benchmarkNTT[T any, P Element[T]](logSize int) func(b *testing.B) {
+	return func(b *testing.B) {
+		ntt := NewNTT[T, P](logSize)
+		a := make([]T, 1<<logSize)
+		for i := range a {
+			a[i] = random[T, P]()
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			ntt.Forward(a, 1)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import "math/bits"
+
+// PackedE1 is a vector of elements of GF(2), packed 64 per word, the i-th element being the bit i%64
+// of the word i/64.
+type PackedE1 struct {
+	words []uint64
+	n     int
+}
+
+// NewPackedE1 returns the zero vector of n elements of GF(2).
+func NewPackedE1(n int) PackedE1 {
+	return PackedE1{words: make([]uint64, (n+63)/64), n: n}
+}
+
+// Len returns the number of elements of v.
+func (v *PackedE1) Len() int {
+	return v.n
+}
+
+// Words returns the words of v, its unused bits being zero.
+func (v *PackedE1) Words() []uint64 {
+	return v.words
+}
+
+// Get returns the i-th element of v, 0 or 1.
+func (v *PackedE1) Get(i int) uint8 {
+	return uint8(v.words[i/64]>>(i%64)) & 1
+}
+
+// Set sets the i-th element of v to b&1.
+func (v *PackedE1) Set(i int, b uint8) {
+	v.words[i/64] = v.words[i/64]&^(1<<(i%64)) | uint64(b&1)<<(i%64)
+}
+
+// Add adds two vectors element-wise, 64 elements at a time, and stores the result in v.
+// It panics if the vectors don't have the same length.
+func (v *PackedE1) Add(a, b PackedE1) {
+	if a.n != b.n || a.n != v.n {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := range v.words {
+		v.words[i] = a.words[i] ^ b.words[i]
+	}
+}
+
+// Mul multiplies two vectors element-wise, 64 elements at a time, and stores the result in v.
+// It panics if the vectors don't have the same length.
+func (v *PackedE1) Mul(a, b PackedE1) {
+	if a.n != b.n || a.n != v.n {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := range v.words {
+		v.words[i] = a.words[i] & b.words[i]
+	}
+}
+
+// EvaluateMultiLin returns the evaluation at the coordinates of the multilinear polynomial of the
+// values v (see MultiLin), the sum of the eq(b, coordinates) over the elements b of v equal to 1.
+// It panics if v doesn't have 2^len(coordinates) elements.
+func (v *PackedE1) EvaluateMultiLin(coordinates []E128) E128 {
+	if v.n != 1<<len(coordinates) {
+		panic("the vector should have 2^len(coordinates) elements")
+	}
+	eq := EqTable[E128](coordinates)
+	var res E128
+	for i, w := range v.words {
+		for ; w != 0; w &= w - 1 {
+			res.Add(&res, &eq[i*64+bits.TrailingZeros64(w)])
+		}
+	}
+	return res
+}
+
+// PackedE8 is a vector of elements of GF(2⁸), packed 8 per word, the i-th element being the byte i%8
+// of the word i/8.
+type PackedE8 struct {
+	words []uint64
+	n     int
+}
+
+// NewPackedE8 returns the zero vector of n elements of GF(2⁸).
+func NewPackedE8(n int) PackedE8 {
+	return PackedE8{words: make([]uint64, (n+7)/8), n: n}
+}
+
+// Len returns the number of elements of v.
+func (v *PackedE8) Len() int {
+	return v.n
+}
+
+// Words returns the words of v, its unused bytes being zero.
+func (v *PackedE8) Words() []uint64 {
+	return v.words
+}
+
+// Get returns the i-th element of v.
+func (v *PackedE8) Get(i int) E8 {
+	return E8(v.words[i/8] >> (8 * (i % 8)))
+}
+
+// Set sets the i-th element of v to x.
+func (v *PackedE8) Set(i int, x E8) {
+	shift := 8 * (i % 8)
+	v.words[i/8] = v.words[i/8]&^(0xff<<shift) | uint64(x)<<shift
+}
+
+// Add adds two vectors element-wise, 8 elements at a time, and stores the result in v.
+// It panics if the vectors don't have the same length.
+func (v *PackedE8) Add(a, b PackedE8) {
+	if a.n != b.n || a.n != v.n {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := range v.words {
+		v.words[i] = a.words[i] ^ b.words[i]
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in v.
+// It panics if the vectors don't have the same length.
+func (v *PackedE8) Mul(a, b PackedE8) {
+	if a.n != b.n || a.n != v.n {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := range v.words {
+		var w uint64
+		for j := 0; j < 64; j += 8 {
+			w |= uint64(mul8(E8(a.words[i]>>j), E8(b.words[i]>>j))) << j
+		}
+		v.words[i] = w
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in v.
+// It panics if the vectors don't have the same length.
+func (v *PackedE8) ScalarMul(a PackedE8, c E8) {
+	if a.n != v.n {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	// x ↦ x⋅c on all the bytes
+	var table [256]E8
+	for x := range table {
+		table[x] = mul8(E8(x), c)
+	}
+	for i := range v.words {
+		var w uint64
+		for j := 0; j < 64; j += 8 {
+			w |= uint64(table[uint8(a.words[i]>>j)]) << j
+		}
+		v.words[i] = w
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPackedE1(t *testing.T) {
+	const n = 1 << 7
+	a, b, v := NewPackedE1(n), NewPackedE1(n), NewPackedE1(n)
+	for i := 0; i < n; i++ {
+		a.Set(i, uint8(rand.Intn(2)))
+		b.Set(i, uint8(rand.Intn(2)))
+	}
+
+	v.Add(a, b)
+	for i := 0; i < n; i++ {
+		if v.Get(i) != a.Get(i)^b.Get(i) {
+			t.Fatal("wrong sum")
+		}
+	}
+	v.Mul(a, b)
+	for i := 0; i < n; i++ {
+		if v.Get(i) != a.Get(i)&b.Get(i) {
+			t.Fatal("wrong product")
+		}
+	}
+
+	// the same evaluation as the unpacked multilinear polynomial
+	m := make(MultiLin[E128, *E128], n)
+	for i := range m {
+		m[i].SetUint64(uint64(a.Get(i)))
+	}
+	coordinates := make([]E128, 7)
+	for i := range coordinates {
+		coordinates[i] = random[E128]()
+	}
+	expected := m.Evaluate(coordinates)
+	if e := a.EvaluateMultiLin(coordinates); !e.Equal(&expected) {
+		t.Fatal("wrong multilinear evaluation")
+	}
+}
+
+func TestPackedE8(t *testing.T) {
+	const n = 37
+	a, b, v := NewPackedE8(n), NewPackedE8(n), NewPackedE8(n)
+	for i := 0; i < n; i++ {
+		a.Set(i, random[E8]())
+		b.Set(i, random[E8]())
+	}
+
+	v.Add(a, b)
+	for i := 0; i < n; i++ {
+		if v.Get(i) != a.Get(i)^b.Get(i) {
+			t.Fatal("wrong sum")
+		}
+	}
+	v.Mul(a, b)
+	for i := 0; i < n; i++ {
+		x, y := a.Get(i), b.Get(i)
+		if v.Get(i) != *x.Mul(&x, &y) {
+			t.Fatal("wrong product")
+		}
+	}
+	c := random[E8]()
+	v.ScalarMul(a, c)
+	for i := 0; i < n; i++ {
+		x := a.Get(i)
+		if v.Get(i) != *x.Mul(&x, &c) {
+			t.Fatal("wrong scalar product")
+		}
+	}
+}
@@ -0,0 +1,500 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+)
+
+// E8 is an element of GF(2⁸)
+type E8 uint8
+
+// E16 is an element of GF(2¹⁶) = GF(2⁸)[X₃]
+type E16 uint16
+
+// E32 is an element of GF(2³²) = GF(2¹⁶)[X₄]
+type E32 uint32
+
+// E64 is an element of GF(2⁶⁴) = GF(2³²)[X₅]
+type E64 uint64
+
+// E128 is an element of GF(2¹²⁸) = GF(2⁶⁴)[X₆], its low half first
+type E128 [2]uint64
+
+// Element is satisfied by a pointer to an element of the tower, *E8 to *E128.
+type Element[T any] interface {
+	*T
+	Set(x *T) *T
+	SetZero() *T
+	SetOne() *T
+	SetUint64(v uint64) *T
+	SetRandom() (*T, error)
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Mul(x, y *T) *T
+	Square(x *T) *T
+	Inverse(x *T) *T
+	IsZero() bool
+	IsOne() bool
+	Equal(x *T) bool
+}
+
+// tables of GF(2⁸): exp8[i] = gⁱ and log8[gⁱ] = i for a generator g of GF(2⁸)*, and
+// alpha8[x] = x⋅X₂
+var (
+	exp8   [2 * 255]E8
+	log8   [256]uint8
+	alpha8 [256]E8
+)
+
+func init() {
+	// find a generator, multiplying with the tower definition
+	for g := uint64(2); ; g++ {
+		x := uint64(1)
+		order := 0
+		for {
+			exp8[order] = E8(x)
+			order++
+			if x = mulTower(x, g, 3); x == 1 {
+				break
+			}
+		}
+		if order == 255 {
+			break
+		}
+	}
+	for i := 0; i < 255; i++ {
+		exp8[i+255] = exp8[i]
+		log8[exp8[i]] = uint8(i)
+	}
+	for x := range alpha8 {
+		alpha8[x] = E8(mulTower(uint64(x), 1<<4, 3))
+	}
+
+	initPolyTables()
+}
+
+// mulTower returns x⋅y in GF(2^(2ᵏ)), from the definition of the tower
+func mulTower(x, y uint64, k int) uint64 {
+	if k == 0 {
+		return x & y
+	}
+	h := uint(1) << (k - 1)
+	mask := uint64(1)<<h - 1
+	x0, x1, y0, y1 := x&mask, x>>h, y&mask, y>>h
+	z0, z2 := mulTower(x0, y0, k-1), mulTower(x1, y1, k-1)
+	z1 := mulTower(x0^x1, y0^y1, k-1) ^ z0 ^ z2
+
+	// Xₖ₋₁² = Xₖ₋₁⋅Xₖ₋₂ + 1, with X₋₁ = 1
+	alpha := uint64(1)
+	if k > 1 {
+		alpha <<= h / 2
+	}
+	return (z0 ^ z2) | (z1^mulTower(z2, alpha, k-1))<<h
+}
+
+// In each field, with x = x₀ + x₁⋅X and X² = X⋅α + 1, α being the generator of the subfield:
+//
+//	x⋅y = (x₀⋅y₀ + x₁⋅y₁) + ((x₀ + x₁)⋅(y₀ + y₁) - x₀⋅y₀)⋅X
+//	x⋅X = x₁ + (x₀ + x₁⋅α)⋅X
+//	x² = (x₀² + x₁²) + x₁²⋅α⋅X
+//	1/x = ((x₀ + x₁⋅α) + x₁⋅X) / (x₀⋅(x₀ + x₁⋅α) + x₁²)
+
+func mul8(x, y E8) E8 {
+	if x == 0 || y == 0 {
+		return 0
+	}
+	return exp8[uint(log8[x])+uint(log8[y])]
+}
+
+func square8(x E8) E8 {
+	return mul8(x, x)
+}
+
+func inverse8(x E8) E8 {
+	if x == 0 {
+		return 0
+	}
+	return exp8[255-uint(log8[x])]
+}
+
+func mul16(x, y E16) E16 {
+	x0, x1, y0, y1 := E8(x), E8(x>>8), E8(y), E8(y>>8)
+	z0, z2 := mul8(x0, y0), mul8(x1, y1)
+	z1 := mul8(x0^x1, y0^y1) ^ z0
+	return E16(z0^z2) | E16(z1^z2^alpha8[z2])<<8
+}
+
+func mulAlpha16(x E16) E16 {
+	x0, x1 := E8(x), E8(x>>8)
+	return E16(x1) | E16(x0^alpha8[x1])<<8
+}
+
+func square16(x E16) E16 {
+	x0, x1 := square8(E8(x)), square8(E8(x>>8))
+	return E16(x0^x1) | E16(alpha8[x1])<<8
+}
+
+func inverse16(x E16) E16 {
+	x0, x1 := E8(x), E8(x>>8)
+	t := x0 ^ alpha8[x1]
+	nInv := inverse8(mul8(x0, t) ^ square8(x1))
+	return E16(mul8(t, nInv)) | E16(mul8(x1, nInv))<<8
+}
+
+func mul32(x, y E32) E32 {
+	x0, x1, y0, y1 := E16(x), E16(x>>16), E16(y), E16(y>>16)
+	z0, z2 := mul16(x0, y0), mul16(x1, y1)
+	z1 := mul16(x0^x1, y0^y1) ^ z0
+	return E32(z0^z2) | E32(z1^z2^mulAlpha16(z2))<<16
+}
+
+func mulAlpha32(x E32) E32 {
+	x0, x1 := E16(x), E16(x>>16)
+	return E32(x1) | E32(x0^mulAlpha16(x1))<<16
+}
+
+func square32(x E32) E32 {
+	x0, x1 := square16(E16(x)), square16(E16(x>>16))
+	return E32(x0^x1) | E32(mulAlpha16(x1))<<16
+}
+
+func inverse32(x E32) E32 {
+	x0, x1 := E16(x), E16(x>>16)
+	t := x0 ^ mulAlpha16(x1)
+	nInv := inverse16(mul16(x0, t) ^ square16(x1))
+	return E32(mul16(t, nInv)) | E32(mul16(x1, nInv))<<16
+}
+
+func mul64(x, y E64) E64 {
+	x0, x1, y0, y1 := E32(x), E32(x>>32), E32(y), E32(y>>32)
+	z0, z2 := mul32(x0, y0), mul32(x1, y1)
+	z1 := mul32(x0^x1, y0^y1) ^ z0
+	return E64(z0^z2) | E64(z1^z2^mulAlpha32(z2))<<32
+}
+
+func mulAlpha64(x E64) E64 {
+	x0, x1 := E32(x), E32(x>>32)
+	return E64(x1) | E64(x0^mulAlpha32(x1))<<32
+}
+
+func square64(x E64) E64 {
+	x0, x1 := square32(E32(x)), square32(E32(x>>32))
+	return E64(x0^x1) | E64(mulAlpha32(x1))<<32
+}
+
+func inverse64(x E64) E64 {
+	x0, x1 := E32(x), E32(x>>32)
+	t := x0 ^ mulAlpha32(x1)
+	nInv := inverse32(mul32(x0, t) ^ square32(x1))
+	return E64(mul32(t, nInv)) | E64(mul32(x1, nInv))<<32
+}
+
+func mul128(x, y E128) E128 {
+	x0, x1, y0, y1 := E64(x[0]), E64(x[1]), E64(y[0]), E64(y[1])
+	z0, z2 := mul64(x0, y0), mul64(x1, y1)
+	z1 := mul64(x0^x1, y0^y1) ^ z0
+	return E128{uint64(z0 ^ z2), uint64(z1 ^ z2 ^ mulAlpha64(z2))}
+}
+
+func square128(x E128) E128 {
+	x0, x1 := square64(E64(x[0])), square64(E64(x[1]))
+	return E128{uint64(x0 ^ x1), uint64(mulAlpha64(x1))}
+}
+
+func inverse128(x E128) E128 {
+	x0, x1 := E64(x[0]), E64(x[1])
+	t := x0 ^ mulAlpha64(x1)
+	nInv := inverse64(mul64(x0, t) ^ square64(x1))
+	return E128{uint64(mul64(t, nInv)), uint64(mul64(x1, nInv))}
+}
+
+// mulE8 returns x⋅c, GF(2¹²⁸) being a vector space over GF(2⁸) with the bytes of x as coordinates
+func mulE8(x E128, c E8) E128 {
+	var res E128
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 64; j += 8 {
+			res[i] |= uint64(mul8(E8(x[i]>>j), c)) << j
+		}
+	}
+	return res
+}
+
+// -------------------------------------------------------------------------------------------------
+// E8
+
+// SetZero sets z to 0 and returns z
+func (z *E8) SetZero() *E8 { *z = 0; return z }
+
+// SetOne sets z to 1 and returns z
+func (z *E8) SetOne() *E8 { *z = 1; return z }
+
+// SetUint64 sets z to the element of coordinates the low 8 bits of v and returns z
+func (z *E8) SetUint64(v uint64) *E8 { *z = E8(v); return z }
+
+// Set sets z to x and returns z
+func (z *E8) Set(x *E8) *E8 { *z = *x; return z }
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E8) SetRandom() (*E8, error) {
+	var b [1]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = E8(b[0])
+	return z, nil
+}
+
+// Add sets z to x + y and returns z
+func (z *E8) Add(x, y *E8) *E8 { *z = *x ^ *y; return z }
+
+// Sub sets z to x - y = x + y and returns z
+func (z *E8) Sub(x, y *E8) *E8 { *z = *x ^ *y; return z }
+
+// Mul sets z to x⋅y and returns z
+func (z *E8) Mul(x, y *E8) *E8 { *z = mul8(*x, *y); return z }
+
+// Square sets z to x² and returns z
+func (z *E8) Square(x *E8) *E8 { *z = square8(*x); return z }
+
+// Inverse sets z to 1/x (0 if x = 0) and returns z
+func (z *E8) Inverse(x *E8) *E8 { *z = inverse8(*x); return z }
+
+// IsZero returns z == 0
+func (z *E8) IsZero() bool { return *z == 0 }
+
+// IsOne returns z == 1
+func (z *E8) IsOne() bool { return *z == 1 }
+
+// Equal returns z == x
+func (z *E8) Equal(x *E8) bool { return *z == *x }
+
+// String returns the hexadecimal coordinates of z
+func (z E8) String() string { return fmt.Sprintf("0x%02x", uint8(z)) }
+
+// -------------------------------------------------------------------------------------------------
+// E16
+
+// SetZero sets z to 0 and returns z
+func (z *E16) SetZero() *E16 { *z = 0; return z }
+
+// SetOne sets z to 1 and returns z
+func (z *E16) SetOne() *E16 { *z = 1; return z }
+
+// SetUint64 sets z to the element of coordinates the low 16 bits of v and returns z
+func (z *E16) SetUint64(v uint64) *E16 { *z = E16(v); return z }
+
+// Set sets z to x and returns z
+func (z *E16) Set(x *E16) *E16 { *z = *x; return z }
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E16) SetRandom() (*E16, error) {
+	var b [2]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = E16(binary.LittleEndian.Uint16(b[:]))
+	return z, nil
+}
+
+// Add sets z to x + y and returns z
+func (z *E16) Add(x, y *E16) *E16 { *z = *x ^ *y; return z }
+
+// Sub sets z to x - y = x + y and returns z
+func (z *E16) Sub(x, y *E16) *E16 { *z = *x ^ *y; return z }
+
+// Mul sets z to x⋅y and returns z
+func (z *E16) Mul(x, y *E16) *E16 { *z = mul16(*x, *y); return z }
+
+// Square sets z to x² and returns z
+func (z *E16) Square(x *E16) *E16 { *z = square16(*x); return z }
+
+// Inverse sets z to 1/x (0 if x = 0) and returns z
+func (z *E16) Inverse(x *E16) *E16 { *z = inverse16(*x); return z }
+
+// IsZero returns z == 0
+func (z *E16) IsZero() bool { return *z == 0 }
+
+// IsOne returns z == 1
+func (z *E16) IsOne() bool { return *z == 1 }
+
+// Equal returns z == x
+func (z *E16) Equal(x *E16) bool { return *z == *x }
+
+// String returns the hexadecimal coordinates of z
+func (z E16) String() string { return fmt.Sprintf("0x%04x", uint16(z)) }
+
+// -------------------------------------------------------------------------------------------------
+// E32
+
+// SetZero sets z to 0 and returns z
+func (z *E32) SetZero() *E32 { *z = 0; return z }
+
+// SetOne sets z to 1 and returns z
+func (z *E32) SetOne() *E32 { *z = 1; return z }
+
+// SetUint64 sets z to the element of coordinates the low 32 bits of v and returns z
+func (z *E32) SetUint64(v uint64) *E32 { *z = E32(v); return z }
+
+// Set sets z to x and returns z
+func (z *E32) Set(x *E32) *E32 { *z = *x; return z }
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E32) SetRandom() (*E32, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = E32(binary.LittleEndian.Uint32(b[:]))
+	return z, nil
+}
+
+// Add sets z to x + y and returns z
+func (z *E32) Add(x, y *E32) *E32 { *z = *x ^ *y; return z }
+
+// Sub sets z to x - y = x + y and returns z
+func (z *E32) Sub(x, y *E32) *E32 { *z = *x ^ *y; return z }
+
+// Mul sets z to x⋅y and returns z
+func (z *E32) Mul(x, y *E32) *E32 { *z = mul32(*x, *y); return z }
+
+// Square sets z to x² and returns z
+func (z *E32) Square(x *E32) *E32 { *z = square32(*x); return z }
+
+// Inverse sets z to 1/x (0 if x = 0) and returns z
+func (z *E32) Inverse(x *E32) *E32 { *z = inverse32(*x); return z }
+
+// IsZero returns z == 0
+func (z *E32) IsZero() bool { return *z == 0 }
+
+// IsOne returns z == 1
+func (z *E32) IsOne() bool { return *z == 1 }
+
+// Equal returns z == x
+func (z *E32) Equal(x *E32) bool { return *z == *x }
+
+// String returns the hexadecimal coordinates of z
+func (z E32) String() string { return fmt.Sprintf("0x%08x", uint32(z)) }
+
+// -------------------------------------------------------------------------------------------------
+// E64
+
+// SetZero sets z to 0 and returns z
+func (z *E64) SetZero() *E64 { *z = 0; return z }
+
+// SetOne sets z to 1 and returns z
+func (z *E64) SetOne() *E64 { *z = 1; return z }
+
+// SetUint64 sets z to the element of coordinates the bits of v and returns z
+func (z *E64) SetUint64(v uint64) *E64 { *z = E64(v); return z }
+
+// Set sets z to x and returns z
+func (z *E64) Set(x *E64) *E64 { *z = *x; return z }
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E64) SetRandom() (*E64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = E64(binary.LittleEndian.Uint64(b[:]))
+	return z, nil
+}
+
+// Add sets z to x + y and returns z
+func (z *E64) Add(x, y *E64) *E64 { *z = *x ^ *y; return z }
+
+// Sub sets z to x - y = x + y and returns z
+func (z *E64) Sub(x, y *E64) *E64 { *z = *x ^ *y; return z }
+
+// Mul sets z to x⋅y and returns z
+func (z *E64) Mul(x, y *E64) *E64 { *z = mulElement64(*x, *y); return z }
+
+// Square sets z to x² and returns z
+func (z *E64) Square(x *E64) *E64 { *z = square64(*x); return z }
+
+// Inverse sets z to 1/x (0 if x = 0) and returns z
+func (z *E64) Inverse(x *E64) *E64 { *z = inverse64(*x); return z }
+
+// IsZero returns z == 0
+func (z *E64) IsZero() bool { return *z == 0 }
+
+// IsOne returns z == 1
+func (z *E64) IsOne() bool { return *z == 1 }
+
+// Equal returns z == x
+func (z *E64) Equal(x *E64) bool { return *z == *x }
+
+// String returns the hexadecimal coordinates of z
+func (z E64) String() string { return fmt.Sprintf("0x%016x", uint64(z)) }
+
+// -------------------------------------------------------------------------------------------------
+// E128
+
+// SetZero sets z to 0 and returns z
+func (z *E128) SetZero() *E128 { *z = E128{}; return z }
+
+// SetOne sets z to 1 and returns z
+func (z *E128) SetOne() *E128 { *z = E128{1, 0}; return z }
+
+// SetUint64 sets z to the element of the subfield GF(2⁶⁴) of coordinates the bits of v and returns z
+func (z *E128) SetUint64(v uint64) *E128 { *z = E128{v, 0}; return z }
+
+// Set sets z to x and returns z
+func (z *E128) Set(x *E128) *E128 { *z = *x; return z }
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E128) SetRandom() (*E128, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	z[0] = binary.LittleEndian.Uint64(b[:8])
+	z[1] = binary.LittleEndian.Uint64(b[8:])
+	return z, nil
+}
+
+// Add sets z to x + y and returns z
+func (z *E128) Add(x, y *E128) *E128 { *z = E128{x[0] ^ y[0], x[1] ^ y[1]}; return z }
+
+// Sub sets z to x - y = x + y and returns z
+func (z *E128) Sub(x, y *E128) *E128 { return z.Add(x, y) }
+
+// Mul sets z to x⋅y and returns z
+func (z *E128) Mul(x, y *E128) *E128 { *z = mulElement128(*x, *y); return z }
+
+// MulByE8 sets z to x⋅c, c being in the subfield GF(2⁸), and returns z.
+// It is cheaper than Mul since GF(2¹²⁸) is a vector space over GF(2⁸) with the bytes of x as coordinates.
+func (z *E128) MulByE8(x *E128, c E8) *E128 { *z = mulE8(*x, c); return z }
+
+// Square sets z to x² and returns z
+func (z *E128) Square(x *E128) *E128 { *z = square128(*x); return z }
+
+// Inverse sets z to 1/x (0 if x = 0) and returns z
+func (z *E128) Inverse(x *E128) *E128 { *z = inverse128(*x); return z }
+
+// IsZero returns z == 0
+func (z *E128) IsZero() bool { return z[0] == 0 && z[1] == 0 }
+
+// IsOne returns z == 1
+func (z *E128) IsOne() bool { return z[0] == 1 && z[1] == 0 }
+
+// Equal returns z == x
+func (z *E128) Equal(x *E128) bool { return *z == *x }
+
+// String returns the hexadecimal coordinates of z
+func (z E128) String() string { return fmt.Sprintf("0x%016x%016x", z[1], z[0]) }
@@ -0,0 +1,177 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"testing"
+)
+
+func random[T any, P Element[T]]() T {
+	var x T
+	if _, err := P(&x).SetRandom(); err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// testField checks the field axioms on random elements
+func testField[T any, P Element[T]](t *testing.T) {
+	var one T
+	P(&one).SetOne()
+	for i := 0; i < 200; i++ {
+		x, y, z := random[T, P](), random[T, P](), random[T, P]()
+
+		// (x⋅y)⋅z = x⋅(y⋅z) and x⋅y = y⋅x
+		var xy, yz, l, r T
+		P(&xy).Mul(&x, &y)
+		P(&yz).Mul(&y, &z)
+		P(&l).Mul(&xy, &z)
+		P(&r).Mul(&x, &yz)
+		if !P(&l).Equal(&r) {
+			t.Fatal("multiplication should be associative")
+		}
+		P(&r).Mul(&y, &x)
+		if !P(&xy).Equal(&r) {
+			t.Fatal("multiplication should be commutative")
+		}
+
+		// x⋅(y + z) = x⋅y + x⋅z
+		var s, xz T
+		P(&s).Add(&y, &z)
+		P(&l).Mul(&x, &s)
+		P(&xz).Mul(&x, &z)
+		P(&r).Add(&xy, &xz)
+		if !P(&l).Equal(&r) {
+			t.Fatal("multiplication should distribute over addition")
+		}
+
+		// x² = x⋅x, x⋅1 = x and x⋅(1/x) = 1
+		P(&l).Square(&x)
+		P(&r).Mul(&x, &x)
+		if !P(&l).Equal(&r) {
+			t.Fatal("wrong square")
+		}
+		if P(&l).Mul(&x, &one); !P(&l).Equal(&x) {
+			t.Fatal("1 should be neutral")
+		}
+		if P(&x).IsZero() {
+			continue
+		}
+		P(&l).Inverse(&x)
+		if P(&l).Mul(&l, &x); !P(&l).IsOne() {
+			t.Fatal("wrong inverse")
+		}
+	}
+
+	var zero T
+	if P(&zero).Inverse(&zero); !P(&zero).IsZero() {
+		t.Fatal("the inverse of 0 should be 0")
+	}
+}
+
+func TestE8(t *testing.T)   { testField[E8](t) }
+func TestE16(t *testing.T)  { testField[E16](t) }
+func TestE32(t *testing.T)  { testField[E32](t) }
+func TestE64(t *testing.T)  { testField[E64](t) }
+func TestE128(t *testing.T) { testField[E128](t) }
+
+func TestTower(t *testing.T) {
+	// Xₖ² = Xₖ⋅Xₖ₋₁ + 1, Xₖ being the element 2^(2ᵏ)
+	for k := 0; k < 7; k++ {
+		var x, prev, l, r E128
+		if k < 6 {
+			x.SetUint64(1 << (1 << k))
+		} else {
+			x = E128{0, 1}
+		}
+		prev.SetOne()
+		if k > 0 {
+			prev.SetUint64(1 << (1 << (k - 1)))
+		}
+		l.Square(&x)
+		r.Mul(&x, &prev)
+		r[0] ^= 1
+		if !l.Equal(&r) {
+			t.Fatal("wrong tower relation at level", k)
+		}
+	}
+
+	// the subfields are embedded by zero extension
+	for i := 0; i < 100; i++ {
+		x, y := random[E8](), random[E8]()
+		var xy E8
+		xy.Mul(&x, &y)
+		var x128, y128, xy128 E128
+		x128.SetUint64(uint64(x))
+		y128.SetUint64(uint64(y))
+		if xy128.Mul(&x128, &y128); xy128 != (E128{uint64(xy), 0}) {
+			t.Fatal("GF(2⁸) should be a subfield of GF(2¹²⁸)")
+		}
+
+		x32, y32 := random[E32](), random[E32]()
+		var xy32 E32
+		xy32.Mul(&x32, &y32)
+		var x64, y64, xy64 E64
+		x64.SetUint64(uint64(x32))
+		y64.SetUint64(uint64(y32))
+		if xy64.Mul(&x64, &y64); xy64 != E64(xy32) {
+			t.Fatal("GF(2³²) should be a subfield of GF(2⁶⁴)")
+		}
+
+		// multiplication by a scalar of GF(2⁸)
+		z := random[E128]()
+		var l, r E128
+		l.MulByE8(&z, x)
+		r.Mul(&z, &x128)
+		if !l.Equal(&r) {
+			t.Fatal("wrong multiplication by an element of GF(2⁸)")
+		}
+	}
+}
+
+func TestMulTower(t *testing.T) {
+	// the tables of GF(2⁸) against the definition of the tower
+	for x := 0; x < 256; x++ {
+		for y := 0; y < 256; y++ {
+			if mul8(E8(x), E8(y)) != E8(mulTower(uint64(x), uint64(y), 3)) {
+				t.Fatal("wrong multiplication in GF(2⁸)")
+			}
+		}
+	}
+	for i := 0; i < 100; i++ {
+		x, y := random[E32](), random[E32]()
+		if mul32(x, y) != E32(mulTower(uint64(x), uint64(y), 5)) {
+			t.Fatal("wrong multiplication in GF(2³²)")
+		}
+	}
+}
+
+// --------------------------------------------------------------------
+// benches
+
+func BenchmarkMul(b *testing.B) {
+	b.Run("E8", benchmarkMul[E8])
+	b.Run("E16", benchmarkMul[E16])
+	b.Run("E32", benchmarkMul[E32])
+	b.Run("E64", benchmarkMul[E64])
+	b.Run("E128", benchmarkMul[E128])
+}
+
+func benchmarkMul[T any, P Element[T]](b *testing.B) {
+	x, y := random[T, P](), random[T, P]()
+	for i := 0; i < b.N; i++ {
+		P(&x).Mul(&x, &y)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package field provides field-generic code built on the Element type constraint,
+// which is satisfied by every generated field element (fr, fp, goldilocks, ...).
+//
+// Generic types take the element value type T and its pointer type P as type
+// parameters:
+//
+//	var v field.Vector[fr.Element, *fr.Element]
+//	var p field.Polynomial[goldilocks.Element, *goldilocks.Element]
+//
+// Generic functions only need T; P is inferred:
+//
+//	inv := field.BatchInvert[fr.Element](a)
+package field
+
+import "math/big"
+
+// Element is satisfied by a pointer to a generated field element, such as
+// *fr.Element, *fp.Element or *goldilocks.Element.
+type Element[T any] interface {
+	*T
+	Set(x *T) *T
+	SetZero() *T
+	SetOne() *T
+	SetUint64(v uint64) *T
+	SetInt64(v int64) *T
+	SetBigInt(v *big.Int) *T
+	SetString(number string) (*T, error)
+	SetRandom() (*T, error)
+	SetBytes(e []byte) *T
+	Add(x, y *T) *T
+	Sub(x, y *T) *T
+	Double(x *T) *T
+	Neg(x *T) *T
+	Mul(x, y *T) *T
+	Square(x *T) *T
+	Inverse(x *T) *T
+	Div(x, y *T) *T
+	Exp(x T, k *big.Int) *T
+	Equal(x *T) bool
+	IsZero() bool
+	IsOne() bool
+	BigInt(res *big.Int) *big.Int
+	Marshal() []byte
+	Text(base int) string
+	String() string
+}
+
+// One returns 1
+func One[T any, P Element[T]]() T {
+	var res T
+	P(&res).SetOne()
+	return res
+}
+
+// BatchInvert returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick; zero elements are left as zero.
+func BatchInvert[T any, P Element[T]](a []T) []T {
+	res := make([]T, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	accumulator := One[T, P]()
+
+	for i := 0; i < len(a); i++ {
+		if P(&a[i]).IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i] = accumulator
+		P(&accumulator).Mul(&accumulator, &a[i])
+	}
+
+	P(&accumulator).Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		P(&res[i]).Mul(&res[i], &accumulator)
+		P(&accumulator).Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
@@ -0,0 +1,201 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field_test
+
+import (
+	"testing"
+
+	fp_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	fp_bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fp"
+	fr_bls12378 "github.com/consensys/gnark-crypto/ecc/bls12-378/fr"
+	fp_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	fp_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fp"
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	fp_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fp"
+	fr_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	fp_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	fp_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fp"
+	fr_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/fr"
+	fp_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fp"
+	fr_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/fr"
+	fp_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	fp_ed25519 "github.com/consensys/gnark-crypto/ecc/ed25519/fp"
+	fr_ed25519 "github.com/consensys/gnark-crypto/ecc/ed25519/fr"
+	fp_mnt4298 "github.com/consensys/gnark-crypto/ecc/mnt4-298/fp"
+	fr_mnt4298 "github.com/consensys/gnark-crypto/ecc/mnt4-298/fr"
+	fp_mnt6298 "github.com/consensys/gnark-crypto/ecc/mnt6-298/fp"
+	fr_mnt6298 "github.com/consensys/gnark-crypto/ecc/mnt6-298/fr"
+	fp_pallas "github.com/consensys/gnark-crypto/ecc/pallas/fp"
+	fr_pallas "github.com/consensys/gnark-crypto/ecc/pallas/fr"
+	fp_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	fr_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	fp_secp256r1 "github.com/consensys/gnark-crypto/ecc/secp256r1/fp"
+	fr_secp256r1 "github.com/consensys/gnark-crypto/ecc/secp256r1/fr"
+	fp_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/fp"
+	fr_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+	"github.com/consensys/gnark-crypto/field"
+	"github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+const nbElements = 17
+
+// testElement exercises the generic helpers of package field on T.
+// It doubles as a compile-time check that T satisfies field.Element.
+func testElement[T any, P field.Element[T]](t *testing.T) {
+	t.Parallel()
+
+	random := func(n int) field.Vector[T, P] {
+		v := make(field.Vector[T, P], n)
+		for i := range v {
+			if _, err := P(&v[i]).SetRandom(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return v
+	}
+	a, b := random(nbElements), random(nbElements)
+
+	// (a + b) - b == a
+	c := make(field.Vector[T, P], nbElements)
+	c.Add(a, b)
+	c.Sub(c, b)
+	if !c.Equal(a) {
+		t.Fatal("vector add/sub mismatch")
+	}
+
+	// <a, b> == Σ a ⊙ b
+	c.Mul(a, b)
+	sum, ip := c.Sum(), a.InnerProduct(b)
+	if !P(&sum).Equal(&ip) {
+		t.Fatal("inner product mismatch")
+	}
+
+	// <a, λ⋅b> == λ⋅<a, b>
+	lambda := random(1)[0]
+	c.ScalarMul(b, &lambda)
+	ip2 := a.InnerProduct(c)
+	P(&ip).Mul(&ip, &lambda)
+	if !P(&ip).Equal(&ip2) {
+		t.Fatal("scalar mul mismatch")
+	}
+
+	// a[i] * BatchInvert(a)[i] == 1, zeroes stay zero
+	var zero T
+	a[3] = zero
+	inv := field.BatchInvert[T, P](a)
+	for i := range a {
+		var r T
+		P(&r).Mul(&a[i], &inv[i])
+		if i == 3 {
+			if !P(&inv[i]).IsZero() {
+				t.Fatal("BatchInvert should leave zero untouched")
+			}
+			continue
+		}
+		if !P(&r).IsOne() {
+			t.Fatal("BatchInvert mismatch")
+		}
+	}
+
+	// (p1 * p2)(x) == p1(x) * p2(x) and (p1 + p2)(x) == p1(x) + p2(x)
+	p1, p2 := field.Polynomial[T, P](random(nbElements)), field.Polynomial[T, P](random(nbElements/2))
+	x := random(1)[0]
+	e1, e2 := p1.Eval(&x), p2.Eval(&x)
+
+	var prod, add field.Polynomial[T, P]
+	prod.Mul(p1, p2)
+	add.Add(p1, p2)
+	if prod.Degree() != p1.Degree()+p2.Degree() {
+		t.Fatal("wrong degree for the product")
+	}
+
+	var expected T
+	got := prod.Eval(&x)
+	P(&expected).Mul(&e1, &e2)
+	if !P(&got).Equal(&expected) {
+		t.Fatal("polynomial mul mismatch")
+	}
+	got = add.Eval(&x)
+	P(&expected).Add(&e1, &e2)
+	if !P(&got).Equal(&expected) {
+		t.Fatal("polynomial add mismatch")
+	}
+
+	// p1 - p1 == 0
+	q := p1.Clone()
+	q.Sub(q, p1)
+	if q.Text(10) != "0" {
+		t.Fatal("polynomial sub mismatch")
+	}
+
+	// the empty polynomial is zero
+	var empty field.Polynomial[T, P]
+	if got := empty.Eval(&x); !P(&got).IsZero() {
+		t.Fatal("empty polynomial should evaluate to zero")
+	}
+	if empty.Degree() != 0 {
+		t.Fatal("empty polynomial should have degree 0")
+	}
+	add.Add(empty, p2)
+	if !add.Equal(p2) {
+		t.Fatal("polynomial add with empty mismatch")
+	}
+}
+
+func TestElement(t *testing.T) {
+	t.Run("bls12-377/fp", testElement[fp_bls12377.Element])
+	t.Run("bls12-377/fr", testElement[fr_bls12377.Element])
+	t.Run("bls12-378/fp", testElement[fp_bls12378.Element])
+	t.Run("bls12-378/fr", testElement[fr_bls12378.Element])
+	t.Run("bls12-381/fp", testElement[fp_bls12381.Element])
+	t.Run("bls12-381/fr", testElement[fr_bls12381.Element])
+	t.Run("bls24-315/fp", testElement[fp_bls24315.Element])
+	t.Run("bls24-315/fr", testElement[fr_bls24315.Element])
+	t.Run("bls24-317/fp", testElement[fp_bls24317.Element])
+	t.Run("bls24-317/fr", testElement[fr_bls24317.Element])
+	t.Run("bn254/fp", testElement[fp_bn254.Element])
+	t.Run("bn254/fr", testElement[fr_bn254.Element])
+	t.Run("bw6-633/fp", testElement[fp_bw6633.Element])
+	t.Run("bw6-633/fr", testElement[fr_bw6633.Element])
+	t.Run("bw6-756/fp", testElement[fp_bw6756.Element])
+	t.Run("bw6-756/fr", testElement[fr_bw6756.Element])
+	t.Run("bw6-761/fp", testElement[fp_bw6761.Element])
+	t.Run("bw6-761/fr", testElement[fr_bw6761.Element])
+	t.Run("ed25519/fp", testElement[fp_ed25519.Element])
+	t.Run("ed25519/fr", testElement[fr_ed25519.Element])
+	t.Run("mnt4-298/fp", testElement[fp_mnt4298.Element])
+	t.Run("mnt4-298/fr", testElement[fr_mnt4298.Element])
+	t.Run("mnt6-298/fp", testElement[fp_mnt6298.Element])
+	t.Run("mnt6-298/fr", testElement[fr_mnt6298.Element])
+	t.Run("pallas/fp", testElement[fp_pallas.Element])
+	t.Run("pallas/fr", testElement[fr_pallas.Element])
+	t.Run("secp256k1/fp", testElement[fp_secp256k1.Element])
+	t.Run("secp256k1/fr", testElement[fr_secp256k1.Element])
+	t.Run("secp256r1/fp", testElement[fp_secp256r1.Element])
+	t.Run("secp256r1/fr", testElement[fr_secp256r1.Element])
+	t.Run("stark-curve/fp", testElement[fp_starkcurve.Element])
+	t.Run("stark-curve/fr", testElement[fr_starkcurve.Element])
+	t.Run("goldilocks", testElement[goldilocks.Element])
+	t.Run("babybear", testElement[babybear.Element])
+	t.Run("koalabear", testElement[koalabear.Element])
+	t.Run("mersenne31", testElement[mersenne31.Element])
+}
@@ -158,6 +158,12 @@ func Generate(w io.Writer, F *config.FieldConfig) error {
 	// fft butterflies
 	f.generateButterfly()
 
+	// vector operations
+	if f.NbWords <= MaxNbWordsVec {
+		f.generateAddVec()
+		f.generateSubVec()
+	}
+
 	return nil
 }
 
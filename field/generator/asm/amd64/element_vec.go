@@ -0,0 +1,156 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amd64
+
+import "fmt"
+
+// MaxNbWordsVec is the largest number of words for which the vector kernels are generated;
+// past that the operands no longer fit in the general purpose registers.
+const MaxNbWordsVec = 6
+
+// addVec res = a + b
+//
+//	func addVec(res, a, b *{{.ElementName}}, n uint64) {
+//		for i := 0; i < n; i++ {
+//			res[i].Add(&a[i], &b[i])
+//		}
+//	}
+func (f *FFAmd64) generateAddVec() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(4+f.NbWords*2, 0, 0)
+	registers := f.FnHeader("addVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers & labels we need
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	addrRes := f.Pop(&registers)
+	n := f.Pop(&registers)
+
+	a := f.PopN(&registers)
+	t := f.PopN(&registers)
+
+	loop := f.NewLabel()
+	done := f.NewLabel()
+
+	// load arguments
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+
+	f.LABEL(loop)
+
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	// a = a + b
+	f.Mov(addrA, a)
+	f.Add(addrB, a)
+
+	// reduce a
+	f.ReduceElement(a, t)
+
+	// save a into res
+	f.Mov(a, addrRes)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(fmt.Sprintf("$%d", f.NbWords*8), addrA)
+	f.ADDQ(fmt.Sprintf("$%d", f.NbWords*8), addrB)
+	f.ADDQ(fmt.Sprintf("$%d", f.NbWords*8), addrRes)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.RET()
+
+	f.Push(&registers, a...)
+	f.Push(&registers, t...)
+	f.Push(&registers, addrA, addrB, addrRes, n)
+}
+
+// subVec res = a - b
+//
+//	func subVec(res, a, b *{{.ElementName}}, n uint64) {
+//		for i := 0; i < n; i++ {
+//			res[i].Sub(&a[i], &b[i])
+//		}
+//	}
+func (f *FFAmd64) generateSubVec() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(4+f.NbWords, 0, 0)
+	registers := f.FnHeader("subVec", stackSize, argSize)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	// registers & labels we need
+	addrA := f.Pop(&registers)
+	addrB := f.Pop(&registers)
+	addrRes := f.Pop(&registers)
+	n := f.Pop(&registers)
+
+	a := f.PopN(&registers)
+
+	loop := f.NewLabel()
+	noReduce := f.NewLabel()
+	done := f.NewLabel()
+
+	// load arguments
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", n)
+
+	f.LABEL(loop)
+
+	f.TESTQ(n, n)
+	f.JEQ(done, "n == 0, we are done")
+
+	// a = a - b
+	f.Mov(addrA, a)
+	f.Sub(addrB, a)
+
+	// if we borrowed, add q
+	f.JCC(noReduce)
+	for i := 0; i < f.NbWords; i++ {
+		if i == 0 {
+			f.ADDQ(f.qAt(i), a[i])
+		} else {
+			f.ADCQ(f.qAt(i), a[i])
+		}
+	}
+	f.LABEL(noReduce)
+
+	// save a into res
+	f.Mov(a, addrRes)
+
+	f.Comment("increment pointers to visit next element")
+	f.ADDQ(fmt.Sprintf("$%d", f.NbWords*8), addrA)
+	f.ADDQ(fmt.Sprintf("$%d", f.NbWords*8), addrB)
+	f.ADDQ(fmt.Sprintf("$%d", f.NbWords*8), addrRes)
+	f.DECQ(n, "decrement n")
+	f.JMP(loop)
+
+	f.LABEL(done)
+
+	f.RET()
+
+	f.Push(&registers, a...)
+	f.Push(&registers, addrA, addrB, addrRes, n)
+}
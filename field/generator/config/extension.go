@@ -1,6 +1,9 @@
 package config
 
-import "math/big"
+import (
+	"fmt"
+	"math/big"
+)
 
 type Element []big.Int
 
@@ -22,6 +25,82 @@ func NewTower(base *FieldConfig, degree uint8, rootOf int64) Extension {
 	return ret
 }
 
+// TowerExtension is the extension E[v]/(vᵈ-ξ) of a radical extension E, with d = 2 or 3 and ξ not a d-th power in E
+type TowerExtension struct {
+	Base       *Extension //E
+	Degree     int        //d
+	NonResidue Element    //ξ
+}
+
+// NewQuadraticExtension returns the extension E[v]/(v²-ξ) of base with ξ = Σ nonResidue[i]⋅uⁱ
+func NewQuadraticExtension(base *Extension, nonResidue ...int64) TowerExtension {
+	return newTowerExtension(base, 2, nonResidue)
+}
+
+// NewCubicExtension returns the extension E[v]/(v³-ξ) of base with ξ = Σ nonResidue[i]⋅uⁱ
+func NewCubicExtension(base *Extension, nonResidue ...int64) TowerExtension {
+	return newTowerExtension(base, 3, nonResidue)
+}
+
+func newTowerExtension(base *Extension, degree int, nonResidue []int64) TowerExtension {
+	ret := TowerExtension{
+		Base:       base,
+		Degree:     degree,
+		NonResidue: base.FromInt64(nonResidue...),
+	}
+	base.reduce(ret.NonResidue)
+	return ret
+}
+
+// FindRootOf returns a small α such that uⁿ-α is irreducible over base, trying -1, 2, -2, 3, -3, ... in that order.
+// It returns an error if n does not divide p-1, in which case no such α exists.
+func FindRootOf(base *FieldConfig, degree uint8) (int64, error) {
+	n := big.NewInt(int64(degree))
+	var pMinusOne, e, r big.Int
+	pMinusOne.Sub(base.ModulusBig, big.NewInt(1))
+	if degree < 2 || r.Mod(&pMinusOne, n).BitLen() != 0 {
+		return 0, fmt.Errorf("no radical extension of degree %d: %d does not divide p-1", degree, degree)
+	}
+	e.Div(&pMinusOne, n)
+
+	// uⁿ-α is irreducible iff α is not a n-th power (n prime), i.e. α^((p-1)/n) ≠ 1
+	for k := int64(1); k < 1<<16; k++ {
+		for _, alpha := range []int64{k, -k} {
+			if alpha == 1 {
+				continue
+			}
+			var a big.Int
+			a.SetInt64(alpha).Mod(&a, base.ModulusBig)
+			if a.BitLen() != 0 && a.Exp(&a, &e, base.ModulusBig).Cmp(big.NewInt(1)) != 0 {
+				return alpha, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("no small root found for a radical extension of degree %d", degree)
+}
+
+// FindNonResidue returns ξ = k+u with k ≥ 0 as small as possible, such that vᵈ-ξ is irreducible over f.
+// It returns an error if d does not divide q-1, in which case no such ξ exists.
+func (f *Extension) FindNonResidue(degree int) (Element, error) {
+	d := big.NewInt(int64(degree))
+	var qMinusOne, e, r big.Int
+	qMinusOne.Sub(&f.Size, big.NewInt(1))
+	if degree < 2 || r.Mod(&qMinusOne, d).BitLen() != 0 {
+		return nil, fmt.Errorf("no extension of degree %d of the degree %d extension", degree, f.Degree)
+	}
+	e.Div(&qMinusOne, d)
+
+	// vᵈ-ξ is irreducible iff ξ is not a d-th power (d prime), i.e. ξ^((q-1)/d) ≠ 1
+	one := f.FromInt64(1)
+	for k := int64(0); k < 1<<16; k++ {
+		xi := f.FromInt64(k, 1)
+		if !f.Equal(f.Exp(xi, &e), one) {
+			return xi, nil
+		}
+	}
+	return nil, fmt.Errorf("no small non-residue found for an extension of degree %d", degree)
+}
+
 func (f *Extension) FromInt64(i ...int64) Element {
 	z := make(Element, f.Degree)
 	for n := 0; n < len(i) && n < int(f.Degree); n++ {
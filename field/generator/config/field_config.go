@@ -72,6 +72,7 @@ type FieldConfig struct {
 	SqrtSMinusOneOver2Data    *addchain.AddChainData
 	SqrtQ3Mod4ExponentData    *addchain.AddChainData
 	UseAddChain               bool
+	ParallelImportPath        string // import path of the package providing parallel.Execute to Vector; if empty (default), Vector embeds its own copy
 }
 
 // NewFieldConfig returns a data structure with needed information to generate apis for field element
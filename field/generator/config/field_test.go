@@ -161,6 +161,41 @@ func TestExponentiationBls12381G2(t *testing.T) {
 	}
 }
 
+func TestFindNonResidue(t *testing.T) {
+	t.Parallel()
+
+	base, err := NewFieldConfig("dummyName", "dummyElement", "0x1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// p = 3 mod 4: -1 is not a square
+	if alpha, err := FindRootOf(base, 2); err != nil || alpha != -1 {
+		t.Fatalf("expected u²+1, got α = %d, err = %v", alpha, err)
+	}
+
+	e2 := NewTower(base, 2, -1)
+	xi, err := e2.FindNonResidue(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// v³-ξ is irreducible iff ξ^((q-1)/3) ≠ 1
+	var e big.Int
+	e.Sub(&e2.Size, big.NewInt(1)).Div(&e, big.NewInt(3))
+	if e2.Equal(e2.Exp(xi, &e), e2.FromInt64(1)) {
+		t.Fatal("ξ is a cube")
+	}
+
+	// 2⁶⁴-59 = 2 mod 3: there is no radical cubic extension
+	base, err = NewFieldConfig("dummyName", "dummyElement", "18446744073709551557", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FindRootOf(base, 3); err == nil {
+		t.Fatal("expected an error for a cubic extension with 3 ∤ p-1")
+	}
+}
+
 const minNbWords = 1
 const maxNbWords = 15
 
@@ -0,0 +1,400 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	"github.com/consensys/gnark-crypto/field/generator/internal/templates/extension"
+)
+
+// GenerateExtensions will generate in outputDir the go code for the radical extensions
+// 𝔽pⁿ = 𝔽p[u]/(uⁿ-α) of a field generated with GenerateFF, and for the quadratic and cubic extensions
+// 𝔽pᵈⁿ = 𝔽pⁿ[v]/(vᵈ-ξ) built on top of them.
+// basePackagePath is the import path of the base field package. Each extension of degree n
+// is generated as type En in files en.go and en_test.go; radical extensions of degree 2 and 3 are supported.
+// The package documentation and the shared test generators are written in doc.go and generators_test.go.
+//
+// Example usage
+//
+//	fp, _ := config.NewFieldConfig("goldilocks", "Element", "0xFFFFFFFF00000001", true)
+//	generator.GenerateFF(fp, "goldilocks")
+//	e2 := config.NewTower(fp, 2, 7)
+//	e4 := config.NewQuadraticExtension(&e2, 0, 1)
+//	generator.GenerateExtensions("github.com/consensys/gnark-crypto/field/goldilocks", filepath.Join("goldilocks", "extensions"), []config.Extension{e2}, e4)
+func GenerateExtensions(basePackagePath, outputDir string, extensions []config.Extension, towers ...config.TowerExtension) error {
+	if len(extensions) == 0 {
+		return errors.New("no extension to generate")
+	}
+	base := extensions[0].Base
+	packageName := filepath.Base(outputDir)
+
+	seen := make(map[int]bool)
+	data := extensionsTemplateData{
+		Extensions: make([]extensionTemplateData, len(extensions)),
+		Towers:     make([]towerTemplateData, len(towers)),
+	}
+	for i := range extensions {
+		ext := &extensions[i]
+		if ext.Base != base {
+			return errors.New("all extensions must have the same base field")
+		}
+		if seen[ext.Degree] {
+			return fmt.Errorf("extension of degree %d is defined twice", ext.Degree)
+		}
+		seen[ext.Degree] = true
+		d, err := newExtensionTemplateData(ext, basePackagePath, packageName)
+		if err != nil {
+			return err
+		}
+		data.Extensions[i] = d
+	}
+	for i := range towers {
+		var e *extensionTemplateData
+		for j := range extensions {
+			if extensions[j].Degree == towers[i].Base.Degree && extensions[j].RootOf == towers[i].Base.RootOf {
+				e = &data.Extensions[j]
+			}
+		}
+		if e == nil || towers[i].Base.Base != base {
+			return errors.New("the base of a tower extension must be one of the generated extensions")
+		}
+		degree := towers[i].Degree * e.Degree
+		if seen[degree] {
+			return fmt.Errorf("extension of degree %d is defined twice", degree)
+		}
+		seen[degree] = true
+		d, err := newTowerTemplateData(&towers[i], e)
+		if err != nil {
+			return err
+		}
+		data.Towers[i] = d
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	bavardOpts := []func(*bavard.Bavard) error{
+		bavard.Apache2("ConsenSys Software Inc.", 2020),
+		bavard.Package(packageName),
+		bavard.GeneratedBy("consensys/gnark-crypto"),
+	}
+
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "doc.go"), []string{extension.Doc}, data, bavardOpts...); err != nil {
+		return err
+	}
+
+	if err := bavard.GenerateFromString(filepath.Join(outputDir, "generators_test.go"), []string{extension.Generators}, data, bavardOpts...); err != nil {
+		return err
+	}
+
+	for _, d := range data.Extensions {
+		eName := strings.ToLower(d.ElementName)
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+".go"), []string{extension.Base}, d, bavardOpts...); err != nil {
+			return err
+		}
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+"_test.go"), []string{extension.Test}, d, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range data.Towers {
+		eName := strings.ToLower(d.ElementName)
+		tmpl, tmplTest := extension.Tower, extension.TowerTest
+		if d.TowerDegree == 3 {
+			tmpl, tmplTest = extension.Cubic, extension.CubicTest
+		}
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+".go"), []string{tmpl}, d, bavardOpts...); err != nil {
+			return err
+		}
+		if err := bavard.GenerateFromString(filepath.Join(outputDir, eName+"_test.go"), []string{tmplTest}, d, bavardOpts...); err != nil {
+			return err
+		}
+	}
+
+	// run go fmt on whole directory
+	cmd := exec.Command("gofmt", "-s", "-w", outputDir)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+type extensionsTemplateData struct {
+	Extensions []extensionTemplateData
+	Towers     []towerTemplateData
+}
+
+// HasCubic returns true if one of the towers is a cubic extension
+func (d extensionsTemplateData) HasCubic() bool {
+	for _, t := range d.Towers {
+		if t.TowerDegree == 3 {
+			return true
+		}
+	}
+	return false
+}
+
+type extensionTemplateData struct {
+	PackageName     string
+	BasePackagePath string
+	BasePackageName string
+	BaseElement     string // e.g. goldilocks.Element
+	ElementName     string // e.g. E2
+	Degree          int
+	RootOf          int64
+	Modulus         string // modulus of the base field, base10
+	Polynomial      string // uⁿ-α, e.g. u² - 7
+
+	ext *config.Extension
+
+	NonResidue            string   // α, as a BaseElement literal
+	FrobeniusCoefficients []string // γⁱ = α^(i(p-1)/n) for 1 ≤ i < n, as BaseElement literals
+
+	// Tonelli-Shanks constants, pⁿ-1 = 2ˢ⋅m with m odd
+	SqrtS            int
+	SqrtM            string   // m, base10
+	SqrtMPlusOneHalf string   // (m+1)/2, base10
+	SqrtG            []string // coordinates of gᵐ with g a quadratic non-residue, as BaseElement literals
+}
+
+func newExtensionTemplateData(ext *config.Extension, basePackagePath, packageName string) (extensionTemplateData, error) {
+	p := ext.Base.ModulusBig
+	n := big.NewInt(int64(ext.Degree))
+
+	if ext.Degree != 2 && ext.Degree != 3 {
+		return extensionTemplateData{}, fmt.Errorf("extension of degree %d is not supported", ext.Degree)
+	}
+
+	// uⁿ-α is irreducible iff n | p-1 and α is not a n-th power (n prime)
+	var pMinusOne, e, r big.Int
+	pMinusOne.Sub(p, big.NewInt(1))
+	if r.Mod(&pMinusOne, n).BitLen() != 0 {
+		return extensionTemplateData{}, fmt.Errorf("the degree %d does not divide p-1", ext.Degree)
+	}
+	var alpha big.Int
+	alpha.SetInt64(ext.RootOf).Mod(&alpha, p)
+	if alpha.BitLen() == 0 {
+		return extensionTemplateData{}, fmt.Errorf("u^%d - %d is not irreducible: %d = 0 mod p", ext.Degree, ext.RootOf, ext.RootOf)
+	}
+	var gamma big.Int
+	e.Div(&pMinusOne, n)
+	gamma.Exp(&alpha, &e, p)
+	if gamma.Cmp(big.NewInt(1)) == 0 {
+		return extensionTemplateData{}, fmt.Errorf("u^%d - %d is not irreducible", ext.Degree, ext.RootOf)
+	}
+
+	d := extensionTemplateData{
+		PackageName:     packageName,
+		BasePackagePath: basePackagePath,
+		BasePackageName: ext.Base.PackageName,
+		BaseElement:     ext.Base.PackageName + "." + ext.Base.ElementName,
+		ElementName:     fmt.Sprintf("E%d", ext.Degree),
+		Degree:          ext.Degree,
+		RootOf:          ext.RootOf,
+		Modulus:         p.String(),
+		ext:             ext,
+	}
+	if ext.RootOf < 0 {
+		d.Polynomial = fmt.Sprintf("u%s + %d", superscript(ext.Degree), -ext.RootOf)
+	} else {
+		d.Polynomial = fmt.Sprintf("u%s - %d", superscript(ext.Degree), ext.RootOf)
+	}
+	d.NonResidue = d.baseLiteral(ext.Base, &alpha)
+
+	// Frobenius: (Σ aᵢuⁱ)ᵖ = Σ aᵢγⁱuⁱ
+	var gammaI big.Int
+	gammaI.Set(&gamma)
+	for i := 1; i < ext.Degree; i++ {
+		d.FrobeniusCoefficients = append(d.FrobeniusCoefficients, d.baseLiteral(ext.Base, &gammaI))
+		gammaI.Mul(&gammaI, &gamma).Mod(&gammaI, p)
+	}
+
+	// Tonelli-Shanks
+	var qMinusOne, m, legendreExp big.Int
+	qMinusOne.Sub(&ext.Size, big.NewInt(1))
+	d.SqrtS = int(qMinusOne.TrailingZeroBits())
+	m.Rsh(&qMinusOne, uint(d.SqrtS))
+	d.SqrtM = m.String()
+	d.SqrtMPlusOneHalf = new(big.Int).Rsh(new(big.Int).Add(&m, big.NewInt(1)), 1).String()
+	legendreExp.Rsh(&qMinusOne, 1)
+
+	// look for a quadratic non-residue of the form u+k
+	minusOne := ext.FromInt64(-1)
+	for i := range minusOne {
+		minusOne[i].Mod(&minusOne[i], p)
+	}
+	var g config.Element
+	for k := int64(0); g == nil; k++ {
+		c := ext.FromInt64(k, 1)
+		if ext.Equal(ext.Exp(c, &legendreExp), minusOne) {
+			g = ext.Exp(c, &m)
+		}
+	}
+	for i := range g {
+		d.SqrtG = append(d.SqrtG, d.baseLiteral(ext.Base, &g[i]))
+	}
+
+	return d, nil
+}
+
+// baseLiteral returns the go literal of x (in regular form) as a Montgomery base field element
+func (d *extensionTemplateData) baseLiteral(base *config.FieldConfig, x *big.Int) string {
+	var builder strings.Builder
+	builder.WriteString(d.BaseElement)
+	builder.WriteString("{")
+	mont := base.ToMont(*x)
+	bavard.WriteBigIntAsUint64Slice(&builder, &mont)
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// extensionLiteral returns the go literal of x (in regular form) as an element of the extension
+func (d *extensionTemplateData) extensionLiteral(x config.Element) string {
+	var builder strings.Builder
+	builder.WriteString(d.ElementName)
+	builder.WriteString("{")
+	for i := range x {
+		if i != 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprintf("A%d: ", i))
+		builder.WriteString(d.baseLiteral(d.ext.Base, &x[i]))
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+type towerTemplateData struct {
+	PackageName     string
+	BasePackagePath string
+	ElementName     string // e.g. E4
+	BaseName        string // e.g. E2
+	BaseDegree      int
+	TowerDegree     int // d, 2 or 3
+	Degree          int
+	Modulus         string // modulus of the base field, base10
+
+	NonResidue       string // ξ, as a BaseName literal
+	NonResidueIsU    bool   // ξ = u, multiplication by ξ is then MulByNonResidue
+	NonResidueString string // ξ as a polynomial in u, e.g. 2+u
+
+	// quadratic towers: v^(p-1) = ξ^((p-1)/2), as a BaseName literal
+	FrobeniusCoefficient string
+
+	// cubic towers: vᵖ = γ₁⋅v^(p mod 3) and v²ᵖ = γ₂⋅v^(2p mod 3), as BaseName literals.
+	// If p = 2 mod 3, FrobeniusSwap is set and the Frobenius map exchanges the coordinates of v and v².
+	FrobeniusCoefficients [2]string
+	FrobeniusSwap         bool
+}
+
+// V returns vᵈ
+func (d towerTemplateData) V() string {
+	return "v" + superscript(d.TowerDegree)
+}
+
+func newTowerTemplateData(tower *config.TowerExtension, base *extensionTemplateData) (towerTemplateData, error) {
+	ext := tower.Base
+	p := ext.Base.ModulusBig
+	if len(tower.NonResidue) != ext.Degree {
+		return towerTemplateData{}, errors.New("the non-residue of a tower extension must be an element of its base")
+	}
+	if tower.Degree != 2 && tower.Degree != 3 {
+		return towerTemplateData{}, fmt.Errorf("tower extension of degree %d is not supported", tower.Degree)
+	}
+
+	// vᵈ-ξ is irreducible iff d | q-1 and ξ is not a d-th power (d prime)
+	var e, r big.Int
+	d := big.NewInt(int64(tower.Degree))
+	e.Sub(&ext.Size, big.NewInt(1))
+	if r.Mod(&e, d).BitLen() != 0 {
+		return towerTemplateData{}, fmt.Errorf("the degree %d does not divide |%s|-1", tower.Degree, base.ElementName)
+	}
+	e.Div(&e, d)
+	if ext.IsZero(tower.NonResidue) || ext.Equal(ext.Exp(tower.NonResidue, &e), ext.FromInt64(1)) {
+		return towerTemplateData{}, fmt.Errorf("the non-residue of the extension of degree %d of %s is a %d-th power", tower.Degree, base.ElementName, tower.Degree)
+	}
+
+	data := towerTemplateData{
+		PackageName:     base.PackageName,
+		BasePackagePath: base.BasePackagePath,
+		ElementName:     fmt.Sprintf("E%d", tower.Degree*ext.Degree),
+		BaseName:        base.ElementName,
+		BaseDegree:      ext.Degree,
+		TowerDegree:     tower.Degree,
+		Degree:          tower.Degree * ext.Degree,
+		Modulus:         base.Modulus,
+		NonResidue:      base.extensionLiteral(tower.NonResidue),
+	}
+	data.NonResidueIsU = ext.Equal(tower.NonResidue, ext.FromInt64(0, 1))
+
+	// ξ as a polynomial in u, with small negative coefficients written as such
+	var half big.Int
+	half.Rsh(p, 1)
+	var terms []string
+	for i := range tower.NonResidue {
+		var c big.Int
+		c.Set(&tower.NonResidue[i])
+		if c.BitLen() == 0 {
+			continue
+		}
+		if c.Cmp(&half) > 0 {
+			c.Sub(&c, p)
+		}
+		var t string
+		switch {
+		case i == 0:
+			t = c.String()
+		case c.IsInt64() && c.Int64() == 1:
+			t = "u" + superscript(i)
+		case c.IsInt64() && c.Int64() == -1:
+			t = "-u" + superscript(i)
+		default:
+			t = c.String() + "u" + superscript(i)
+		}
+		if len(terms) != 0 && t[0] != '-' {
+			t = "+" + t
+		}
+		terms = append(terms, t)
+	}
+	data.NonResidueString = strings.Join(terms, "")
+
+	if tower.Degree == 2 {
+		// Frobenius: (b0 + b1v)ᵖ = b0ᵖ + b1ᵖ⋅ξ^((p-1)/2)⋅v
+		e.Sub(p, big.NewInt(1)).Rsh(&e, 1)
+		data.FrobeniusCoefficient = base.extensionLiteral(ext.Exp(tower.NonResidue, &e))
+		return data, nil
+	}
+
+	// Frobenius: writing ip = 3k + r with r ∈ {1, 2}, v^(ip) = ξᵏ⋅vʳ
+	for i := 1; i <= 2; i++ {
+		var ip, k big.Int
+		ip.Mul(p, big.NewInt(int64(i)))
+		k.DivMod(&ip, big.NewInt(3), &r)
+		data.FrobeniusCoefficients[i-1] = base.extensionLiteral(ext.Exp(tower.NonResidue, &k))
+		if i == 1 {
+			data.FrobeniusSwap = r.Int64() == 2
+		}
+	}
+
+	return data, nil
+}
+
+// superscript returns i as a superscript, or an empty string if i = 1
+func superscript(i int) string {
+	if i == 1 {
+		return ""
+	}
+	const digits = "⁰¹²³⁴⁵⁶⁷⁸⁹"
+	var sb strings.Builder
+	for _, c := range fmt.Sprint(i) {
+		sb.WriteString(string([]rune(digits)[c-'0']))
+	}
+	return sb.String()
+}
@@ -34,6 +34,7 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 		element.MulNoCarry,
 		element.Sqrt,
 		element.Inverse,
+		element.ConstantTime,
 		element.BigNum,
 	}
 
@@ -82,6 +83,9 @@ func GenerateFF(F *config.FieldConfig, outputDir string) error {
 	}
 
 	funcs["shorten"] = shorten
+	funcs["maxNbWordsVec"] = func() int {
+		return amd64.MaxNbWordsVec
+	}
 	funcs["ltu64"] = func(a, b uint64) bool {
 		return a < b
 	}
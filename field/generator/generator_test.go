@@ -100,3 +100,27 @@ func TestIntegration(t *testing.T) {
 	}
 
 }
+
+func TestExtensionNonResidue(t *testing.T) {
+	t.Parallel()
+
+	// p = 2⁶¹-1
+	const p = 2305843009213693951
+	base, err := field.NewFieldConfig("dummyName", "dummyElement", fmt.Sprint(p), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alpha := range []int64{p, -p, 2 * p} {
+		ext := field.NewTower(base, 2, alpha)
+		if _, err := newExtensionTemplateData(&ext, "", "dummy"); err == nil {
+			t.Fatalf("expected an error for α = %d = 0 mod p", alpha)
+		}
+	}
+
+	// -1 is not a square since p = 3 mod 4
+	ext := field.NewTower(base, 2, -1)
+	if _, err := newExtensionTemplateData(&ext, "", "dummy"); err != nil {
+		t.Fatal(err)
+	}
+}
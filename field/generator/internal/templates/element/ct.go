@@ -0,0 +1,224 @@
+package element
+
+const ConstantTime = `
+
+{{/* constant-time variants of Exp, Inverse and Sqrt, for secret-dependent inputs */}}
+
+var (
+	_bInverseCTExponent{{.ElementName}} *big.Int
+	_bSqrtCTExponent{{.ElementName}} *big.Int
+)
+
+func init() {
+	// q-2
+	_bInverseCTExponent{{.ElementName}} = Modulus()
+	_bInverseCTExponent{{.ElementName}}.Sub(_bInverseCTExponent{{.ElementName}}, big.NewInt(2))
+
+	{{- if .SqrtQ3Mod4}}
+	const sqrtCTExponent{{.ElementName}} = "{{.SqrtQ3Mod4Exponent}}"
+	{{- else if .SqrtAtkin}}
+	const sqrtCTExponent{{.ElementName}} = "{{.SqrtAtkinExponent}}"
+	{{- else if .SqrtTonelliShanks}}
+	const sqrtCTExponent{{.ElementName}} = "{{.SqrtSMinusOneOver2}}"
+	{{- end }}
+	_bSqrtCTExponent{{.ElementName}}, _ = new(big.Int).SetString(sqrtCTExponent{{.ElementName}}, 16)
+}
+
+// MulCT z = x * y (mod q) in constant time.
+// Mul may branch on the result of the Montgomery reduction (except in the amd64 assembly);
+// MulCT performs the final subtraction without branching.
+func (z *{{.ElementName}}) MulCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	{{- template "mul_cios_loop" dict "all" . "V1" "x" "V2" "y" }}
+
+	// t < 2q, so z = t - q if t ⩾ q, else t
+	var b uint64
+	z[0], b = bits.Sub64(t[0], q0, 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	z[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}, b)
+	{{- end}}
+	_, b = bits.Sub64(t[{{.NbWords}}], 0, b)
+
+	// mask is all ones if t < q
+	mask := -b
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}] = t[{{$i}}]&mask | z[{{$i}}]&^mask
+	{{- end}}
+	return z
+}
+
+// AddCT z = x + y (mod q) in constant time
+func (z *{{.ElementName}}) AddCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var t [{{.NbWords}}]uint64
+	var carry uint64
+	t[0], carry = bits.Add64(x[0], y[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	t[{{$i}}], carry = bits.Add64(x[{{$i}}], y[{{$i}}], carry)
+	{{- end}}
+
+	// z = t - q if t ⩾ q, else t
+	var b uint64
+	z[0], b = bits.Sub64(t[0], q0, 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	z[{{$i}}], b = bits.Sub64(t[{{$i}}], q{{$i}}, b)
+	{{- end}}
+	_, b = bits.Sub64(carry, 0, b)
+
+	// mask is all ones if t < q
+	mask := -b
+	{{- range $i := .NbWordsIndexesFull}}
+	z[{{$i}}] = t[{{$i}}]&mask | z[{{$i}}]&^mask
+	{{- end}}
+	return z
+}
+
+// SubCT z = x - y (mod q) in constant time
+func (z *{{.ElementName}}) SubCT(x, y *{{.ElementName}}) *{{.ElementName}} {
+	var b uint64
+	z[0], b = bits.Sub64(x[0], y[0], 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	z[{{$i}}], b = bits.Sub64(x[{{$i}}], y[{{$i}}], b)
+	{{- end}}
+
+	// if x < y, add q back
+	mask := -b
+	{{- if eq .NbWords 1}}
+	z[0] += q0 & mask
+	{{- else}}
+	var c uint64
+	z[0], c = bits.Add64(z[0], q0&mask, 0)
+	{{- range $i := .NbWordsIndexesNoZero}}
+	{{- if eq $i $.NbWordsLastIndex}}
+	z[{{$i}}], _ = bits.Add64(z[{{$i}}], q{{$i}}&mask, c)
+	{{- else}}
+	z[{{$i}}], c = bits.Add64(z[{{$i}}], q{{$i}}&mask, c)
+	{{- end}}
+	{{- end}}
+	{{- end}}
+	return z
+}
+
+// ExpCT z = xᵏ (mod q) in constant time: the sequence of operations and memory accesses
+// depends on max(Bytes, ⌈k.BitLen()/8⌉), but not on the values of x and k.
+//
+// If k < 0, x is inverted with InverseCT; the sign of k is not hidden.
+func (z *{{.ElementName}}) ExpCT(x {{.ElementName}}, k *big.Int) *{{.ElementName}} {
+	if k.Sign() == -1 {
+		// if k < 0: xᵏ (mod q) == (x⁻¹)ᵏ (mod q)
+		x.InverseCT(&x)
+	}
+
+	// fixed window of 4 bits: table[i] = xⁱ
+	const w = 4
+	var table [1 << w]{{.ElementName}}
+	table[0].SetOne()
+	table[1] = x
+	for i := 2; i < len(table); i++ {
+		table[i].MulCT(&table[i-1], &x)
+	}
+
+	// the bits of |k| are read from a fixed-size big-endian buffer, as big.Int.Bit branches on the length of k
+	nbBytes := Bytes
+	if l := (k.BitLen() + 7) / 8; l > nbBytes {
+		nbBytes = l
+	}
+	e := k.FillBytes(make([]byte, nbBytes))
+
+	var res, t {{.ElementName}}
+	res.SetOne()
+	for i := 0; i < 2*nbBytes; i++ {
+		for j := 0; j < w; j++ {
+			res.MulCT(&res, &res)
+		}
+		// d is the i-th window of 4 bits, from the most significant one
+		d := uint(e[i/2]>>(w*(1-i%2))) & (1<<w - 1)
+		// t = table[d], scanning the whole table
+		for j := range table {
+			t.Select(int(uint(j)^d), &table[j], &t)
+		}
+		res.MulCT(&res, &t)
+	}
+
+	return z.Set(&res)
+}
+
+// InverseCT z = x⁻¹ (mod q) in constant time, as x^(q-2).
+// It is slower than Inverse, whose running time depends on x.
+//
+// if x == 0, sets and returns z = x
+func (z *{{.ElementName}}) InverseCT(x *{{.ElementName}}) *{{.ElementName}} {
+	return z.ExpCT(*x, _bInverseCTExponent{{.ElementName}})
+}
+
+// SqrtCT z = √x (mod q) in constant time: the sequence of operations doesn't depend on x.
+// if the square root doesn't exist (x is not a square mod q)
+// SqrtCT leaves z unchanged and returns nil; whether x is a square is not hidden.
+func (z *{{.ElementName}}) SqrtCT(x *{{.ElementName}}) *{{.ElementName}} {
+	{{- if .SqrtQ3Mod4}}
+	// q ≡ 3 (mod 4)
+	// using  z ≡ ± x^((p+1)/4) (mod q)
+	var y {{.ElementName}}
+	y.ExpCT(*x, _bSqrtCTExponent{{.ElementName}})
+	{{- else if .SqrtAtkin}}
+	// q ≡ 5 (mod 8)
+	// see modSqrt5Mod8Prime in math/big/int.go
+	var one, two, alpha, tx, y {{.ElementName}}
+	one.SetOne()
+	two.SetUint64(2)
+	tx.MulCT(x, &two)
+	alpha.ExpCT(tx, _bSqrtCTExponent{{.ElementName}})
+	y.MulCT(&alpha, &alpha)
+	y.MulCT(&y, &tx)
+	y.SubCT(&y, &one)
+	y.MulCT(&y, x)
+	y.MulCT(&y, &alpha)
+	{{- else if .SqrtTonelliShanks}}
+	// q ≡ 1 (mod 4)
+	// constant-time Tonelli-Shanks, see https://www.rfc-editor.org/rfc/rfc9380#appendix-I.4
+	var y, w, t, b, c, tv, one {{.ElementName}}
+	one.SetOne()
+
+	// w = x^((s-1)/2))
+	w.ExpCT(*x, _bSqrtCTExponent{{.ElementName}})
+
+	// t = xˢ = w * w * x
+	t.MulCT(&w, &w).MulCT(&t, x)
+
+	// y = x^((s+1)/2)) = w * x
+	y.MulCT(&w, x)
+
+	// c = nonResidue ^ s
+	c = {{.ElementName}}{
+		{{- range $i := .SqrtG}}
+		{{$i}},{{end}}
+	}
+
+	for i := {{.SqrtE}}; i >= 2; i-- {
+		b = t
+		for j := 1; j < i-1; j++ {
+			b.MulCT(&b, &b)
+		}
+		// if b != 1, y = y * c and t = t * c²
+		notOne := int(b.NotEqual(&one))
+		tv.MulCT(&y, &c)
+		y.Select(notOne, &y, &tv)
+		c.MulCT(&c, &c)
+		tv.MulCT(&t, &c)
+		t.Select(notOne, &t, &tv)
+	}
+	{{- else}}
+	panic("not implemented")
+	{{- end}}
+
+	{{- if or .SqrtQ3Mod4 .SqrtAtkin .SqrtTonelliShanks}}
+
+	// ensure we found y such that y * y = x
+	var square {{.ElementName}}
+	square.MulCT(&y, &y)
+	if square.Equal(x) {
+		return z.Set(&y)
+	}
+	return nil
+	{{- end}}
+}
+
+`
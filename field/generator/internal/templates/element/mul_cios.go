@@ -35,7 +35,7 @@ package element
 //
 // The same (arm64) unrolled Go code produce satisfying perfomrance for WASM (compiled using TinyGo).
 const MulCIOS = `
-{{ define "mul_cios" }}
+{{ define "mul_cios_loop" }}
 	var t [{{add .all.NbWords 1}}]uint64
 	var D uint64
 	var m, C uint64
@@ -69,7 +69,10 @@ const MulCIOS = `
 		 t[{{sub $.all.NbWords 1}}], C = bits.Add64(t[{{$.all.NbWords}}], C, 0)
 		 t[{{$.all.NbWords}}], _ = bits.Add64(0, D, C)
 	{{- end}}
+{{ end }}
 
+{{ define "mul_cios" }}
+	{{ template "mul_cios_loop" . }}
 
 	if t[{{$.all.NbWords}}] != 0 {
 		// we need to reduce, we have a result on {{add 1 $.all.NbWords}} words
@@ -29,6 +29,48 @@ func reduce(res *{{.ElementName}})
 //go:noescape
 func Butterfly(a, b *{{.ElementName}})
 
+{{- if le .NbWords maxNbWordsVec}}
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+// vectorAdd res = a + b, element-wise
+func vectorAdd(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	if len(a) == 0 {
+		return
+	}
+	addVec(&res[0], &a[0], &b[0], uint64(len(a)))
+}
+
+// vectorSub res = a - b, element-wise
+func vectorSub(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	if len(a) == 0 {
+		return
+	}
+	subVec(&res[0], &a[0], &b[0], uint64(len(a)))
+}
+{{- else}}
+
+// vectorAdd res = a + b, element-wise
+func vectorAdd(res, a, b Vector) {
+	addVecGeneric(res, a, b)
+}
+
+// vectorSub res = a - b, element-wise
+func vectorSub(res, a, b Vector) {
+	subVecGeneric(res, a, b)
+}
+{{- end}}
+
 
 
 // Mul z = x * y (mod q)
@@ -98,6 +98,18 @@ func Benchmark{{toTitle .ElementName}}Inverse(b *testing.B) {
 
 }
 
+func Benchmark{{toTitle .ElementName}}InverseCT(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.InverseCT(&x)
+	}
+
+}
+
 func Benchmark{{toTitle .ElementName}}Butterfly(b *testing.B) {
 	var x {{.ElementName}}
 	x.SetRandom()
@@ -120,6 +132,17 @@ func Benchmark{{toTitle .ElementName}}Exp(b *testing.B) {
 	}
 }
 
+func Benchmark{{toTitle .ElementName}}ExpCT(b *testing.B) {
+	var x {{.ElementName}}
+	x.SetRandom()
+	benchRes{{.ElementName}}.SetRandom()
+	b1, _ := rand.Int(rand.Reader, Modulus())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.ExpCT(x, b1)
+	}
+}
+
 
 func Benchmark{{toTitle .ElementName}}Double(b *testing.B) {
 	benchRes{{.ElementName}}.SetRandom()
@@ -195,6 +218,16 @@ func Benchmark{{toTitle .ElementName}}Sqrt(b *testing.B) {
 	}
 }
 
+func Benchmark{{toTitle .ElementName}}SqrtCT(b *testing.B) {
+	var a {{.ElementName}}
+	a.SetUint64(4)
+	a.Neg(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchRes{{.ElementName}}.SqrtCT(&a)
+	}
+}
+
 func Benchmark{{toTitle .ElementName}}Mul(b *testing.B) {
 	x := {{.ElementName}}{
 		{{- range $i := .RSquare}}
@@ -731,12 +764,18 @@ func Test{{toTitle .ElementName}}LexicographicallyLargest(t *testing.T) {
 {{template "testBinaryOp" dict "all" . "Op" "Add"}}
 {{template "testBinaryOp" dict "all" . "Op" "Sub"}}
 {{template "testBinaryOp" dict "all" . "Op" "Mul" "GenericOp" "_mulGeneric"}}
+{{template "testBinaryOp" dict "all" . "Op" "AddCT" "BigOp" "Add"}}
+{{template "testBinaryOp" dict "all" . "Op" "SubCT" "BigOp" "Sub"}}
+{{template "testBinaryOp" dict "all" . "Op" "MulCT" "BigOp" "Mul" "GenericOp" "_mulGeneric"}}
 {{template "testBinaryOp" dict "all" . "Op" "Div"}}
 {{template "testBinaryOp" dict "all" . "Op" "Exp"}}
+{{template "testBinaryOp" dict "all" . "Op" "ExpCT"}}
 
 {{template "testUnaryOp" dict "all" . "Op" "Square" }}
 {{template "testUnaryOp" dict "all" . "Op" "Inverse"}}
+{{template "testUnaryOp" dict "all" . "Op" "InverseCT"}}
 {{template "testUnaryOp" dict "all" . "Op" "Sqrt"}}
+{{template "testUnaryOp" dict "all" . "Op" "SqrtCT"}}
 {{template "testUnaryOp" dict "all" . "Op" "Double"}}
 {{template "testUnaryOp" dict "all" . "Op" "Neg" }}
 
@@ -761,7 +800,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 		func(a, b testPair{{.all.ElementName}}) bool {
 			var c, d {{.all.ElementName}}
 			d.Set(&a.element)
-			{{if eq .Op "Exp"}}
+			{{if or (eq .Op "Exp") (eq .Op "ExpCT")}}
 				c.{{.Op}}(a.element, &b.bigint)
 				a.element.{{.Op}}(a.element, &b.bigint)
 				b.element.{{.Op}}(d, &b.bigint)
@@ -780,7 +819,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 		func(a, b testPair{{.all.ElementName}}) bool {
 			{
 				var c {{.all.ElementName}}
-				{{if eq .Op "Exp"}}
+				{{if or (eq .Op "Exp") (eq .Op "ExpCT")}}
 					c.{{.Op}}(a.element, &b.bigint)
 				{{else}}
 					c.{{.Op}}(&a.element, &b.element)
@@ -789,10 +828,10 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 				{{- if eq .Op "Div"}}
 					d.ModInverse(&b.bigint, Modulus())
 					d.Mul(&d, &a.bigint).Mod(&d, Modulus())
-				{{- else if eq .Op "Exp"}}
+				{{- else if or (eq .Op "Exp") (eq .Op "ExpCT")}}
 					d.Exp(&a.bigint, &b.bigint, Modulus())
 				{{- else}}
-					d.{{.Op}}(&a.bigint, &b.bigint).Mod(&d, Modulus())
+					d.{{or .BigOp .Op}}(&a.bigint, &b.bigint).Mod(&d, Modulus())
 				{{- end }}
 
 
@@ -816,12 +855,12 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 					c.{{.Op}}(&a.element, &r)
 					d.ModInverse(&rb, Modulus())
 					d.Mul(&d, &a.bigint).Mod(&d, Modulus())
-				{{- else if eq .Op "Exp"}}
+				{{- else if or (eq .Op "Exp") (eq .Op "ExpCT")}}
 					c.{{.Op}}(a.element, &rb)
 					d.Exp(&a.bigint, &rb, Modulus())
 				{{- else}}
 					c.{{.Op}}(&a.element, &r)
-					d.{{.Op}}(&a.bigint, &rb).Mod(&d, Modulus())
+					d.{{or .BigOp .Op}}(&a.bigint, &rb).Mod(&d, Modulus())
 				{{- end }}
 
 				{{if .GenericOp}}
@@ -847,7 +886,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 	properties.Property("{{.Op}}: operation result must be smaller than modulus", prop.ForAll(
 		func(a, b testPair{{.all.ElementName}}) bool {
 			var c {{.all.ElementName}}
-			{{if eq .Op "Exp"}}
+			{{if or (eq .Op "Exp") (eq .Op "ExpCT")}}
 				c.{{.Op}}(a.element, &b.bigint)
 			{{else}}
 				c.{{.Op}}(&a.element, &b.element)
@@ -894,12 +933,12 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 					c.{{.Op}}(&a, &b)
 					d.ModInverse(&bBig, Modulus())
 					d.Mul(&d, &aBig).Mod(&d, Modulus())
-				{{- else if eq .Op "Exp"}}
+				{{- else if or (eq .Op "Exp") (eq .Op "ExpCT")}}
 					c.{{.Op}}(a, &bBig)
 					d.Exp(&aBig, &bBig, Modulus())
 				{{- else}}
 					c.{{.Op}}(&a, &b)
-					d.{{.Op}}(&aBig, &bBig).Mod(&d, Modulus())
+					d.{{or .BigOp .Op}}(&aBig, &bBig).Mod(&d, Modulus())
 				{{- end }}
 	
 				{{if .GenericOp}}
@@ -945,7 +984,7 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 
 	properties.Property("{{.Op}}: having the receiver as operand should output the same result", prop.ForAll(
 		func(a testPair{{.all.ElementName}}) bool {
-			{{if eq .Op "Sqrt"}}
+			{{if or (eq .Op "Sqrt") (eq .Op "SqrtCT")}}
 			b := a.element
 			{{else}}
 			var b {{.all.ElementName}}
@@ -965,16 +1004,21 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 			var d, e big.Int 
 			{{- if eq .Op "Square"}}
 				d.Mul(&a.bigint, &a.bigint).Mod(&d, Modulus())
-			{{- else if eq .Op "Inverse"}}
+			{{- else if or (eq .Op "Inverse") (eq .Op "InverseCT")}}
 				d.ModInverse(&a.bigint, Modulus())
-			{{- else if eq .Op "Sqrt"}}
+			{{- else if or (eq .Op "Sqrt") (eq .Op "SqrtCT")}}
 				d.ModSqrt(&a.bigint, Modulus())
 			{{- else if eq .Op "Double"}}
 				d.Lsh(&a.bigint, 1).Mod(&d, Modulus())
 			{{- else if eq .Op "Neg"}}
 				d.Neg(&a.bigint).Mod(&d, Modulus())
 			{{- end }}
-
+			{{- if eq .Op "SqrtCT"}}
+			// the root may differ from the one of big.Int by its sign
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				c.Neg(&c)
+			}
+			{{- end}}
 
 			return c.BigInt(&e).Cmp(&d) == 0
 		},
@@ -1018,9 +1062,9 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 			var  d, e big.Int 
 			{{- if eq .Op "Square"}}
 				d.Mul(&aBig, &aBig).Mod(&d, Modulus())
-			{{- else if eq .Op "Inverse"}}
+			{{- else if or (eq .Op "Inverse") (eq .Op "InverseCT")}}
 				d.ModInverse(&aBig, Modulus())
-			{{- else if eq .Op "Sqrt"}}
+			{{- else if or (eq .Op "Sqrt") (eq .Op "SqrtCT")}}
 				d.ModSqrt(&aBig, Modulus())
 			{{- else if eq .Op "Double"}}
 				d.Lsh(&aBig, 1).Mod(&d, Modulus())
@@ -1028,6 +1072,13 @@ func Test{{toTitle .all.ElementName}}{{.Op}}(t *testing.T) {
 				d.Neg(&aBig).Mod(&d, Modulus())
 			{{- end }}
 
+			{{- if eq .Op "SqrtCT"}}
+			// the root may differ from the one of big.Int by its sign
+			if c.BigInt(&e).Cmp(&d) != 0 {
+				c.Neg(&c)
+			}
+			{{- end}}
+
 			{{if .GenericOp}}
 				// checking asm against generic impl
 				var cGeneric {{.all.ElementName}}
@@ -6,8 +6,18 @@ import (
 	"encoding/binary"
 	"strings"
 	"bytes"
+	"sync"
+	{{- if .ParallelImportPath}}
+
+	"{{.ParallelImportPath}}"
+	{{- else}}
+	"runtime"
+	{{- end}}
 )
 
+{{- $execute := "parallel.Execute"}}
+{{- if not .ParallelImportPath}}{{$execute = "parallelExecute"}}{{end}}
+
 // Vector represents a slice of {{.ElementName}}.
 // 
 // It implements the following interfaces:
@@ -17,6 +27,9 @@ import (
 //	- encoding.BinaryMarshaler
 //	- encoding.BinaryUnmarshaler
 //	- sort.Interface
+//
+// It also provides element-wise arithmetic (Add, Sub, Mul, ScalarMul) and reductions (Sum, InnerProduct).
+// These take an optional number of tasks to split the work across goroutines, runtime.NumCPU() by default.
 type Vector []{{.ElementName}}
 
 // MarshalBinary implements encoding.BinaryMarshaler
@@ -118,4 +131,188 @@ func (vector Vector) Swap(i, j int) {
 	vector[i], vector[j] = vector[j], vector[i]
 }
 
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector, nbTasks ...int) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	res := *vector
+	{{$execute}}(len(a), func(start, end int) {
+		vectorAdd(res[start:end], a[start:end], b[start:end])
+	}, nbTasks...)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector, nbTasks ...int) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	res := *vector
+	{{$execute}}(len(a), func(start, end int) {
+		vectorSub(res[start:end], a[start:end], b[start:end])
+	}, nbTasks...)
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector, nbTasks ...int) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	res := *vector
+	{{$execute}}(len(a), func(start, end int) {
+		mulVecGeneric(res[start:end], a[start:end], b[start:end])
+	}, nbTasks...)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}, nbTasks ...int) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	res := *vector
+	{{$execute}}(len(a), func(start, end int) {
+		scalarMulVecGeneric(res[start:end], a[start:end], b)
+	}, nbTasks...)
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector Vector) Sum(nbTasks ...int) (res {{.ElementName}}) {
+	var lock sync.Mutex
+	{{$execute}}(len(vector), func(start, end int) {
+		partial := sumVecGeneric(vector[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, nbTasks...)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector Vector) InnerProduct(other Vector, nbTasks ...int) (res {{.ElementName}}) {
+	if len(vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var lock sync.Mutex
+	{{$execute}}(len(vector), func(start, end int) {
+		partial := innerProductVecGeneric(vector[start:end], other[start:end])
+		lock.Lock()
+		res.Add(&res, &partial)
+		lock.Unlock()
+	}, nbTasks...)
+	return
+}
+
+func addVecGeneric(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Add(&a[i], &b[i])
+	}
+}
+
+func subVecGeneric(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Sub(&a[i], &b[i])
+	}
+}
+
+func mulVecGeneric(res, a, b Vector) {
+	if len(a) != len(b) || len(a) != len(res) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a Vector, b *{{.ElementName}}) {
+	if len(a) != len(res) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		res[i].Mul(&a[i], b)
+	}
+}
+
+func sumVecGeneric(v Vector) (res {{.ElementName}}) {
+	for i := 0; i < len(v); i++ {
+		res.Add(&res, &v[i])
+	}
+	return
+}
+
+func innerProductVecGeneric(a, b Vector) (res {{.ElementName}}) {
+	if len(a) != len(b) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp {{.ElementName}}
+	for i := 0; i < len(a); i++ {
+		tmp.Mul(&a[i], &b[i])
+		res.Add(&res, &tmp)
+	}
+	return
+}
+{{- if not .ParallelImportPath}}
+
+// parallelExecute is a copy of gnark-crypto's internal/parallel.Execute, which packages generated
+// outside of gnark-crypto can't import.
+func parallelExecute(nbIterations int, work func(int, int), maxCpus ...int) {
+
+	nbTasks := runtime.NumCPU()
+	if len(maxCpus) == 1 {
+		nbTasks = maxCpus[0]
+		if nbTasks < 1 {
+			nbTasks = 1
+		} else if nbTasks > 512 {
+			nbTasks = 512
+		}
+	}
+
+	if nbTasks == 1 {
+		// no go routines
+		work(0, nbIterations)
+		return
+	}
+
+	nbIterationsPerCpus := nbIterations / nbTasks
+
+	// more CPUs than tasks: a CPU will work on exactly one iteration
+	if nbIterationsPerCpus < 1 {
+		nbIterationsPerCpus = 1
+		nbTasks = nbIterations
+	}
+
+	var wg sync.WaitGroup
+
+	extraTasks := nbIterations - (nbTasks * nbIterationsPerCpus)
+	extraTasksOffset := 0
+
+	for i := 0; i < nbTasks; i++ {
+		wg.Add(1)
+		_start := i*nbIterationsPerCpus + extraTasksOffset
+		_end := _start + nbIterationsPerCpus
+		if extraTasks > 0 {
+			_end++
+			extraTasks--
+			extraTasksOffset++
+		}
+		go func() {
+			work(_start, _end)
+			wg.Done()
+		}()
+	}
+
+	wg.Wait()
+}
+{{- end}}
+
 `
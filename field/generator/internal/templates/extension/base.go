@@ -0,0 +1,498 @@
+package extension
+
+const Base = `
+{{- $E := .ElementName}}
+{{- $B := .BaseElement}}
+{{- $b := .BasePackageName}}
+
+import (
+	"errors"
+	"math/big"
+
+	"{{.BasePackagePath}}"
+)
+
+// {{$E}} is a degree {{.Degree}} extension of {{$B}}: {{$E}} = 𝔽p[u]/({{.Polynomial}})
+type {{$E}} struct {
+	{{- range $i := iterate 0 .Degree}}{{if $i}}, {{end}}A{{$i}}{{end}} {{$B}}
+}
+
+// SizeOf{{$E}} is the number of bytes needed to represent an element of {{$E}}
+const SizeOf{{$E}} = {{.Degree}} * {{$b}}.Bytes
+
+// nonResidue{{$E}} is u{{supScr .Degree}} = {{.RootOf}}
+var nonResidue{{$E}} = {{.NonResidue}}
+
+// frobCoeff{{$E}}[i-1] = u^(i(p-1)) for 1 ≤ i < {{.Degree}}
+var frobCoeff{{$E}} = [{{sub .Degree 1}}]{{$B}}{
+	{{- range .FrobeniusCoefficients}}
+	{{.}},
+	{{- end}}
+}
+
+// Tonelli-Shanks constants: p{{supScr .Degree}}-1 = 2ˢ⋅m with m odd and
+// sqrtG{{$E}} = gᵐ for a quadratic non-residue g
+const sqrtS{{$E}} = {{.SqrtS}}
+
+var sqrtG{{$E}} = {{$E}}{
+	{{- range $i, $g := .SqrtG}}
+	A{{$i}}: {{$g}},
+	{{- end}}
+}
+
+var sqrtM{{$E}}, sqrtMPlusOneHalf{{$E}} big.Int
+
+func init() {
+	sqrtM{{$E}}.SetString("{{.SqrtM}}", 10)
+	sqrtMPlusOneHalf{{$E}}.SetString("{{.SqrtMPlusOneHalf}}", 10)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{$E}}) Equal(x *{{$E}}) bool {
+	return {{range $i := iterate 0 .Degree}}{{if $i}} && {{end}}z.A{{$i}}.Equal(&x.A{{$i}}){{end}}
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *{{$E}}) Cmp(x *{{$E}}) int {
+	{{- range $i := reverse (iterate 1 .Degree)}}
+	if a{{$i}} := z.A{{$i}}.Cmp(&x.A{{$i}}); a{{$i}} != 0 {
+		return a{{$i}}
+	}
+	{{- end}}
+	return z.A0.Cmp(&x.A0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *{{$E}}) LexicographicallyLargest() bool {
+	{{- range $i := reverse (iterate 1 .Degree)}}
+	if !z.A{{$i}}.IsZero() {
+		return z.A{{$i}}.LexicographicallyLargest()
+	}
+	{{- end}}
+	return z.A0.LexicographicallyLargest()
+}
+
+// SetString sets z from the base 10 strings of its coordinates and returns z
+func (z *{{$E}}) SetString({{range $i := iterate 0 .Degree}}{{if $i}}, {{end}}s{{$i}}{{end}} string) (*{{$E}}, error) {
+	{{- range $i := iterate 0 .Degree}}
+	if _, err := z.A{{$i}}.SetString(s{{$i}}); err != nil {
+		return nil, err
+	}
+	{{- end}}
+	return z, nil
+}
+
+// SetZero sets z to 0 and returns z
+func (z *{{$E}}) SetZero() *{{$E}} {
+	*z = {{$E}}{}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *{{$E}}) Set(x *{{$E}}) *{{$E}} {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *{{$E}}) SetOne() *{{$E}} {
+	*z = {{$E}}{}
+	z.A0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *{{$E}}) SetRandom() (*{{$E}}, error) {
+	{{- range $i := iterate 0 .Degree}}
+	if _, err := z.A{{$i}}.SetRandom(); err != nil {
+		return nil, err
+	}
+	{{- end}}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *{{$E}}) IsZero() bool {
+	return {{range $i := iterate 0 .Degree}}{{if $i}} && {{end}}z.A{{$i}}.IsZero(){{end}}
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *{{$E}}) IsOne() bool {
+	return z.A0.IsOne(){{range $i := iterate 1 .Degree}} && z.A{{$i}}.IsZero(){{end}}
+}
+
+// Add sets z to x+y and returns z
+func (z *{{$E}}) Add(x, y *{{$E}}) *{{$E}} {
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Add(&x.A{{$i}}, &y.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *{{$E}}) Sub(x, y *{{$E}}) *{{$E}} {
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Sub(&x.A{{$i}}, &y.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Double sets z to 2x and returns z
+func (z *{{$E}}) Double(x *{{$E}}) *{{$E}} {
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Double(&x.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Neg sets z to -x and returns z
+func (z *{{$E}}) Neg(x *{{$E}}) *{{$E}} {
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Neg(&x.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Halve sets z to z/2
+func (z *{{$E}}) Halve() {
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Halve()
+	{{- end}}
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{$E}}) String() string {
+	{{- if eq .Degree 2}}
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+	{{- else}}
+	return z.A0.String() + "+" + z.A1.String() + "*u+" + z.A2.String() + "*u**2"
+	{{- end}}
+}
+
+// MulByElement sets z to x*y with y in the base field and returns z
+func (z *{{$E}}) MulByElement(x *{{$E}}, y *{{$B}}) *{{$E}} {
+	_y := *y
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Mul(&x.A{{$i}}, &_y)
+	{{- end}}
+	return z
+}
+
+// MulByNonResidue sets z to x*u and returns z
+func (z *{{$E}}) MulByNonResidue(x *{{$E}}) *{{$E}} {
+	{{- if eq .Degree 2}}
+	z.A0, z.A1 = x.A1, x.A0
+	{{- else}}
+	z.A0, z.A1, z.A2 = x.A2, x.A0, x.A1
+	{{- end}}
+	z.A0.Mul(&z.A0, &nonResidue{{$E}})
+	return z
+}
+
+// Mul sets z to x*y and returns z
+func (z *{{$E}}) Mul(x, y *{{$E}}) *{{$E}} {
+	{{- if eq .Degree 2}}
+	// Karatsuba
+	var t0, t1, s0, s1 {{$B}}
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	s0.Add(&x.A0, &x.A1)
+	s1.Add(&y.A0, &y.A1)
+	z.A1.Mul(&s0, &s1).Sub(&z.A1, &t0).Sub(&z.A1, &t1)
+	z.A0.Mul(&t1, &nonResidue{{$E}}).Add(&z.A0, &t0)
+	{{- else}}
+	// Algorithm 13 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, c0, c1, c2, tmp {{$B}}
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c0.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2).Mul(&c0, &nonResidue{{$E}})
+
+	tmp.Add(&x.A0, &x.A2)
+	c2.Add(&y.A0, &y.A2).Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2)
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	t2.Mul(&t2, &nonResidue{{$E}})
+
+	z.A0.Add(&c0, &t0)
+	z.A1.Add(&c1, &t2)
+	z.A2.Add(&c2, &t1)
+	{{- end}}
+	return z
+}
+
+// Square sets z to x*x and returns z
+func (z *{{$E}}) Square(x *{{$E}}) *{{$E}} {
+	{{- if eq .Degree 2}}
+	// (a0 + a1u)² = a0² + αa1² + 2a0a1u
+	var t0, t1 {{$B}}
+	t0.Square(&x.A0)
+	t1.Square(&x.A1).Mul(&t1, &nonResidue{{$E}})
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&t0, &t1)
+	{{- else}}
+	// Algorithm 16 from https://eprint.iacr.org/2010/354.pdf
+	var c4, c5, c1, c2, c3, c0, c6 {{$B}}
+
+	c6.Double(&x.A1)
+	c4.Mul(&x.A0, &c6) // x.A0 * xA1 * 2
+	c5.Square(&x.A2)
+	c1.Mul(&c5, &nonResidue{{$E}}).Add(&c1, &c4)
+	c2.Sub(&c4, &c5)
+
+	c3.Square(&x.A0)
+	c4.Sub(&x.A0, &x.A1).Add(&c4, &x.A2)
+	c5.Mul(&c6, &x.A2) // x.A1 * xA2 * 2
+	c4.Square(&c4)
+	c0.Mul(&c5, &nonResidue{{$E}})
+	c4.Add(&c4, &c5).Sub(&c4, &c3)
+
+	z.A0.Add(&c0, &c3)
+	z.A1 = c1
+	z.A2.Add(&c2, &c4)
+	{{- end}}
+	return z
+}
+
+// Inverse sets z to x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *{{$E}}) Inverse(x *{{$E}}) *{{$E}} {
+	{{- if eq .Degree 2}}
+	// (a0 + a1u)⁻¹ = (a0 - a1u) / (a0² - αa1²)
+	var t {{$B}}
+	x.norm(&t)
+	t.Inverse(&t)
+	z.A0.Mul(&x.A0, &t)
+	z.A1.Mul(&x.A1, &t).Neg(&z.A1)
+	{{- else}}
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	// step 9 is wrong in the paper it's t1-t4
+	var t0, t1, t2, t3, t4, t5, t6, c0, c1, c2, d1, d2 {{$B}}
+	t0.Square(&x.A0)
+	t1.Square(&x.A1)
+	t2.Square(&x.A2)
+	t3.Mul(&x.A0, &x.A1)
+	t4.Mul(&x.A0, &x.A2)
+	t5.Mul(&x.A1, &x.A2)
+	c0.Mul(&t5, &nonResidue{{$E}}).Neg(&c0).Add(&c0, &t0)
+	c1.Mul(&t2, &nonResidue{{$E}}).Sub(&c1, &t3)
+	c2.Sub(&t1, &t4)
+	t6.Mul(&x.A0, &c0)
+	d1.Mul(&x.A2, &c1)
+	d2.Mul(&x.A1, &c2)
+	d1.Add(&d1, &d2).Mul(&d1, &nonResidue{{$E}})
+	t6.Add(&t6, &d1)
+	t6.Inverse(&t6)
+	z.A0.Mul(&c0, &t6)
+	z.A1.Mul(&c1, &t6)
+	z.A2.Mul(&c2, &t6)
+	{{- end}}
+	return z
+}
+
+// BatchInvert{{$E}} returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert{{$E}}(a []{{$E}}) []{{$E}} {
+	res := make([]{{$E}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator {{$E}}
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *{{$E}}) Div(x, y *{{$E}}) *{{$E}} {
+	var r {{$E}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *{{$E}}) Select(c int, x0, x1 *{{$E}}) *{{$E}} {
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}}.Select(c, &x0.A{{$i}}, &x1.A{{$i}})
+	{{- end}}
+	return z
+}
+
+// Frobenius sets z to xᵖ and returns z
+func (z *{{$E}}) Frobenius(x *{{$E}}) *{{$E}} {
+	z.A0 = x.A0
+	{{- range $i := iterate 1 .Degree}}
+	z.A{{$i}}.Mul(&x.A{{$i}}, &frobCoeff{{$E}}[{{sub $i 1}}])
+	{{- end}}
+	return z
+}
+
+{{- if eq .Degree 2}}
+
+// Conjugate sets z to the conjugate a0 - a1u of x = a0 + a1u and returns z.
+// It is equal to the Frobenius xᵖ.
+func (z *{{$E}}) Conjugate(x *{{$E}}) *{{$E}} {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+{{- end}}
+
+// norm sets n to the norm of z, i.e. the product of its conjugates
+func (z *{{$E}}) norm(n *{{$B}}) {
+	{{- if eq .Degree 2}}
+	// N(a0+a1u) = a0² - αa1²
+	var t {{$B}}
+	t.Square(&z.A1).Mul(&t, &nonResidue{{$E}})
+	n.Square(&z.A0).Sub(n, &t)
+	{{- else}}
+	// N(a0+a1u+a2u²) = a0³ + αa1³ + α²a2³ - 3αa0a1a2
+	var t0, t1, t2, t3 {{$B}}
+	t0.Square(&z.A0).Mul(&t0, &z.A0)
+	t1.Square(&z.A1).Mul(&t1, &z.A1).Mul(&t1, &nonResidue{{$E}})
+	t2.Square(&z.A2).Mul(&t2, &z.A2).Mul(&t2, &nonResidue{{$E}}).Mul(&t2, &nonResidue{{$E}})
+	t3.Mul(&z.A0, &z.A1).Mul(&t3, &z.A2).Mul(&t3, &nonResidue{{$E}})
+	n.Double(&t3).Add(n, &t3)
+	n.Sub(&t0, n).Add(n, &t1).Add(n, &t2)
+	{{- end}}
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *{{$E}}) Legendre() int {
+	var n {{$B}}
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ and returns it
+func (z *{{$E}}) Exp(x {{$E}}, k *big.Int) *{{$E}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Sqrt z = √x and returns z, or nil if x is not a square.
+//
+// Tonelli-Shanks with p{{supScr .Degree}}-1 = 2ˢ⋅m, s = {{.SqrtS}}
+func (z *{{$E}}) Sqrt(x *{{$E}}) *{{$E}} {
+	if x.IsZero() {
+		return z.SetZero()
+	}
+
+	var c, t, r, b {{$E}}
+	c.Set(&sqrtG{{$E}})
+	t.Exp(*x, &sqrtM{{$E}})
+	r.Exp(*x, &sqrtMPlusOneHalf{{$E}})
+	s := sqrtS{{$E}}
+
+	for !t.IsOne() {
+		// find the least i such that t^(2^i) = 1
+		i := 0
+		b.Set(&t)
+		for !b.IsOne() {
+			b.Square(&b)
+			i++
+			if i == s {
+				return nil
+			}
+		}
+
+		b.Set(&c)
+		for j := 0; j < s-i-1; j++ {
+			b.Square(&b)
+		}
+		s = i
+		c.Square(&b)
+		t.Mul(&t, &c)
+		r.Mul(&r, &b)
+	}
+
+	return z.Set(&r)
+}
+
+// Bytes returns the value of z as a big-endian byte array, coordinates A0 first
+func (z *{{$E}}) Bytes() (res [SizeOf{{$E}}]byte) {
+	{{- range $i := iterate 0 .Degree}}
+	{{$b}}.BigEndian.PutElement((*[{{$b}}.Bytes]byte)(res[{{$i}}*{{$b}}.Bytes:{{add $i 1}}*{{$b}}.Bytes]), z.A{{$i}})
+	{{- end}}
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice, coordinates A0 first
+func (z *{{$E}}) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian {{$E}}, coordinates A0 first, and sets z to that value.
+// It returns an error if e doesn't have the right size or if a coordinate is not canonical.
+func (z *{{$E}}) SetBytes(e []byte) error {
+	if len(e) != SizeOf{{$E}} {
+		return errors.New("invalid {{$E}} encoding")
+	}
+	var err error
+	{{- range $i := iterate 0 .Degree}}
+	if z.A{{$i}}, err = {{$b}}.BigEndian.Element((*[{{$b}}.Bytes]byte)(e[{{$i}}*{{$b}}.Bytes:{{add $i 1}}*{{$b}}.Bytes])); err != nil {
+		return err
+	}
+	{{- end}}
+	return nil
+}
+`
@@ -0,0 +1,676 @@
+package extension
+
+const Cubic = `
+{{- $E := .ElementName}}
+{{- $B := .BaseName}}
+
+import (
+	"errors"
+	"math/big"
+
+	"{{.BasePackagePath}}"
+)
+
+// {{$E}} is a degree {{.Degree}} extension of 𝔽p: {{$E}} = {{$B}}[v]/(v³-ξ), ξ = {{.NonResidueString}}
+type {{$E}} struct {
+	B0, B1, B2 {{$B}}
+}
+
+// SizeOf{{$E}} is the number of bytes needed to represent an element of {{$E}}
+const SizeOf{{$E}} = 3 * SizeOf{{$B}}
+
+// nonResidue{{$E}} is v³ = ξ = {{.NonResidueString}}
+var nonResidue{{$E}} = {{.NonResidue}}
+
+// frobCoeffs{{$E}} are γ₁, γ₂ such that
+{{- if .FrobeniusSwap}}
+// vᵖ = γ₁⋅v² and v²ᵖ = γ₂⋅v
+{{- else}}
+// vᵖ = γ₁⋅v and v²ᵖ = γ₂⋅v²
+{{- end}}
+var frobCoeffs{{$E}} = [2]{{$B}}{
+	{{index .FrobeniusCoefficients 0}},
+	{{index .FrobeniusCoefficients 1}},
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{$E}}) Equal(x *{{$E}}) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1) && z.B2.Equal(&x.B2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *{{$E}}) Cmp(x *{{$E}}) int {
+	if b2 := z.B2.Cmp(&x.B2); b2 != 0 {
+		return b2
+	}
+	if b1 := z.B1.Cmp(&x.B1); b1 != 0 {
+		return b1
+	}
+	return z.B0.Cmp(&x.B0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *{{$E}}) LexicographicallyLargest() bool {
+	if !z.B2.IsZero() {
+		return z.B2.LexicographicallyLargest()
+	}
+	if !z.B1.IsZero() {
+		return z.B1.LexicographicallyLargest()
+	}
+	return z.B0.LexicographicallyLargest()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *{{$E}}) SetZero() *{{$E}} {
+	*z = {{$E}}{}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *{{$E}}) Set(x *{{$E}}) *{{$E}} {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *{{$E}}) SetOne() *{{$E}} {
+	*z = {{$E}}{}
+	z.B0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *{{$E}}) SetRandom() (*{{$E}}, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *{{$E}}) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero() && z.B2.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *{{$E}}) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero() && z.B2.IsZero()
+}
+
+// Add sets z to x+y and returns z
+func (z *{{$E}}) Add(x, y *{{$E}}) *{{$E}} {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	z.B2.Add(&x.B2, &y.B2)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *{{$E}}) Sub(x, y *{{$E}}) *{{$E}} {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	z.B2.Sub(&x.B2, &y.B2)
+	return z
+}
+
+// Double sets z to 2x and returns z
+func (z *{{$E}}) Double(x *{{$E}}) *{{$E}} {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	z.B2.Double(&x.B2)
+	return z
+}
+
+// Neg sets z to -x and returns z
+func (z *{{$E}}) Neg(x *{{$E}}) *{{$E}} {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	z.B2.Neg(&x.B2)
+	return z
+}
+
+// Halve sets z to z/2
+func (z *{{$E}}) Halve() {
+	z.B0.Halve()
+	z.B1.Halve()
+	z.B2.Halve()
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{$E}}) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*v+(" + z.B2.String() + ")*v**2"
+}
+
+// MulByElement sets z to x*y with y in {{$B}} and returns z
+func (z *{{$E}}) MulByElement(x *{{$E}}, y *{{$B}}) *{{$E}} {
+	_y := *y
+	z.B0.Mul(&x.B0, &_y)
+	z.B1.Mul(&x.B1, &_y)
+	z.B2.Mul(&x.B2, &_y)
+	return z
+}
+
+// MulByNonResidue sets z to x*v and returns z
+func (z *{{$E}}) MulByNonResidue(x *{{$E}}) *{{$E}} {
+	z.B0, z.B1, z.B2 = x.B2, x.B0, x.B1
+	mulByNonResidue{{$E}}(&z.B0, &z.B0)
+	return z
+}
+
+// Mul sets z to x*y and returns z
+func (z *{{$E}}) Mul(x, y *{{$E}}) *{{$E}} {
+	// Karatsuba, Algorithm 13 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, c0, c1, c2, tmp {{$B}}
+	t0.Mul(&x.B0, &y.B0)
+	t1.Mul(&x.B1, &y.B1)
+	t2.Mul(&x.B2, &y.B2)
+
+	c0.Add(&x.B1, &x.B2)
+	tmp.Add(&y.B1, &y.B2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2)
+	mulByNonResidue{{$E}}(&c0, &c0)
+	c0.Add(&c0, &t0)
+
+	c1.Add(&x.B0, &x.B1)
+	tmp.Add(&y.B0, &y.B1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	mulByNonResidue{{$E}}(&tmp, &t2)
+	c1.Add(&c1, &tmp)
+
+	tmp.Add(&x.B0, &x.B2)
+	c2.Add(&y.B0, &y.B2).Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.B0, z.B1, z.B2 = c0, c1, c2
+	return z
+}
+
+// Square sets z to x*x and returns z
+func (z *{{$E}}) Square(x *{{$E}}) *{{$E}} {
+	// Chung-Hasan SQR2, Algorithm 16 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c1, c2, c3, c4, c5 {{$B}}
+	c4.Mul(&x.B0, &x.B1).Double(&c4)
+	c5.Square(&x.B2)
+	mulByNonResidue{{$E}}(&c1, &c5)
+	c1.Add(&c1, &c4)
+	c2.Sub(&c4, &c5)
+	c3.Square(&x.B0)
+	c4.Sub(&x.B0, &x.B1).Add(&c4, &x.B2)
+	c5.Mul(&x.B1, &x.B2).Double(&c5)
+	c4.Square(&c4)
+	mulByNonResidue{{$E}}(&c0, &c5)
+	c0.Add(&c0, &c3)
+	z.B2.Add(&c2, &c4).Add(&z.B2, &c5).Sub(&z.B2, &c3)
+	z.B0, z.B1 = c0, c1
+	return z
+}
+
+// Inverse sets z to x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *{{$E}}) Inverse(x *{{$E}}) *{{$E}} {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	// step 9 is wrong in the paper it's t1-t4
+	var t0, t1, t2, t3, t4, t5, t6, c0, c1, c2, d1, d2 {{$B}}
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	t2.Square(&x.B2)
+	t3.Mul(&x.B0, &x.B1)
+	t4.Mul(&x.B0, &x.B2)
+	t5.Mul(&x.B1, &x.B2)
+	mulByNonResidue{{$E}}(&c0, &t5)
+	c0.Sub(&t0, &c0)
+	mulByNonResidue{{$E}}(&c1, &t2)
+	c1.Sub(&c1, &t3)
+	c2.Sub(&t1, &t4)
+	t6.Mul(&x.B0, &c0)
+	d1.Mul(&x.B2, &c1)
+	d2.Mul(&x.B1, &c2)
+	d1.Add(&d1, &d2)
+	mulByNonResidue{{$E}}(&d1, &d1)
+	t6.Add(&t6, &d1)
+	t6.Inverse(&t6)
+	z.B0.Mul(&c0, &t6)
+	z.B1.Mul(&c1, &t6)
+	z.B2.Mul(&c2, &t6)
+	return z
+}
+
+// BatchInvert{{$E}} returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert{{$E}}(a []{{$E}}) []{{$E}} {
+	res := make([]{{$E}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator {{$E}}
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *{{$E}}) Div(x, y *{{$E}}) *{{$E}} {
+	var r {{$E}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *{{$E}}) Select(c int, x0, x1 *{{$E}}) *{{$E}} {
+	z.B0.Select(c, &x0.B0, &x1.B0)
+	z.B1.Select(c, &x0.B1, &x1.B1)
+	z.B2.Select(c, &x0.B2, &x1.B2)
+	return z
+}
+
+// Frobenius sets z to xᵖ and returns z
+func (z *{{$E}}) Frobenius(x *{{$E}}) *{{$E}} {
+	var b1, b2 {{$B}}
+	z.B0.Frobenius(&x.B0)
+	b1.Frobenius(&x.B1).Mul(&b1, &frobCoeffs{{$E}}[0])
+	b2.Frobenius(&x.B2).Mul(&b2, &frobCoeffs{{$E}}[1])
+	{{- if .FrobeniusSwap}}
+	z.B1, z.B2 = b2, b1
+	{{- else}}
+	z.B1, z.B2 = b1, b2
+	{{- end}}
+	return z
+}
+
+// Exp sets z=xᵏ and returns it
+func (z *{{$E}}) Exp(x {{$E}}, k *big.Int) *{{$E}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Bytes returns the value of z as a big-endian byte array, B0 first
+func (z *{{$E}}) Bytes() (res [SizeOf{{$E}}]byte) {
+	b0, b1, b2 := z.B0.Bytes(), z.B1.Bytes(), z.B2.Bytes()
+	copy(res[:SizeOf{{$B}}], b0[:])
+	copy(res[SizeOf{{$B}}:2*SizeOf{{$B}}], b1[:])
+	copy(res[2*SizeOf{{$B}}:], b2[:])
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice, B0 first
+func (z *{{$E}}) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian {{$E}}, B0 first, and sets z to that value.
+// It returns an error if e doesn't have the right size or if a coordinate is not canonical.
+func (z *{{$E}}) SetBytes(e []byte) error {
+	if len(e) != SizeOf{{$E}} {
+		return errors.New("invalid {{$E}} encoding")
+	}
+	if err := z.B0.SetBytes(e[:SizeOf{{$B}}]); err != nil {
+		return err
+	}
+	if err := z.B1.SetBytes(e[SizeOf{{$B}} : 2*SizeOf{{$B}}]); err != nil {
+		return err
+	}
+	return z.B2.SetBytes(e[2*SizeOf{{$B}}:])
+}
+
+// mulByNonResidue{{$E}} sets z to ξ⋅x
+func mulByNonResidue{{$E}}(z, x *{{$B}}) {
+	{{- if .NonResidueIsU}}
+	z.MulByNonResidue(x)
+	{{- else}}
+	z.Mul(x, &nonResidue{{$E}})
+	{{- end}}
+}
+`
+
+const CubicTest = `
+{{- $E := .ElementName}}
+{{- $B := .BaseName}}
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func Test{{$E}}ReceiverIsOperand(t *testing.T) {
+	var a, b, c, d {{$E}}
+	a.SetRandom()
+	b.SetRandom()
+
+	c.Mul(&a, &b)
+	d.Set(&a)
+	d.Mul(&d, &b)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as first operand failed")
+	}
+	d.Set(&b)
+	d.Mul(&a, &d)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as second operand failed")
+	}
+
+	c.Square(&a)
+	d.Set(&a)
+	d.Square(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Square: receiver as operand failed")
+	}
+
+	c.Inverse(&a)
+	d.Set(&a)
+	d.Inverse(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Inverse: receiver as operand failed")
+	}
+
+	c.Frobenius(&a)
+	d.Set(&a)
+	d.Frobenius(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Frobenius: receiver as operand failed")
+	}
+}
+
+func Test{{$E}}Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen{{$E}}()
+	genB := gen{{$E}}()
+	genE := gen{{$B}}()
+
+	properties.Property("[{{$E}}] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c {{$E}}
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] mul should match the schoolbook product modulo v³-ξ", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			var t {{$B}}
+			c.Mul(a, b)
+
+			// d0 = a0b0 + ξ(a1b2 + a2b1)
+			d.B0.Mul(&a.B1, &b.B2)
+			t.Mul(&a.B2, &b.B1)
+			d.B0.Add(&d.B0, &t).Mul(&d.B0, &nonResidue{{$E}})
+			t.Mul(&a.B0, &b.B0)
+			d.B0.Add(&d.B0, &t)
+
+			// d1 = a0b1 + a1b0 + ξa2b2
+			d.B1.Mul(&a.B2, &b.B2).Mul(&d.B1, &nonResidue{{$E}})
+			t.Mul(&a.B0, &b.B1)
+			d.B1.Add(&d.B1, &t)
+			t.Mul(&a.B1, &b.B0)
+			d.B1.Add(&d.B1, &t)
+
+			// d2 = a0b2 + a1b1 + a2b0
+			d.B2.Mul(&a.B0, &b.B2)
+			t.Mul(&a.B1, &b.B1)
+			d.B2.Add(&d.B2, &t)
+			t.Mul(&a.B2, &b.B0)
+			d.B2.Add(&d.B2, &t)
+
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] Div should be the same as mul by inverse", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Div(a, b)
+			d.Inverse(b).Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] BatchInvert{{$E}} should output the same result as Inverse", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvert{{$E}}([]{{$E}}{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] square and mul should output the same result", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Halve should be the inverse of Double", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Double(a)
+			b.Halve()
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Neg should be the additive inverse", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Neg(a).Add(&b, a)
+			return b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] MulByElement should be the same as mul by an element of {{$B}}", prop.ForAll(
+		func(a *{{$E}}, b *{{$B}}) bool {
+			var c, d {{$E}}
+			c.MulByElement(a, b)
+			d.B0 = *b
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[{{$E}}] MulByNonResidue should be the same as multiplying by v", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c, v {{$E}}
+			v.B1.SetOne()
+			b.Mul(a, &v)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Frobenius should be the same as Exp(p)", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			var p big.Int
+			p.SetString("{{.Modulus}}", 10)
+			b.Frobenius(a)
+			c.Exp(*a, &p)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Frobenius applied {{.Degree}} times should be the identity", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Set(a)
+			for i := 0; i < {{.Degree}}; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Exp with a negative exponent should be the inverse", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return b.Equal(a) && b.SetBytes(buf[1:]) != nil
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Cmp should be consistent with LexicographicallyLargest", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Neg(a)
+			if a.IsZero() {
+				return !a.LexicographicallyLargest() && a.Cmp(&b) == 0
+			}
+			return a.LexicographicallyLargest() == (a.Cmp(&b) == 1)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func Benchmark{{$E}}Mul(b *testing.B) {
+	var a, c {{$E}}
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func Benchmark{{$E}}Square(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func Benchmark{{$E}}Inverse(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+`
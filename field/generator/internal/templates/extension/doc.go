@@ -0,0 +1,30 @@
+package extension
+
+const Doc = `
+{{- $base := index .Extensions 0}}
+// Package {{$base.PackageName}} contains arithmetic operations in extensions of {{$base.BasePackageName}}.
+//
+// The extensions are simple radical extensions of the base field 𝔽p, p = {{$base.Modulus}}:
+//
+{{- range .Extensions}}
+// 	{{.ElementName}} = 𝔽p[u]/({{.Polynomial}})
+{{- end}}
+//
+// Elements are represented by their coordinates in the basis (1, u, u², ...) and
+// all the base field coordinates are in Montgomery form.
+{{- if .Towers}}
+//
+// Higher degree extensions are built as quadratic{{if .HasCubic}} or cubic{{end}} extensions of the former:
+//
+{{- range .Towers}}
+// 	{{.ElementName}} = {{.BaseName}}[v]/({{.V}} - {{if .NonResidueIsU}}u{{else}}({{.NonResidueString}}){{end}})
+{{- end}}
+//
+// and are represented by their coordinates B0 + B1⋅v{{if .HasCubic}} (+ B2⋅v² for cubic extensions){{end}}.
+{{- end}}
+//
+// Warning
+//
+// This code has not been audited and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
+package {{$base.PackageName}}
+`
@@ -0,0 +1,448 @@
+package extension
+
+const Test = `
+{{- $E := .ElementName}}
+{{- $B := .BaseElement}}
+{{- $b := .BasePackageName}}
+
+import (
+	"math/big"
+	"testing"
+
+	"{{.BasePackagePath}}"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func Test{{$E}}ReceiverIsOperand(t *testing.T) {
+	var a, b, c, d {{$E}}
+	a.SetRandom()
+	b.SetRandom()
+
+	c.Mul(&a, &b)
+	d.Set(&a)
+	d.Mul(&d, &b)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as first operand failed")
+	}
+	d.Set(&b)
+	d.Mul(&a, &d)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as second operand failed")
+	}
+
+	c.Square(&a)
+	d.Set(&a)
+	d.Square(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Square: receiver as operand failed")
+	}
+
+	c.Inverse(&a)
+	d.Set(&a)
+	d.Inverse(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Inverse: receiver as operand failed")
+	}
+
+	c.Frobenius(&a)
+	d.Set(&a)
+	d.Frobenius(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Frobenius: receiver as operand failed")
+	}
+}
+
+func Test{{$E}}Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen{{$E}}()
+	genB := gen{{$E}}()
+	genE := genBaseElement()
+
+	properties.Property("[{{$E}}] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c {{$E}}
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] mul should match the schoolbook product modulo {{.Polynomial}}", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Mul(a, b)
+			schoolbookMul{{$E}}(&d, a, b)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] Div should be the same as mul by inverse", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Div(a, b)
+			d.Inverse(b).Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] BatchInvert{{$E}} should output the same result as Inverse", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvert{{$E}}([]{{$E}}{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] square and mul should output the same result", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Double and add twice should output the same result", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Add(a, a)
+			c.Double(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Halve should be the inverse of Double", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Double(a)
+			b.Halve()
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Neg should be the additive inverse", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Neg(a).Add(&b, a)
+			return b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] MulByElement MulByElement inverse should leave an element invariant", prop.ForAll(
+		func(a *{{$E}}, b {{$B}}) bool {
+			var c {{$E}}
+			var d {{$B}}
+			d.Inverse(&b)
+			c.MulByElement(a, &b).MulByElement(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[{{$E}}] MulByNonResidue should be the same as multiplying by u", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c, u {{$E}}
+			u.A1.SetOne()
+			b.Mul(a, &u)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Frobenius should be the same as Exp(p)", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Frobenius(a)
+			c.Exp(*a, {{$b}}.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Frobenius applied {{.Degree}} times should be the identity", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Set(a)
+			for i := 0; i < {{.Degree}}; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	{{- if eq .Degree 2}}
+
+	properties.Property("[{{$E}}] Conjugate should be the same as Frobenius", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Conjugate(a)
+			c.Frobenius(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+	{{- end}}
+
+	properties.Property("[{{$E}}] Exp with a negative exponent should be the inverse", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Legendre on square should output 1", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Legendre should match Euler's criterion", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, minusOne {{$E}}
+			e := new(big.Int).Exp({{$b}}.Modulus(), big.NewInt({{.Degree}}), nil)
+			e.Sub(e, big.NewInt(1)).Rsh(e, 1)
+			b.Exp(*a, e)
+			minusOne.SetOne().Neg(&minusOne)
+			switch a.Legendre() {
+			case 1:
+				return b.IsOne()
+			case -1:
+				return b.Equal(&minusOne)
+			}
+			return false
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Sqrt of a square should be a square root", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return b.Equal(a) && b.SetBytes(buf[1:]) != nil
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Cmp should be consistent with LexicographicallyLargest", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Neg(a)
+			if a.IsZero() {
+				return !a.LexicographicallyLargest() && a.Cmp(&b) == 0
+			}
+			return a.LexicographicallyLargest() == (a.Cmp(&b) == 1)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func Test{{$E}}SetBytesNonCanonical(t *testing.T) {
+	var a {{$E}}
+	buf := make([]byte, SizeOf{{$E}})
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	if err := a.SetBytes(buf); err == nil {
+		t.Fatal("SetBytes should reject non canonical coordinates")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func Benchmark{{$E}}Add(b *testing.B) {
+	var a, c {{$E}}
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Add(&a, &c)
+	}
+}
+
+func Benchmark{{$E}}Mul(b *testing.B) {
+	var a, c {{$E}}
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func Benchmark{{$E}}Square(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func Benchmark{{$E}}Inverse(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func Benchmark{{$E}}Sqrt(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+
+// schoolbookMul{{$E}} computes x*y as polynomials in u and reduces with u{{supScr .Degree}} = {{.RootOf}}
+func schoolbookMul{{$E}}(z, x, y *{{$E}}) {
+	a := [...]{{$B}}{ {{- range $i := iterate 0 .Degree}}x.A{{$i}}, {{end}} }
+	b := [...]{{$B}}{ {{- range $i := iterate 0 .Degree}}y.A{{$i}}, {{end}} }
+	var c [2*{{.Degree}} - 1]{{$B}}
+	for i := range a {
+		for j := range b {
+			var t {{$B}}
+			t.Mul(&a[i], &b[j])
+			c[i+j].Add(&c[i+j], &t)
+		}
+	}
+	var alpha {{$B}}
+	alpha.SetInt64({{.RootOf}})
+	for i := len(c) - 1; i >= {{.Degree}}; i-- {
+		var t {{$B}}
+		t.Mul(&c[i], &alpha)
+		c[i-{{.Degree}}].Add(&c[i-{{.Degree}}], &t)
+	}
+	{{- range $i := iterate 0 .Degree}}
+	z.A{{$i}} = c[{{$i}}]
+	{{- end}}
+}
+
+`
+
+const Generators = `
+{{- $base := index .Extensions 0}}
+
+import (
+	"{{$base.BasePackagePath}}"
+	"github.com/leanovate/gopter"
+)
+
+// genBaseElement generates a random {{$base.BaseElement}}
+func genBaseElement() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a {{$base.BaseElement}}
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(a, gopter.NoShrinker)
+	}
+}
+{{- range .Extensions}}
+
+// gen{{.ElementName}} generates a random {{.ElementName}} element
+func gen{{.ElementName}}() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a {{.ElementName}}
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&a, gopter.NoShrinker)
+	}
+}
+{{- end}}
+{{- range .Towers}}
+
+// gen{{.ElementName}} generates a random {{.ElementName}} element
+func gen{{.ElementName}}() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a {{.ElementName}}
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&a, gopter.NoShrinker)
+	}
+}
+{{- end}}
+`
@@ -0,0 +1,733 @@
+package extension
+
+const Tower = `
+{{- $E := .ElementName}}
+{{- $B := .BaseName}}
+
+import (
+	"errors"
+	"math/big"
+
+	"{{.BasePackagePath}}"
+)
+
+// {{$E}} is a degree {{.Degree}} extension of 𝔽p: {{$E}} = {{$B}}[v]/(v²-ξ), ξ = {{.NonResidueString}}
+type {{$E}} struct {
+	B0, B1 {{$B}}
+}
+
+// SizeOf{{$E}} is the number of bytes needed to represent an element of {{$E}}
+const SizeOf{{$E}} = 2 * SizeOf{{$B}}
+
+// nonResidue{{$E}} is v² = ξ = {{.NonResidueString}}
+var nonResidue{{$E}} = {{.NonResidue}}
+
+// frobCoeff{{$E}} = v^(p-1) = ξ^((p-1)/2)
+var frobCoeff{{$E}} = {{.FrobeniusCoefficient}}
+
+// Equal returns true if z equals x, false otherwise
+func (z *{{$E}}) Equal(x *{{$E}}) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *{{$E}}) Cmp(x *{{$E}}) int {
+	if b1 := z.B1.Cmp(&x.B1); b1 != 0 {
+		return b1
+	}
+	return z.B0.Cmp(&x.B0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *{{$E}}) LexicographicallyLargest() bool {
+	if !z.B1.IsZero() {
+		return z.B1.LexicographicallyLargest()
+	}
+	return z.B0.LexicographicallyLargest()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *{{$E}}) SetZero() *{{$E}} {
+	*z = {{$E}}{}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *{{$E}}) Set(x *{{$E}}) *{{$E}} {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *{{$E}}) SetOne() *{{$E}} {
+	*z = {{$E}}{}
+	z.B0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *{{$E}}) SetRandom() (*{{$E}}, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *{{$E}}) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *{{$E}}) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// Add sets z to x+y and returns z
+func (z *{{$E}}) Add(x, y *{{$E}}) *{{$E}} {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *{{$E}}) Sub(x, y *{{$E}}) *{{$E}} {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z to 2x and returns z
+func (z *{{$E}}) Double(x *{{$E}}) *{{$E}} {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg sets z to -x and returns z
+func (z *{{$E}}) Neg(x *{{$E}}) *{{$E}} {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Halve sets z to z/2
+func (z *{{$E}}) Halve() {
+	z.B0.Halve()
+	z.B1.Halve()
+}
+
+// String implements Stringer interface for fancy printing
+func (z *{{$E}}) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*v"
+}
+
+// MulByElement sets z to x*y with y in {{$B}} and returns z
+func (z *{{$E}}) MulByElement(x *{{$E}}, y *{{$B}}) *{{$E}} {
+	_y := *y
+	z.B0.Mul(&x.B0, &_y)
+	z.B1.Mul(&x.B1, &_y)
+	return z
+}
+
+// MulByNonResidue sets z to x*v and returns z
+func (z *{{$E}}) MulByNonResidue(x *{{$E}}) *{{$E}} {
+	z.B0, z.B1 = x.B1, x.B0
+	mulByNonResidue{{$E}}(&z.B0, &z.B0)
+	return z
+}
+
+// Mul sets z to x*y and returns z
+func (z *{{$E}}) Mul(x, y *{{$E}}) *{{$E}} {
+	// Karatsuba
+	var t0, t1, s0, s1 {{$B}}
+	t0.Mul(&x.B0, &y.B0)
+	t1.Mul(&x.B1, &y.B1)
+	s0.Add(&x.B0, &x.B1)
+	s1.Add(&y.B0, &y.B1)
+	z.B1.Mul(&s0, &s1).Sub(&z.B1, &t0).Sub(&z.B1, &t1)
+	mulByNonResidue{{$E}}(&z.B0, &t1)
+	z.B0.Add(&z.B0, &t0)
+	return z
+}
+
+// Square sets z to x*x and returns z
+func (z *{{$E}}) Square(x *{{$E}}) *{{$E}} {
+	// (b0 + b1v)² = b0² + ξb1² + 2b0b1v
+	var t0, t1 {{$B}}
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	mulByNonResidue{{$E}}(&t1, &t1)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&t0, &t1)
+	return z
+}
+
+// Inverse sets z to x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *{{$E}}) Inverse(x *{{$E}}) *{{$E}} {
+	// (b0 + b1v)⁻¹ = (b0 - b1v) / (b0² - ξb1²)
+	var t {{$B}}
+	x.norm(&t)
+	t.Inverse(&t)
+	z.B0.Mul(&x.B0, &t)
+	z.B1.Mul(&x.B1, &t).Neg(&z.B1)
+	return z
+}
+
+// BatchInvert{{$E}} returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvert{{$E}}(a []{{$E}}) []{{$E}} {
+	res := make([]{{$E}}, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator {{$E}}
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *{{$E}}) Div(x, y *{{$E}}) *{{$E}} {
+	var r {{$E}}
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *{{$E}}) Select(c int, x0, x1 *{{$E}}) *{{$E}} {
+	z.B0.Select(c, &x0.B0, &x1.B0)
+	z.B1.Select(c, &x0.B1, &x1.B1)
+	return z
+}
+
+// Frobenius sets z to xᵖ and returns z
+func (z *{{$E}}) Frobenius(x *{{$E}}) *{{$E}} {
+	z.B0.Frobenius(&x.B0)
+	z.B1.Frobenius(&x.B1).Mul(&z.B1, &frobCoeff{{$E}})
+	return z
+}
+
+// Conjugate sets z to the conjugate b0 - b1v of x = b0 + b1v over {{$B}} and returns z
+func (z *{{$E}}) Conjugate(x *{{$E}}) *{{$E}} {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// norm sets n to the norm of z over {{$B}}, i.e. b0² - ξb1²
+func (z *{{$E}}) norm(n *{{$B}}) {
+	var t {{$B}}
+	t.Square(&z.B1)
+	mulByNonResidue{{$E}}(&t, &t)
+	n.Square(&z.B0).Sub(n, &t)
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *{{$E}}) Legendre() int {
+	var n {{$B}}
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ and returns it
+func (z *{{$E}}) Exp(x {{$E}}, k *big.Int) *{{$E}} {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Sqrt z = √x and returns z, or nil if x is not a square.
+//
+// It computes square roots in {{$B}} only, following the complex method.
+func (z *{{$E}}) Sqrt(x *{{$E}}) *{{$E}} {
+	var a, s {{$B}}
+	if x.B1.IsZero() {
+		// every element of {{$B}} is a square in {{$E}}: either √b0 or √(b0/ξ)⋅v
+		if s.Sqrt(&x.B0) != nil {
+			z.B0, z.B1 = s, {{$B}}{}
+			return z
+		}
+		a.Div(&x.B0, &nonResidue{{$E}})
+		s.Sqrt(&a)
+		z.B0, z.B1 = {{$B}}{}, s
+		return z
+	}
+
+	// δ = √(b0² - ξb1²)
+	var delta {{$B}}
+	x.norm(&delta)
+	if delta.Sqrt(&delta) == nil {
+		return nil
+	}
+
+	// a0 = √((b0 ± δ)/2) and a1 = b1/(2a0)
+	a.Add(&x.B0, &delta)
+	a.Halve()
+	if s.Sqrt(&a) == nil {
+		a.Sub(&x.B0, &delta)
+		a.Halve()
+		if s.Sqrt(&a) == nil {
+			return nil
+		}
+	}
+	a.Double(&s).Inverse(&a)
+	z.B1.Mul(&x.B1, &a)
+	z.B0 = s
+	return z
+}
+
+// Bytes returns the value of z as a big-endian byte array, B0 first
+func (z *{{$E}}) Bytes() (res [SizeOf{{$E}}]byte) {
+	b0, b1 := z.B0.Bytes(), z.B1.Bytes()
+	copy(res[:SizeOf{{$B}}], b0[:])
+	copy(res[SizeOf{{$B}}:], b1[:])
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice, B0 first
+func (z *{{$E}}) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian {{$E}}, B0 first, and sets z to that value.
+// It returns an error if e doesn't have the right size or if a coordinate is not canonical.
+func (z *{{$E}}) SetBytes(e []byte) error {
+	if len(e) != SizeOf{{$E}} {
+		return errors.New("invalid {{$E}} encoding")
+	}
+	if err := z.B0.SetBytes(e[:SizeOf{{$B}}]); err != nil {
+		return err
+	}
+	return z.B1.SetBytes(e[SizeOf{{$B}}:])
+}
+
+// mulByNonResidue{{$E}} sets z to ξ⋅x
+func mulByNonResidue{{$E}}(z, x *{{$B}}) {
+	{{- if .NonResidueIsU}}
+	z.MulByNonResidue(x)
+	{{- else}}
+	z.Mul(x, &nonResidue{{$E}})
+	{{- end}}
+}
+`
+
+const TowerTest = `
+{{- $E := .ElementName}}
+{{- $B := .BaseName}}
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func Test{{$E}}ReceiverIsOperand(t *testing.T) {
+	var a, b, c, d {{$E}}
+	a.SetRandom()
+	b.SetRandom()
+
+	c.Mul(&a, &b)
+	d.Set(&a)
+	d.Mul(&d, &b)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as first operand failed")
+	}
+	d.Set(&b)
+	d.Mul(&a, &d)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as second operand failed")
+	}
+
+	c.Square(&a)
+	d.Set(&a)
+	d.Square(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Square: receiver as operand failed")
+	}
+
+	c.Inverse(&a)
+	d.Set(&a)
+	d.Inverse(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Inverse: receiver as operand failed")
+	}
+
+	c.Frobenius(&a)
+	d.Set(&a)
+	d.Frobenius(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Frobenius: receiver as operand failed")
+	}
+}
+
+func Test{{$E}}Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := gen{{$E}}()
+	genB := gen{{$E}}()
+	genE := gen{{$B}}()
+
+	properties.Property("[{{$E}}] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c {{$E}}
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] mul should match the schoolbook product modulo v²-ξ", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			var t {{$B}}
+			c.Mul(a, b)
+			d.B0.Mul(&a.B0, &b.B0)
+			t.Mul(&a.B1, &b.B1).Mul(&t, &nonResidue{{$E}})
+			d.B0.Add(&d.B0, &t)
+			d.B1.Mul(&a.B0, &b.B1)
+			t.Mul(&a.B1, &b.B0)
+			d.B1.Add(&d.B1, &t)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] Div should be the same as mul by inverse", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Div(a, b)
+			d.Inverse(b).Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] BatchInvert{{$E}} should output the same result as Inverse", prop.ForAll(
+		func(a, b *{{$E}}) bool {
+			var c, d {{$E}}
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvert{{$E}}([]{{$E}}{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[{{$E}}] square and mul should output the same result", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Halve should be the inverse of Double", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Double(a)
+			b.Halve()
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Neg should be the additive inverse", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Neg(a).Add(&b, a)
+			return b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] MulByElement should be the same as mul by an element of {{$B}}", prop.ForAll(
+		func(a *{{$E}}, b *{{$B}}) bool {
+			var c, d {{$E}}
+			c.MulByElement(a, b)
+			d.B0 = *b
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[{{$E}}] MulByNonResidue should be the same as multiplying by v", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c, v {{$E}}
+			v.B1.SetOne()
+			b.Mul(a, &v)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Frobenius should be the same as Exp(p)", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			var p big.Int
+			p.SetString("{{.Modulus}}", 10)
+			b.Frobenius(a)
+			c.Exp(*a, &p)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Frobenius applied {{.Degree}} times should be the identity", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Set(a)
+			for i := 0; i < {{.Degree}}; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] x times its conjugate should be in {{$B}}", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			var n {{$B}}
+			b.Conjugate(a).Mul(&b, a)
+			a.norm(&n)
+			return b.B1.IsZero() && b.B0.Equal(&n)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Exp with a negative exponent should be the inverse", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Legendre on square should output 1", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Legendre should match Euler's criterion", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, minusOne {{$E}}
+			e := new(big.Int)
+			e.SetString("{{.Modulus}}", 10)
+			e.Exp(e, big.NewInt({{.Degree}}), nil)
+			e.Sub(e, big.NewInt(1)).Rsh(e, 1)
+			b.Exp(*a, e)
+			minusOne.SetOne().Neg(&minusOne)
+			switch a.Legendre() {
+			case 1:
+				return b.IsOne()
+			case -1:
+				return b.Equal(&minusOne)
+			}
+			return false
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Sqrt of a square should be a square root", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b, c {{$E}}
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Sqrt of an element of {{$B}} should be a square root", prop.ForAll(
+		func(a *{{$B}}) bool {
+			var b, c {{$E}}
+			b.B0 = *a
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genE,
+	))
+
+	properties.Property("[{{$E}}] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return b.Equal(a) && b.SetBytes(buf[1:]) != nil
+		},
+		genA,
+	))
+
+	properties.Property("[{{$E}}] Cmp should be consistent with LexicographicallyLargest", prop.ForAll(
+		func(a *{{$E}}) bool {
+			var b {{$E}}
+			b.Neg(a)
+			if a.IsZero() {
+				return !a.LexicographicallyLargest() && a.Cmp(&b) == 0
+			}
+			return a.LexicographicallyLargest() == (a.Cmp(&b) == 1)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func Benchmark{{$E}}Mul(b *testing.B) {
+	var a, c {{$E}}
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func Benchmark{{$E}}Square(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func Benchmark{{$E}}Inverse(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func Benchmark{{$E}}Sqrt(b *testing.B) {
+	var a {{$E}}
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+`
@@ -17,5 +17,7 @@ package cmd
 import "errors"
 
 var (
-	errMissingArgument = errors.New("missing argument")
+	errMissingArgument   = errors.New("missing argument")
+	errUnsupportedTower  = errors.New("unsupported extension degree, --tower only accepts 2, 3 and 6")
+	errInvalidNonResidue = errors.New("invalid non-residue, --e6-non-residue takes the two coordinates of ξ = a+b⋅u")
 )
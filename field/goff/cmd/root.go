@@ -19,7 +19,9 @@ import (
 	"fmt"
 	"math/bits"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/consensys/gnark-crypto/field/generator"
@@ -40,6 +42,11 @@ var (
 	fOutputDir   string
 	fPackageName string
 	fElementName string
+
+	fTower        []int
+	fE2NonResidue int64
+	fE3NonResidue int64
+	fE6NonResidue []int64
 )
 
 func init() {
@@ -48,6 +55,10 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&fModulus, "modulus", "m", "", "field modulus (base 10)")
 	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path to create output files")
 	rootCmd.PersistentFlags().StringVarP(&fPackageName, "package", "p", "", "package name in generated files")
+	rootCmd.PersistentFlags().IntSliceVarP(&fTower, "tower", "t", nil, "degrees of the extensions to generate in <output>/extensions, among 2, 3 and 6 (E6 is a cubic extension of E2)")
+	rootCmd.PersistentFlags().Int64Var(&fE2NonResidue, "e2-non-residue", 0, "α such that E2 = Fp[u]/(u²-α) (default: picked by the generator)")
+	rootCmd.PersistentFlags().Int64Var(&fE3NonResidue, "e3-non-residue", 0, "α such that E3 = Fp[u]/(u³-α) (default: picked by the generator)")
+	rootCmd.PersistentFlags().Int64SliceVar(&fE6NonResidue, "e6-non-residue", nil, "coordinates a,b of ξ = a+b⋅u such that E6 = E2[v]/(v³-ξ) (default: picked by the generator)")
 	if bits.UintSize != 64 {
 		panic("goff only supports 64bits architectures")
 	}
@@ -75,6 +86,81 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
 	}
+	if len(fTower) != 0 {
+		if err := generateTower(F); err != nil {
+			fmt.Printf("\n%s\n", err.Error())
+			os.Exit(-1)
+		}
+	}
+}
+
+// generateTower generates in <output>/extensions the extensions of F listed by --tower
+func generateTower(F *field.FieldConfig) error {
+	var e2, e3 *field.Extension
+	var towers []field.TowerExtension
+
+	newExtension := func(degree uint8, rootOf int64) (*field.Extension, error) {
+		if rootOf == 0 {
+			var err error
+			if rootOf, err = field.FindRootOf(F, degree); err != nil {
+				return nil, err
+			}
+		}
+		ext := field.NewTower(F, degree, rootOf)
+		fmt.Printf("E%d = Fp[u]/(u^%d - (%d))\n", degree, degree, rootOf)
+		return &ext, nil
+	}
+
+	var err error
+	for _, degree := range fTower {
+		switch degree {
+		case 2, 6:
+			if e2 == nil {
+				if e2, err = newExtension(2, fE2NonResidue); err != nil {
+					return err
+				}
+			}
+		case 3:
+			if e3, err = newExtension(3, fE3NonResidue); err != nil {
+				return err
+			}
+		}
+		if degree != 6 {
+			continue
+		}
+		e6 := field.NewCubicExtension(e2, fE6NonResidue...)
+		if len(fE6NonResidue) == 0 {
+			if e6.NonResidue, err = e2.FindNonResidue(3); err != nil {
+				return err
+			}
+		}
+		towers = append(towers, e6)
+		fmt.Printf("E6 = E2[v]/(v^3 - (%s + %s*u))\n", e6.NonResidue[0].String(), e6.NonResidue[1].String())
+	}
+
+	var extensions []field.Extension
+	for _, e := range []*field.Extension{e2, e3} {
+		if e != nil {
+			extensions = append(extensions, *e)
+		}
+	}
+
+	basePackagePath, err := importPath(fOutputDir)
+	if err != nil {
+		return err
+	}
+	return generator.GenerateExtensions(basePackagePath, filepath.Join(fOutputDir, "extensions"), extensions, towers...)
+}
+
+// importPath returns the import path of the package in dir
+func importPath(dir string) (string, error) {
+	cmd := exec.Command("go", "list", "-f", "{{.ImportPath}}", ".")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("could not find the import path of %s, is it in a go module? %w", dir, err)
+	}
+	return strings.TrimSpace(string(out)), nil
 }
 
 func parseFlags(cmd *cobra.Command) error {
@@ -85,9 +171,19 @@ func parseFlags(cmd *cobra.Command) error {
 		return errMissingArgument
 	}
 
+	for _, degree := range fTower {
+		if degree != 2 && degree != 3 && degree != 6 {
+			return errUnsupportedTower
+		}
+	}
+	if len(fE6NonResidue) > 2 {
+		return errInvalidNonResidue
+	}
+
 	// clean inputs
 	fOutputDir = filepath.Clean(fOutputDir)
 	fPackageName = strings.ToLower(fPackageName)
+	sort.Ints(fTower)
 
 	return nil
 }
@@ -20,6 +20,11 @@
 //
 //	goff -m 0xffffffff00000001 -o ./goldilocks/ -p goldilocks -e Element
 //
+// Extensions of the field can be generated in the extensions sub-package with --tower;
+// the non-residues are picked by the generator unless given with --e2-non-residue, --e3-non-residue or --e6-non-residue:
+//
+//	goff -m 21888242871839275222246405745257275088696311157297823662689037894645226208583 -o ./bn254/ -p bn254 -e Element --tower 2,6 --e6-non-residue 9,1
+//
 // # Warning
 //
 // The generated code has not been audited for all moduli (only bn254 and bls12-381) and is provided as-is. In particular, there is no security guarantees such as constant time implementation or side-channel attack resistance.
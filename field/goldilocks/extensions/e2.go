@@ -0,0 +1,402 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E2 is a degree 2 extension of goldilocks.Element: E2 = 𝔽p[u]/(u² - 7)
+type E2 struct {
+	A0, A1 goldilocks.Element
+}
+
+// SizeOfE2 is the number of bytes needed to represent an element of E2
+const SizeOfE2 = 2 * goldilocks.Bytes
+
+// nonResidueE2 is u² = 7
+var nonResidueE2 = goldilocks.Element{30064771065}
+
+// frobCoeffE2[i-1] = u^(i(p-1)) for 1 ≤ i < 2
+var frobCoeffE2 = [1]goldilocks.Element{
+	{18446744065119617026},
+}
+
+// Tonelli-Shanks constants: p²-1 = 2ˢ⋅m with m odd and
+// sqrtGE2 = gᵐ for a quadratic non-residue g
+const sqrtSE2 = 33
+
+var sqrtGE2 = E2{
+	A0: goldilocks.Element{0},
+	A1: goldilocks.Element{5882816312994834096},
+}
+
+var sqrtME2, sqrtMPlusOneHalfE2 big.Int
+
+func init() {
+	sqrtME2.SetString("39614081238685424729504874495", 10)
+	sqrtMPlusOneHalfE2.SetString("19807040619342712364752437248", 10)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E2) Equal(x *E2) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E2) Cmp(x *E2) int {
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *E2) LexicographicallyLargest() bool {
+	if !z.A1.IsZero() {
+		return z.A1.LexicographicallyLargest()
+	}
+	return z.A0.LexicographicallyLargest()
+}
+
+// SetString sets z from the base 10 strings of its coordinates and returns z
+func (z *E2) SetString(s0, s1 string) (*E2, error) {
+	if _, err := z.A0.SetString(s0); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetString(s1); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E2) SetZero() *E2 {
+	*z = E2{}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E2) Set(x *E2) *E2 {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E2) SetOne() *E2 {
+	*z = E2{}
+	z.A0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E2) SetRandom() (*E2, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E2) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E2) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// Add sets z to x+y and returns z
+func (z *E2) Add(x, y *E2) *E2 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *E2) Sub(x, y *E2) *E2 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z to 2x and returns z
+func (z *E2) Double(x *E2) *E2 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z to -x and returns z
+func (z *E2) Neg(x *E2) *E2 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Halve sets z to z/2
+func (z *E2) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E2) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u"
+}
+
+// MulByElement sets z to x*y with y in the base field and returns z
+func (z *E2) MulByElement(x *E2, y *goldilocks.Element) *E2 {
+	_y := *y
+	z.A0.Mul(&x.A0, &_y)
+	z.A1.Mul(&x.A1, &_y)
+	return z
+}
+
+// MulByNonResidue sets z to x*u and returns z
+func (z *E2) MulByNonResidue(x *E2) *E2 {
+	z.A0, z.A1 = x.A1, x.A0
+	z.A0.Mul(&z.A0, &nonResidueE2)
+	return z
+}
+
+// Mul sets z to x*y and returns z
+func (z *E2) Mul(x, y *E2) *E2 {
+	// Karatsuba
+	var t0, t1, s0, s1 goldilocks.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	s0.Add(&x.A0, &x.A1)
+	s1.Add(&y.A0, &y.A1)
+	z.A1.Mul(&s0, &s1).Sub(&z.A1, &t0).Sub(&z.A1, &t1)
+	z.A0.Mul(&t1, &nonResidueE2).Add(&z.A0, &t0)
+	return z
+}
+
+// Square sets z to x*x and returns z
+func (z *E2) Square(x *E2) *E2 {
+	// (a0 + a1u)² = a0² + αa1² + 2a0a1u
+	var t0, t1 goldilocks.Element
+	t0.Square(&x.A0)
+	t1.Square(&x.A1).Mul(&t1, &nonResidueE2)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Add(&t0, &t1)
+	return z
+}
+
+// Inverse sets z to x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E2) Inverse(x *E2) *E2 {
+	// (a0 + a1u)⁻¹ = (a0 - a1u) / (a0² - αa1²)
+	var t goldilocks.Element
+	x.norm(&t)
+	t.Inverse(&t)
+	z.A0.Mul(&x.A0, &t)
+	z.A1.Mul(&x.A1, &t).Neg(&z.A1)
+	return z
+}
+
+// BatchInvertE2 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE2(a []E2) []E2 {
+	res := make([]E2, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E2
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *E2) Div(x, y *E2) *E2 {
+	var r E2
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *E2) Select(c int, x0, x1 *E2) *E2 {
+	z.A0.Select(c, &x0.A0, &x1.A0)
+	z.A1.Select(c, &x0.A1, &x1.A1)
+	return z
+}
+
+// Frobenius sets z to xᵖ and returns z
+func (z *E2) Frobenius(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobCoeffE2[0])
+	return z
+}
+
+// Conjugate sets z to the conjugate a0 - a1u of x = a0 + a1u and returns z.
+// It is equal to the Frobenius xᵖ.
+func (z *E2) Conjugate(x *E2) *E2 {
+	z.A0 = x.A0
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// norm sets n to the norm of z, i.e. the product of its conjugates
+func (z *E2) norm(n *goldilocks.Element) {
+	// N(a0+a1u) = a0² - αa1²
+	var t goldilocks.Element
+	t.Square(&z.A1).Mul(&t, &nonResidueE2)
+	n.Square(&z.A0).Sub(n, &t)
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *E2) Legendre() int {
+	var n goldilocks.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ and returns it
+func (z *E2) Exp(x E2, k *big.Int) *E2 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Sqrt z = √x and returns z, or nil if x is not a square.
+//
+// Tonelli-Shanks with p²-1 = 2ˢ⋅m, s = 33
+func (z *E2) Sqrt(x *E2) *E2 {
+	if x.IsZero() {
+		return z.SetZero()
+	}
+
+	var c, t, r, b E2
+	c.Set(&sqrtGE2)
+	t.Exp(*x, &sqrtME2)
+	r.Exp(*x, &sqrtMPlusOneHalfE2)
+	s := sqrtSE2
+
+	for !t.IsOne() {
+		// find the least i such that t^(2^i) = 1
+		i := 0
+		b.Set(&t)
+		for !b.IsOne() {
+			b.Square(&b)
+			i++
+			if i == s {
+				return nil
+			}
+		}
+
+		b.Set(&c)
+		for j := 0; j < s-i-1; j++ {
+			b.Square(&b)
+		}
+		s = i
+		c.Square(&b)
+		t.Mul(&t, &c)
+		r.Mul(&r, &b)
+	}
+
+	return z.Set(&r)
+}
+
+// Bytes returns the value of z as a big-endian byte array, coordinates A0 first
+func (z *E2) Bytes() (res [SizeOfE2]byte) {
+	goldilocks.BigEndian.PutElement((*[goldilocks.Bytes]byte)(res[0*goldilocks.Bytes:1*goldilocks.Bytes]), z.A0)
+	goldilocks.BigEndian.PutElement((*[goldilocks.Bytes]byte)(res[1*goldilocks.Bytes:2*goldilocks.Bytes]), z.A1)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice, coordinates A0 first
+func (z *E2) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian E2, coordinates A0 first, and sets z to that value.
+// It returns an error if e doesn't have the right size or if a coordinate is not canonical.
+func (z *E2) SetBytes(e []byte) error {
+	if len(e) != SizeOfE2 {
+		return errors.New("invalid E2 encoding")
+	}
+	var err error
+	if z.A0, err = goldilocks.BigEndian.Element((*[goldilocks.Bytes]byte)(e[0*goldilocks.Bytes : 1*goldilocks.Bytes])); err != nil {
+		return err
+	}
+	if z.A1, err = goldilocks.BigEndian.Element((*[goldilocks.Bytes]byte)(e[1*goldilocks.Bytes : 2*goldilocks.Bytes])); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,465 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E3 is a degree 3 extension of goldilocks.Element: E3 = 𝔽p[u]/(u³ - 7)
+type E3 struct {
+	A0, A1, A2 goldilocks.Element
+}
+
+// SizeOfE3 is the number of bytes needed to represent an element of E3
+const SizeOfE3 = 3 * goldilocks.Bytes
+
+// nonResidueE3 is u³ = 7
+var nonResidueE3 = goldilocks.Element{30064771065}
+
+// frobCoeffE3[i-1] = u^(i(p-1)) for 1 ≤ i < 3
+var frobCoeffE3 = [2]goldilocks.Element{
+	{1},
+	{18446744065119617025},
+}
+
+// Tonelli-Shanks constants: p³-1 = 2ˢ⋅m with m odd and
+// sqrtGE3 = gᵐ for a quadratic non-residue g
+const sqrtSE3 = 32
+
+var sqrtGE3 = E3{
+	A0: goldilocks.Element{15733474329512464024},
+	A1: goldilocks.Element{0},
+	A2: goldilocks.Element{0},
+}
+
+var sqrtME3, sqrtMPlusOneHalfE3 big.Int
+
+func init() {
+	sqrtME3.SetString("1461501636310055817916238417282618014431694553085", 10)
+	sqrtMPlusOneHalfE3.SetString("730750818155027908958119208641309007215847276543", 10)
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E3) Cmp(x *E3) int {
+	if a2 := z.A2.Cmp(&x.A2); a2 != 0 {
+		return a2
+	}
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *E3) LexicographicallyLargest() bool {
+	if !z.A2.IsZero() {
+		return z.A2.LexicographicallyLargest()
+	}
+	if !z.A1.IsZero() {
+		return z.A1.LexicographicallyLargest()
+	}
+	return z.A0.LexicographicallyLargest()
+}
+
+// SetString sets z from the base 10 strings of its coordinates and returns z
+func (z *E3) SetString(s0, s1, s2 string) (*E3, error) {
+	if _, err := z.A0.SetString(s0); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetString(s1); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetString(s2); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E3) SetZero() *E3 {
+	*z = E3{}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E3) Set(x *E3) *E3 {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E3) SetOne() *E3 {
+	*z = E3{}
+	z.A0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add sets z to x+y and returns z
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double sets z to 2x and returns z
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg sets z to -x and returns z
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// Halve sets z to z/2
+func (z *E3) Halve() {
+	z.A0.Halve()
+	z.A1.Halve()
+	z.A2.Halve()
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E3) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u+" + z.A2.String() + "*u**2"
+}
+
+// MulByElement sets z to x*y with y in the base field and returns z
+func (z *E3) MulByElement(x *E3, y *goldilocks.Element) *E3 {
+	_y := *y
+	z.A0.Mul(&x.A0, &_y)
+	z.A1.Mul(&x.A1, &_y)
+	z.A2.Mul(&x.A2, &_y)
+	return z
+}
+
+// MulByNonResidue sets z to x*u and returns z
+func (z *E3) MulByNonResidue(x *E3) *E3 {
+	z.A0, z.A1, z.A2 = x.A2, x.A0, x.A1
+	z.A0.Mul(&z.A0, &nonResidueE3)
+	return z
+}
+
+// Mul sets z to x*y and returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Algorithm 13 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, c0, c1, c2, tmp goldilocks.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c0.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2).Mul(&c0, &nonResidueE3)
+
+	tmp.Add(&x.A0, &x.A2)
+	c2.Add(&y.A0, &y.A2).Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2)
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	t2.Mul(&t2, &nonResidueE3)
+
+	z.A0.Add(&c0, &t0)
+	z.A1.Add(&c1, &t2)
+	z.A2.Add(&c2, &t1)
+	return z
+}
+
+// Square sets z to x*x and returns z
+func (z *E3) Square(x *E3) *E3 {
+	// Algorithm 16 from https://eprint.iacr.org/2010/354.pdf
+	var c4, c5, c1, c2, c3, c0, c6 goldilocks.Element
+
+	c6.Double(&x.A1)
+	c4.Mul(&x.A0, &c6) // x.A0 * xA1 * 2
+	c5.Square(&x.A2)
+	c1.Mul(&c5, &nonResidueE3).Add(&c1, &c4)
+	c2.Sub(&c4, &c5)
+
+	c3.Square(&x.A0)
+	c4.Sub(&x.A0, &x.A1).Add(&c4, &x.A2)
+	c5.Mul(&c6, &x.A2) // x.A1 * xA2 * 2
+	c4.Square(&c4)
+	c0.Mul(&c5, &nonResidueE3)
+	c4.Add(&c4, &c5).Sub(&c4, &c3)
+
+	z.A0.Add(&c0, &c3)
+	z.A1 = c1
+	z.A2.Add(&c2, &c4)
+	return z
+}
+
+// Inverse sets z to x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E3) Inverse(x *E3) *E3 {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	// step 9 is wrong in the paper it's t1-t4
+	var t0, t1, t2, t3, t4, t5, t6, c0, c1, c2, d1, d2 goldilocks.Element
+	t0.Square(&x.A0)
+	t1.Square(&x.A1)
+	t2.Square(&x.A2)
+	t3.Mul(&x.A0, &x.A1)
+	t4.Mul(&x.A0, &x.A2)
+	t5.Mul(&x.A1, &x.A2)
+	c0.Mul(&t5, &nonResidueE3).Neg(&c0).Add(&c0, &t0)
+	c1.Mul(&t2, &nonResidueE3).Sub(&c1, &t3)
+	c2.Sub(&t1, &t4)
+	t6.Mul(&x.A0, &c0)
+	d1.Mul(&x.A2, &c1)
+	d2.Mul(&x.A1, &c2)
+	d1.Add(&d1, &d2).Mul(&d1, &nonResidueE3)
+	t6.Add(&t6, &d1)
+	t6.Inverse(&t6)
+	z.A0.Mul(&c0, &t6)
+	z.A1.Mul(&c1, &t6)
+	z.A2.Mul(&c2, &t6)
+	return z
+}
+
+// BatchInvertE3 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *E3) Div(x, y *E3) *E3 {
+	var r E3
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *E3) Select(c int, x0, x1 *E3) *E3 {
+	z.A0.Select(c, &x0.A0, &x1.A0)
+	z.A1.Select(c, &x0.A1, &x1.A1)
+	z.A2.Select(c, &x0.A2, &x1.A2)
+	return z
+}
+
+// Frobenius sets z to xᵖ and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobCoeffE3[0])
+	z.A2.Mul(&x.A2, &frobCoeffE3[1])
+	return z
+}
+
+// norm sets n to the norm of z, i.e. the product of its conjugates
+func (z *E3) norm(n *goldilocks.Element) {
+	// N(a0+a1u+a2u²) = a0³ + αa1³ + α²a2³ - 3αa0a1a2
+	var t0, t1, t2, t3 goldilocks.Element
+	t0.Square(&z.A0).Mul(&t0, &z.A0)
+	t1.Square(&z.A1).Mul(&t1, &z.A1).Mul(&t1, &nonResidueE3)
+	t2.Square(&z.A2).Mul(&t2, &z.A2).Mul(&t2, &nonResidueE3).Mul(&t2, &nonResidueE3)
+	t3.Mul(&z.A0, &z.A1).Mul(&t3, &z.A2).Mul(&t3, &nonResidueE3)
+	n.Double(&t3).Add(n, &t3)
+	n.Sub(&t0, n).Add(n, &t1).Add(n, &t2)
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *E3) Legendre() int {
+	var n goldilocks.Element
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ and returns it
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Sqrt z = √x and returns z, or nil if x is not a square.
+//
+// Tonelli-Shanks with p³-1 = 2ˢ⋅m, s = 32
+func (z *E3) Sqrt(x *E3) *E3 {
+	if x.IsZero() {
+		return z.SetZero()
+	}
+
+	var c, t, r, b E3
+	c.Set(&sqrtGE3)
+	t.Exp(*x, &sqrtME3)
+	r.Exp(*x, &sqrtMPlusOneHalfE3)
+	s := sqrtSE3
+
+	for !t.IsOne() {
+		// find the least i such that t^(2^i) = 1
+		i := 0
+		b.Set(&t)
+		for !b.IsOne() {
+			b.Square(&b)
+			i++
+			if i == s {
+				return nil
+			}
+		}
+
+		b.Set(&c)
+		for j := 0; j < s-i-1; j++ {
+			b.Square(&b)
+		}
+		s = i
+		c.Square(&b)
+		t.Mul(&t, &c)
+		r.Mul(&r, &b)
+	}
+
+	return z.Set(&r)
+}
+
+// Bytes returns the value of z as a big-endian byte array, coordinates A0 first
+func (z *E3) Bytes() (res [SizeOfE3]byte) {
+	goldilocks.BigEndian.PutElement((*[goldilocks.Bytes]byte)(res[0*goldilocks.Bytes:1*goldilocks.Bytes]), z.A0)
+	goldilocks.BigEndian.PutElement((*[goldilocks.Bytes]byte)(res[1*goldilocks.Bytes:2*goldilocks.Bytes]), z.A1)
+	goldilocks.BigEndian.PutElement((*[goldilocks.Bytes]byte)(res[2*goldilocks.Bytes:3*goldilocks.Bytes]), z.A2)
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice, coordinates A0 first
+func (z *E3) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian E3, coordinates A0 first, and sets z to that value.
+// It returns an error if e doesn't have the right size or if a coordinate is not canonical.
+func (z *E3) SetBytes(e []byte) error {
+	if len(e) != SizeOfE3 {
+		return errors.New("invalid E3 encoding")
+	}
+	var err error
+	if z.A0, err = goldilocks.BigEndian.Element((*[goldilocks.Bytes]byte)(e[0*goldilocks.Bytes : 1*goldilocks.Bytes])); err != nil {
+		return err
+	}
+	if z.A1, err = goldilocks.BigEndian.Element((*[goldilocks.Bytes]byte)(e[1*goldilocks.Bytes : 2*goldilocks.Bytes])); err != nil {
+		return err
+	}
+	if z.A2, err = goldilocks.BigEndian.Element((*[goldilocks.Bytes]byte)(e[2*goldilocks.Bytes : 3*goldilocks.Bytes])); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE3ReceiverIsOperand(t *testing.T) {
+	var a, b, c, d E3
+	a.SetRandom()
+	b.SetRandom()
+
+	c.Mul(&a, &b)
+	d.Set(&a)
+	d.Mul(&d, &b)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as first operand failed")
+	}
+	d.Set(&b)
+	d.Mul(&a, &d)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as second operand failed")
+	}
+
+	c.Square(&a)
+	d.Set(&a)
+	d.Square(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Square: receiver as operand failed")
+	}
+
+	c.Inverse(&a)
+	d.Set(&a)
+	d.Inverse(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Inverse: receiver as operand failed")
+	}
+
+	c.Frobenius(&a)
+	d.Set(&a)
+	d.Frobenius(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Frobenius: receiver as operand failed")
+	}
+}
+
+func TestE3Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE3()
+	genB := genE3()
+	genE := genBaseElement()
+
+	properties.Property("[E3] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] mul should match the schoolbook product modulo u³ - 7", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.Mul(a, b)
+			schoolbookMulE3(&d, a, b)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] Div should be the same as mul by inverse", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.Div(a, b)
+			d.Inverse(b).Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] BatchInvertE3 should output the same result as Inverse", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvertE3([]E3{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E3] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Double and add twice should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Add(a, a)
+			c.Double(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Halve should be the inverse of Double", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Double(a)
+			b.Halve()
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Neg should be the additive inverse", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Neg(a).Add(&b, a)
+			return b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E3] MulByElement MulByElement inverse should leave an element invariant", prop.ForAll(
+		func(a *E3, b goldilocks.Element) bool {
+			var c E3
+			var d goldilocks.Element
+			d.Inverse(&b)
+			c.MulByElement(a, &b).MulByElement(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[E3] MulByNonResidue should be the same as multiplying by u", prop.ForAll(
+		func(a *E3) bool {
+			var b, c, u E3
+			u.A1.SetOne()
+			b.Mul(a, &u)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Frobenius should be the same as Exp(p)", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Frobenius(a)
+			c.Exp(*a, goldilocks.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Frobenius applied 3 times should be the identity", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Set(a)
+			for i := 0; i < 3; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Exp with a negative exponent should be the inverse", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Legendre on square should output 1", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Legendre should match Euler's criterion", prop.ForAll(
+		func(a *E3) bool {
+			var b, minusOne E3
+			e := new(big.Int).Exp(goldilocks.Modulus(), big.NewInt(3), nil)
+			e.Sub(e, big.NewInt(1)).Rsh(e, 1)
+			b.Exp(*a, e)
+			minusOne.SetOne().Neg(&minusOne)
+			switch a.Legendre() {
+			case 1:
+				return b.IsOne()
+			case -1:
+				return b.Equal(&minusOne)
+			}
+			return false
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Sqrt of a square should be a square root", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil
+		},
+		genA,
+	))
+
+	properties.Property("[E3] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return b.Equal(a) && b.SetBytes(buf[1:]) != nil
+		},
+		genA,
+	))
+
+	properties.Property("[E3] Cmp should be consistent with LexicographicallyLargest", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Neg(a)
+			if a.IsZero() {
+				return !a.LexicographicallyLargest() && a.Cmp(&b) == 0
+			}
+			return a.LexicographicallyLargest() == (a.Cmp(&b) == 1)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE3SetBytesNonCanonical(t *testing.T) {
+	var a E3
+	buf := make([]byte, SizeOfE3)
+	for i := range buf {
+		buf[i] = 0xff
+	}
+	if err := a.SetBytes(buf); err == nil {
+		t.Fatal("SetBytes should reject non canonical coordinates")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE3Add(b *testing.B) {
+	var a, c E3
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Add(&a, &c)
+	}
+}
+
+func BenchmarkE3Mul(b *testing.B) {
+	var a, c E3
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE3Square(b *testing.B) {
+	var a E3
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE3Inverse(b *testing.B) {
+	var a E3
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE3Sqrt(b *testing.B) {
+	var a E3
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
+
+// schoolbookMulE3 computes x*y as polynomials in u and reduces with u³ = 7
+func schoolbookMulE3(z, x, y *E3) {
+	a := [...]goldilocks.Element{x.A0, x.A1, x.A2}
+	b := [...]goldilocks.Element{y.A0, y.A1, y.A2}
+	var c [2*3 - 1]goldilocks.Element
+	for i := range a {
+		for j := range b {
+			var t goldilocks.Element
+			t.Mul(&a[i], &b[j])
+			c[i+j].Add(&c[i+j], &t)
+		}
+	}
+	var alpha goldilocks.Element
+	alpha.SetInt64(7)
+	for i := len(c) - 1; i >= 3; i-- {
+		var t goldilocks.Element
+		t.Mul(&c[i], &alpha)
+		c[i-3].Add(&c[i-3], &t)
+	}
+	z.A0 = c[0]
+	z.A1 = c[1]
+	z.A2 = c[2]
+}
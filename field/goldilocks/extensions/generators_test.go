@@ -0,0 +1,55 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/leanovate/gopter"
+)
+
+// genBaseElement generates a random goldilocks.Element
+func genBaseElement() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a goldilocks.Element
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(a, gopter.NoShrinker)
+	}
+}
+
+// genE2 generates a random E2 element
+func genE2() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a E2
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&a, gopter.NoShrinker)
+	}
+}
+
+// genE3 generates a random E3 element
+func genE3() gopter.Gen {
+	return func(genParams *gopter.GenParameters) *gopter.GenResult {
+		var a E3
+		if _, err := a.SetRandom(); err != nil {
+			panic(err)
+		}
+		return gopter.NewGenResult(&a, gopter.NoShrinker)
+	}
+}
@@ -14,8 +14,16 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	goldilocks.ParallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
 	if err := generator.GenerateFF(goldilocks, "../"); err != nil {
 		panic(err)
 	}
+
+	// 7 generates 𝔽p*, so it is neither a square nor a cube: u²-7 and u³-7 are irreducible
+	e2 := config.NewTower(goldilocks, 2, 7)
+	e3 := config.NewTower(goldilocks, 3, 7)
+	if err := generator.GenerateExtensions("github.com/consensys/gnark-crypto/field/goldilocks", "../extensions", []config.Extension{e2, e3}); err != nil {
+		panic(err)
+	}
 	fmt.Println("successfully generated goldilocks field")
 }
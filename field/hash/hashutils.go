@@ -3,14 +3,31 @@ package hash
 import (
 	"crypto/sha256"
 	"errors"
+	"hash"
+
+	"golang.org/x/crypto/blake2b"
 )
 
 // ExpandMsgXmd expands msg to a slice of lenInBytes bytes.
 // https://tools.ietf.org/html/draft-irtf-cfrg-hash-to-curve-06#section-5
 // https://tools.ietf.org/html/rfc8017#section-4.1 (I2OSP/O2ISP)
 func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	return expandMsgXmd(sha256.New(), msg, dst, lenInBytes)
+}
+
+// ExpandMsgXmdBlake2b expands msg to a slice of lenInBytes bytes with expand_message_xmd and BLAKE2b-512,
+// as the hash_to_field of the Zcash pasta_curves crate.
+// https://www.rfc-editor.org/rfc/rfc9380#section-5.3.1
+func ExpandMsgXmdBlake2b(msg, dst []byte, lenInBytes int) ([]byte, error) {
+	h, err := blake2b.New512(nil)
+	if err != nil {
+		return nil, err
+	}
+	return expandMsgXmd(h, msg, dst, lenInBytes)
+}
 
-	h := sha256.New()
+// expandMsgXmd implements expand_message_xmd with h.
+func expandMsgXmd(h hash.Hash, msg, dst []byte, lenInBytes int) ([]byte, error) {
 	ell := (lenInBytes + h.Size() - 1) / h.Size() // ceil(len_in_bytes / b_in_bytes)
 	if ell > 255 {
 		return nil, errors.New("invalid lenInBytes")
@@ -59,7 +76,7 @@ func ExpandMsgXmd(msg, dst []byte, lenInBytes int) ([]byte, error) {
 	b1 := h.Sum(nil)
 
 	res := make([]byte, lenInBytes)
-	copy(res[:h.Size()], b1)
+	copy(res, b1)
 
 	for i := 2; i <= ell; i++ {
 		// b_i = H(strxor(b₀, b_(i - 1)) ∥ I2OSP(i, 1) ∥ DST_prime)
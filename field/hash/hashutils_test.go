@@ -130,3 +130,51 @@ func TestExpandMsgXmd(t *testing.T) {
 		}
 	}
 }
+
+// Test vectors of expand_message_xmd with BLAKE2b-512, computed with Python's hashlib.
+// The hash_to_field of ecc/pallas and ecc/vesta is tested against the one of the pasta_curves crate.
+func TestExpandMsgXmdBlake2b(t *testing.T) {
+	dst := "z.cash:test-pallas_XMD:BLAKE2b_SSWU_RO_"
+
+	testCases := []expandMsgXmdTestCase{
+		{
+			"",
+			0x80,
+			"451c791366c19c55aab6d21133b683a5ec2a2f1225255fd1d17f015d5d1307c516dbfae0c6cf24b5546fb85d596c1851506c14f039fd0324cb4acfa55bbd5508e9347790e5dc5ae070bc45be155ca3d978856fccc7bca4b7ebfb87edbecc308facd622dc2940963c177bac5627be821069e54fc140969dbfb3e8e82458643e9c",
+		},
+
+		{
+			"abc",
+			0x80,
+			"2a1280c3eb96e3fc2f5508406288f3195215ee5224880b08f01f3edd782697b2d36d9ff9937f2aa8ce955dc8c15fdffe0ce739c3643ae6dbe04b734e61feb02415b1e65cac1483695ef65c203eea563c38a648de8dbcbfeb3157e1f733b59a4e44b66987f5bebc3738937c4c623917a05f8e59fc39180c8ff3827b698a52b9a6",
+		},
+
+		{
+			"abcdef0123456789",
+			0x40,
+			"2158eb0316db893c531ecc9386d3c4412d26580cb78c47f1c3925007c2d171a118077f23b82e9b531ddb68cbdf2604314aaff471356f918a848f36ad2d8153fc",
+		},
+
+		{
+			"q128_qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq",
+			0x20,
+			"ab1620ffdcd6c4520fd33bb1fdb5291614c8c74c2a3e26af0f11fde2aecb6d0e",
+		},
+	}
+
+	for _, testCase := range testCases {
+		uniformBytes, err := ExpandMsgXmdBlake2b([]byte(testCase.msg), []byte(dst), testCase.lenInBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCaseUniformBytes, err := hex.DecodeString(testCase.uniformBytesHex)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(uniformBytes, testCaseUniformBytes) {
+			t.Errorf("expected \"%s\" got \"%x\"", testCase.uniformBytesHex, uniformBytes)
+		}
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/koalabear"
+)
+
+// E4 is a degree 4 extension of 𝔽p: E4 = E2[v]/(v²-ξ), ξ = u
+type E4 struct {
+	B0, B1 E2
+}
+
+// SizeOfE4 is the number of bytes needed to represent an element of E4
+const SizeOfE4 = 2 * SizeOfE2
+
+// nonResidueE4 is v² = ξ = u
+var nonResidueE4 = E2{A0: koalabear.Element{0}, A1: koalabear.Element{402124772}}
+
+// frobCoeffE4 = v^(p-1) = ξ^((p-1)/2)
+var frobCoeffE4 = E2{A0: koalabear.Element{1930170389}, A1: koalabear.Element{0}}
+
+// Equal returns true if z equals x, false otherwise
+func (z *E4) Equal(x *E4) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E4) Cmp(x *E4) int {
+	if b1 := z.B1.Cmp(&x.B1); b1 != 0 {
+		return b1
+	}
+	return z.B0.Cmp(&x.B0)
+}
+
+// LexicographicallyLargest returns true if this element is strictly lexicographically
+// larger than its negation, false otherwise
+func (z *E4) LexicographicallyLargest() bool {
+	if !z.B1.IsZero() {
+		return z.B1.LexicographicallyLargest()
+	}
+	return z.B0.LexicographicallyLargest()
+}
+
+// SetZero sets z to 0 and returns z
+func (z *E4) SetZero() *E4 {
+	*z = E4{}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *E4) Set(x *E4) *E4 {
+	*z = *x
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *E4) SetOne() *E4 {
+	*z = E4{}
+	z.B0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a uniform random value and returns z
+func (z *E4) SetRandom() (*E4, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// IsZero returns true if z is 0, false otherwise
+func (z *E4) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is 1, false otherwise
+func (z *E4) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// Add sets z to x+y and returns z
+func (z *E4) Add(x, y *E4) *E4 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *E4) Sub(x, y *E4) *E4 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z to 2x and returns z
+func (z *E4) Double(x *E4) *E4 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg sets z to -x and returns z
+func (z *E4) Neg(x *E4) *E4 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Halve sets z to z/2
+func (z *E4) Halve() {
+	z.B0.Halve()
+	z.B1.Halve()
+}
+
+// String implements Stringer interface for fancy printing
+func (z *E4) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*v"
+}
+
+// MulByElement sets z to x*y with y in E2 and returns z
+func (z *E4) MulByElement(x *E4, y *E2) *E4 {
+	_y := *y
+	z.B0.Mul(&x.B0, &_y)
+	z.B1.Mul(&x.B1, &_y)
+	return z
+}
+
+// MulByNonResidue sets z to x*v and returns z
+func (z *E4) MulByNonResidue(x *E4) *E4 {
+	z.B0, z.B1 = x.B1, x.B0
+	mulByNonResidueE4(&z.B0, &z.B0)
+	return z
+}
+
+// Mul sets z to x*y and returns z
+func (z *E4) Mul(x, y *E4) *E4 {
+	// Karatsuba
+	var t0, t1, s0, s1 E2
+	t0.Mul(&x.B0, &y.B0)
+	t1.Mul(&x.B1, &y.B1)
+	s0.Add(&x.B0, &x.B1)
+	s1.Add(&y.B0, &y.B1)
+	z.B1.Mul(&s0, &s1).Sub(&z.B1, &t0).Sub(&z.B1, &t1)
+	mulByNonResidueE4(&z.B0, &t1)
+	z.B0.Add(&z.B0, &t0)
+	return z
+}
+
+// Square sets z to x*x and returns z
+func (z *E4) Square(x *E4) *E4 {
+	// (b0 + b1v)² = b0² + ξb1² + 2b0b1v
+	var t0, t1 E2
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	mulByNonResidueE4(&t1, &t1)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&t0, &t1)
+	return z
+}
+
+// Inverse sets z to x⁻¹ and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E4) Inverse(x *E4) *E4 {
+	// (b0 + b1v)⁻¹ = (b0 - b1v) / (b0² - ξb1²)
+	var t E2
+	x.norm(&t)
+	t.Inverse(&t)
+	z.B0.Mul(&x.B0, &t)
+	z.B1.Mul(&x.B1, &t).Neg(&z.B1)
+	return z
+}
+
+// BatchInvertE4 returns a new slice with every element inverted.
+// Uses Montgomery batch inversion trick
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE4(a []E4) []E4 {
+	res := make([]E4, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E4
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// Div sets z to x/y and returns z
+func (z *E4) Div(x, y *E4) *E4 {
+	var r E4
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Select is a constant-time conditional move.
+// If c=0, z = x0. Else z = x1
+func (z *E4) Select(c int, x0, x1 *E4) *E4 {
+	z.B0.Select(c, &x0.B0, &x1.B0)
+	z.B1.Select(c, &x0.B1, &x1.B1)
+	return z
+}
+
+// Frobenius sets z to xᵖ and returns z
+func (z *E4) Frobenius(x *E4) *E4 {
+	z.B0.Frobenius(&x.B0)
+	z.B1.Frobenius(&x.B1).Mul(&z.B1, &frobCoeffE4)
+	return z
+}
+
+// Conjugate sets z to the conjugate b0 - b1v of x = b0 + b1v over E2 and returns z
+func (z *E4) Conjugate(x *E4) *E4 {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// norm sets n to the norm of z over E2, i.e. b0² - ξb1²
+func (z *E4) norm(n *E2) {
+	var t E2
+	t.Square(&z.B1)
+	mulByNonResidueE4(&t, &t)
+	n.Square(&z.B0).Sub(n, &t)
+}
+
+// Legendre returns the Legendre symbol of z (either +1, -1, or 0.)
+func (z *E4) Legendre() int {
+	var n E2
+	z.norm(&n)
+	return n.Legendre()
+}
+
+// Exp sets z=xᵏ and returns it
+func (z *E4) Exp(x E4, k *big.Int) *E4 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ == (x⁻¹)⁻ᵏ
+		x.Inverse(&x)
+		e = new(big.Int).Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Sqrt z = √x and returns z, or nil if x is not a square.
+//
+// It computes square roots in E2 only, following the complex method.
+func (z *E4) Sqrt(x *E4) *E4 {
+	var a, s E2
+	if x.B1.IsZero() {
+		// every element of E2 is a square in E4: either √b0 or √(b0/ξ)⋅v
+		if s.Sqrt(&x.B0) != nil {
+			z.B0, z.B1 = s, E2{}
+			return z
+		}
+		a.Div(&x.B0, &nonResidueE4)
+		s.Sqrt(&a)
+		z.B0, z.B1 = E2{}, s
+		return z
+	}
+
+	// δ = √(b0² - ξb1²)
+	var delta E2
+	x.norm(&delta)
+	if delta.Sqrt(&delta) == nil {
+		return nil
+	}
+
+	// a0 = √((b0 ± δ)/2) and a1 = b1/(2a0)
+	a.Add(&x.B0, &delta)
+	a.Halve()
+	if s.Sqrt(&a) == nil {
+		a.Sub(&x.B0, &delta)
+		a.Halve()
+		if s.Sqrt(&a) == nil {
+			return nil
+		}
+	}
+	a.Double(&s).Inverse(&a)
+	z.B1.Mul(&x.B1, &a)
+	z.B0 = s
+	return z
+}
+
+// Bytes returns the value of z as a big-endian byte array, B0 first
+func (z *E4) Bytes() (res [SizeOfE4]byte) {
+	b0, b1 := z.B0.Bytes(), z.B1.Bytes()
+	copy(res[:SizeOfE2], b0[:])
+	copy(res[SizeOfE2:], b1[:])
+	return
+}
+
+// Marshal returns the value of z as a big-endian byte slice, B0 first
+func (z *E4) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// SetBytes interprets e as the bytes of a big-endian E4, B0 first, and sets z to that value.
+// It returns an error if e doesn't have the right size or if a coordinate is not canonical.
+func (z *E4) SetBytes(e []byte) error {
+	if len(e) != SizeOfE4 {
+		return errors.New("invalid E4 encoding")
+	}
+	if err := z.B0.SetBytes(e[:SizeOfE2]); err != nil {
+		return err
+	}
+	return z.B1.SetBytes(e[SizeOfE2:])
+}
+
+// mulByNonResidueE4 sets z to ξ⋅x
+func mulByNonResidueE4(z, x *E2) {
+	z.MulByNonResidue(x)
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x7f000001"
+	koalabear, err := config.NewFieldConfig("koalabear", "Element", modulus, true)
+	if err != nil {
+		panic(err)
+	}
+	koalabear.ParallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
+	if err := generator.GenerateFF(koalabear, "../"); err != nil {
+		panic(err)
+	}
+
+	// 3 is not a square: u²-3 is irreducible and u is not a square in E2, so that v⁴ = 3
+	e2 := config.NewTower(koalabear, 2, 3)
+	e4 := config.NewQuadraticExtension(&e2, 0, 1)
+	if err := generator.GenerateExtensions("github.com/consensys/gnark-crypto/field/koalabear", "../extensions", []config.Extension{e2}, e4); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated koalabear field")
+}
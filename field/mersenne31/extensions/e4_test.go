@@ -0,0 +1,375 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE4ReceiverIsOperand(t *testing.T) {
+	var a, b, c, d E4
+	a.SetRandom()
+	b.SetRandom()
+
+	c.Mul(&a, &b)
+	d.Set(&a)
+	d.Mul(&d, &b)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as first operand failed")
+	}
+	d.Set(&b)
+	d.Mul(&a, &d)
+	if !c.Equal(&d) {
+		t.Fatal("Mul: receiver as second operand failed")
+	}
+
+	c.Square(&a)
+	d.Set(&a)
+	d.Square(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Square: receiver as operand failed")
+	}
+
+	c.Inverse(&a)
+	d.Set(&a)
+	d.Inverse(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Inverse: receiver as operand failed")
+	}
+
+	c.Frobenius(&a)
+	d.Set(&a)
+	d.Frobenius(&d)
+	if !c.Equal(&d) {
+		t.Fatal("Frobenius: receiver as operand failed")
+	}
+}
+
+func TestE4Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	if testing.Short() {
+		parameters.MinSuccessfulTests = 10
+	} else {
+		parameters.MinSuccessfulTests = 100
+	}
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := genE4()
+	genB := genE4()
+	genE := genE2()
+
+	properties.Property("[E4] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E4) bool {
+			var c E4
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] mul should match the schoolbook product modulo v²-ξ", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			var t E2
+			c.Mul(a, b)
+			d.B0.Mul(&a.B0, &b.B0)
+			t.Mul(&a.B1, &b.B1).Mul(&t, &nonResidueE4)
+			d.B0.Add(&d.B0, &t)
+			d.B1.Mul(&a.B0, &b.B1)
+			t.Mul(&a.B1, &b.B0)
+			d.B1.Add(&d.B1, &t)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] Div should be the same as mul by inverse", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			c.Div(a, b)
+			d.Inverse(b).Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] BatchInvertE4 should output the same result as Inverse", prop.ForAll(
+		func(a, b *E4) bool {
+			var c, d E4
+			c.Inverse(a)
+			d.Inverse(b)
+			res := BatchInvertE4([]E4{*a, {}, *b})
+			return res[0].Equal(&c) && res[1].IsZero() && res[2].Equal(&d)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[E4] square and mul should output the same result", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Halve should be the inverse of Double", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Double(a)
+			b.Halve()
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Neg should be the additive inverse", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Neg(a).Add(&b, a)
+			return b.IsZero()
+		},
+		genA,
+	))
+
+	properties.Property("[E4] MulByElement should be the same as mul by an element of E2", prop.ForAll(
+		func(a *E4, b *E2) bool {
+			var c, d E4
+			c.MulByElement(a, b)
+			d.B0 = *b
+			d.Mul(&d, a)
+			return c.Equal(&d)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[E4] MulByNonResidue should be the same as multiplying by v", prop.ForAll(
+		func(a *E4) bool {
+			var b, c, v E4
+			v.B1.SetOne()
+			b.Mul(a, &v)
+			c.MulByNonResidue(a)
+			return c.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Frobenius should be the same as Exp(p)", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			var p big.Int
+			p.SetString("2147483647", 10)
+			b.Frobenius(a)
+			c.Exp(*a, &p)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Frobenius applied 4 times should be the identity", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Set(a)
+			for i := 0; i < 4; i++ {
+				b.Frobenius(&b)
+			}
+			return b.Equal(a)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] x times its conjugate should be in E2", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			var n E2
+			b.Conjugate(a).Mul(&b, a)
+			a.norm(&n)
+			return b.B1.IsZero() && b.B0.Equal(&n)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Exp with a negative exponent should be the inverse", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Legendre on square should output 1", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Square(a)
+			return b.Legendre() == 1
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Legendre should match Euler's criterion", prop.ForAll(
+		func(a *E4) bool {
+			var b, minusOne E4
+			e := new(big.Int)
+			e.SetString("2147483647", 10)
+			e.Exp(e, big.NewInt(4), nil)
+			e.Sub(e, big.NewInt(1)).Rsh(e, 1)
+			b.Exp(*a, e)
+			minusOne.SetOne().Neg(&minusOne)
+			switch a.Legendre() {
+			case 1:
+				return b.IsOne()
+			case -1:
+				return b.Equal(&minusOne)
+			}
+			return false
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Sqrt of a square should be a square root", prop.ForAll(
+		func(a *E4) bool {
+			var b, c E4
+			b.Square(a)
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Sqrt of an element of E2 should be a square root", prop.ForAll(
+		func(a *E2) bool {
+			var b, c E4
+			b.B0 = *a
+			if c.Sqrt(&b) == nil {
+				return false
+			}
+			return c.Square(&c).Equal(&b)
+		},
+		genE,
+	))
+
+	properties.Property("[E4] Sqrt should return nil on non-squares", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			if a.Legendre() != -1 {
+				return true
+			}
+			return b.Sqrt(a) == nil
+		},
+		genA,
+	))
+
+	properties.Property("[E4] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			buf := a.Bytes()
+			if err := b.SetBytes(buf[:]); err != nil {
+				return false
+			}
+			return b.Equal(a) && b.SetBytes(buf[1:]) != nil
+		},
+		genA,
+	))
+
+	properties.Property("[E4] Cmp should be consistent with LexicographicallyLargest", prop.ForAll(
+		func(a *E4) bool {
+			var b E4
+			b.Neg(a)
+			if a.IsZero() {
+				return !a.LexicographicallyLargest() && a.Cmp(&b) == 0
+			}
+			return a.LexicographicallyLargest() == (a.Cmp(&b) == 1)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE4Mul(b *testing.B) {
+	var a, c E4
+	a.SetRandom()
+	c.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE4Square(b *testing.B) {
+	var a E4
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE4Inverse(b *testing.B) {
+	var a E4
+	a.SetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
+
+func BenchmarkE4Sqrt(b *testing.B) {
+	var a E4
+	a.SetRandom()
+	a.Square(&a)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sqrt(&a)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// MaxOrder is the order of the circle group, p+1 = 2³¹
+const MaxOrder = 1 << 31
+
+// CirclePoint is a point (X, Y) of the circle x² + y² = 1 over 𝔽p.
+//
+// The circle points form a cyclic group of order 2³¹, written additively, with identity (1, 0) and
+//
+//	(x₀, y₀) + (x₁, y₁) = (x₀x₁ - y₀y₁, x₀y₁ + y₀x₁)
+type CirclePoint struct {
+	X, Y mersenne31.Element
+}
+
+// circleGenerator generates the circle group
+var circleGenerator = CirclePoint{
+	X: mersenne31.NewElement(2),
+	Y: mersenne31.NewElement(1268011823),
+}
+
+// Generator returns a generator of the circle subgroup of order 2^⌈log₂(m)⌉
+// or an error if m is too big (the subgroup doesn't exist)
+func Generator(m uint64) (CirclePoint, error) {
+	x := ecc.NextPowerOfTwo(m)
+	if x > MaxOrder {
+		return CirclePoint{}, errors.New("m is too big: the circle group has order 2³¹")
+	}
+	var g CirclePoint
+	g.Set(&circleGenerator)
+	for i := bits.TrailingZeros64(x); i < 31; i++ {
+		g.Double(&g)
+	}
+	return g, nil
+}
+
+// Set sets p to q and returns p
+func (p *CirclePoint) Set(q *CirclePoint) *CirclePoint {
+	*p = *q
+	return p
+}
+
+// SetIdentity sets p to the identity (1, 0) and returns p
+func (p *CirclePoint) SetIdentity() *CirclePoint {
+	p.X.SetOne()
+	p.Y.SetZero()
+	return p
+}
+
+// IsIdentity returns true if p is the identity (1, 0)
+func (p *CirclePoint) IsIdentity() bool {
+	return p.X.IsOne() && p.Y.IsZero()
+}
+
+// Equal returns true if p and q are equal
+func (p *CirclePoint) Equal(q *CirclePoint) bool {
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
+}
+
+// IsOnCircle returns true if x² + y² = 1
+func (p *CirclePoint) IsOnCircle() bool {
+	var x2, y2 mersenne31.Element
+	x2.Square(&p.X)
+	y2.Square(&p.Y)
+	return x2.Add(&x2, &y2).IsOne()
+}
+
+// Add sets p to a + b and returns p
+func (p *CirclePoint) Add(a, b *CirclePoint) *CirclePoint {
+	var x, y, t mersenne31.Element
+	x.Mul(&a.X, &b.X)
+	t.Mul(&a.Y, &b.Y)
+	x.Sub(&x, &t)
+	y.Mul(&a.X, &b.Y)
+	t.Mul(&a.Y, &b.X)
+	y.Add(&y, &t)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Sub sets p to a - b and returns p
+func (p *CirclePoint) Sub(a, b *CirclePoint) *CirclePoint {
+	var nb CirclePoint
+	nb.Neg(b)
+	return p.Add(a, &nb)
+}
+
+// Double sets p to 2a and returns p.
+// The x-coordinate of 2a is π(x) = 2x² - 1.
+func (p *CirclePoint) Double(a *CirclePoint) *CirclePoint {
+	var x, y mersenne31.Element
+	x.Square(&a.X).Double(&x)
+	x.Sub(&x, &one)
+	y.Mul(&a.X, &a.Y).Double(&y)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Neg sets p to -a = (x, -y), the conjugate of a, and returns p
+func (p *CirclePoint) Neg(a *CirclePoint) *CirclePoint {
+	p.X = a.X
+	p.Y.Neg(&a.Y)
+	return p
+}
+
+// ScalarMultiplication sets p to [s]a and returns p
+func (p *CirclePoint) ScalarMultiplication(a *CirclePoint, s *big.Int) *CirclePoint {
+	var k big.Int
+	k.Mod(s, big.NewInt(MaxOrder))
+	return p.scalarMulUint64(a, k.Uint64())
+}
+
+func (p *CirclePoint) scalarMulUint64(a *CirclePoint, s uint64) *CirclePoint {
+	var res, base CirclePoint
+	res.SetIdentity()
+	base.Set(a)
+	for ; s != 0; s >>= 1 {
+		if s&1 == 1 {
+			res.Add(&res, &base)
+		}
+		base.Double(&base)
+	}
+	return p.Set(&res)
+}
+
+// String returns the point in the form (X, Y)
+func (p *CirclePoint) String() string {
+	return "(" + p.X.String() + ", " + p.Y.String() + ")"
+}
+
+var one = mersenne31.One()
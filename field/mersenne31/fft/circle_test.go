@@ -0,0 +1,88 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestCircleGroup(t *testing.T) {
+	if !circleGenerator.IsOnCircle() {
+		t.Fatal("generator is not on the circle")
+	}
+
+	// the generator has order exactly 2³¹
+	var p CirclePoint
+	p.ScalarMultiplication(&circleGenerator, big.NewInt(MaxOrder))
+	if !p.IsIdentity() {
+		t.Fatal("[2³¹]G should be the identity")
+	}
+	p.ScalarMultiplication(&circleGenerator, big.NewInt(MaxOrder/2))
+	if p.IsIdentity() {
+		t.Fatal("[2³⁰]G should not be the identity")
+	}
+
+	for _, m := range []uint64{1, 2, 5, 64, 1 << 20} {
+		g, err := Generator(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p.ScalarMultiplication(&g, new(big.Int).SetUint64(ecc.NextPowerOfTwo(m)))
+		if !p.IsIdentity() {
+			t.Fatalf("generator of size %d has the wrong order", m)
+		}
+	}
+	if _, err := Generator(MaxOrder + 1); err == nil {
+		t.Fatal("expected an error for a subgroup larger than the circle group")
+	}
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[a]G + [b]G == [a+b]G", prop.ForAll(
+		func(a, b uint64) bool {
+			var pa, pb, pab, s CirclePoint
+			pa.scalarMulUint64(&circleGenerator, a)
+			pb.scalarMulUint64(&circleGenerator, b)
+			pab.scalarMulUint64(&circleGenerator, a+b)
+			s.Add(&pa, &pb)
+			return s.Equal(&pab) && s.IsOnCircle()
+		},
+		gen.UInt64Range(0, 1<<40),
+		gen.UInt64Range(0, 1<<40),
+	))
+
+	properties.Property("P - P == identity and 2P == P + P", prop.ForAll(
+		func(a uint64) bool {
+			var p, q, r CirclePoint
+			p.scalarMulUint64(&circleGenerator, a)
+			q.Sub(&p, &p)
+			r.Add(&p, &p)
+			p.Double(&p)
+			return q.IsIdentity() && r.Equal(&p)
+		},
+		gen.UInt64Range(0, 1<<40),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
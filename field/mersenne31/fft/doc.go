@@ -0,0 +1,30 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fft provides the circle fast Fourier transform over the Mersenne-31 field.
+//
+// The multiplicative group of 𝔽p, p = 2³¹-1, has 2-adicity 1 and does not support the
+// usual radix-2 FFT. Instead, the points of the circle x² + y² = 1 over 𝔽p form a cyclic
+// group of order p+1 = 2³¹, and its cosets are used as evaluation domains.
+// The FFT maps the coefficients of a polynomial in the basis
+//
+//	y^j₀ ⋅ x^j₁ ⋅ π(x)^j₂ ⋅ π²(x)^j₃ ⋯, with π(x) = 2x²-1 and jₖ ∈ {0, 1}
+//
+// where j₀ is the most significant bit of the coefficient index, to its evaluations
+// on a circle domain.
+//
+// # See also
+//
+// https://eprint.iacr.org/2024/278 (Circle STARKs)
+package fft
@@ -0,0 +1,225 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+)
+
+// Domain is a circle domain of size N = 2ⁿ, the coset Shift + ⟨2⋅Generator⟩ with
+// Shift of order 2N.
+//
+// The domain is stable under conjugation. Its points are ordered as the half coset
+// Pᵢ = Shift + i⋅Generator, 0 ≤ i < N/2, followed by the conjugates -Pᵢ.
+type Domain struct {
+	Cardinality    uint64
+	CardinalityInv mersenne31.Element
+	Shift          CirclePoint // of order 2N
+	Generator      CirclePoint // of order N/2
+
+	// twiddles[0] are the y-coordinates of the half coset, twiddles[l] the
+	// x-coordinates of its points multiplied by 2ˡ⁻¹ (first half only)
+	twiddles    [][]mersenne31.Element
+	twiddlesInv [][]mersenne31.Element
+}
+
+// NewDomain returns a circle domain of size 2^⌈log₂(m)⌉, at least 2.
+// It panics if m > 2³⁰.
+func NewDomain(m uint64) *Domain {
+	x := ecc.NextPowerOfTwo(m)
+	if x < 2 {
+		x = 2
+	}
+	if x > MaxOrder/2 {
+		panic(fmt.Sprintf("m (%d) is too big: the largest circle domain has size 2³⁰", m))
+	}
+
+	d := &Domain{Cardinality: x}
+	d.CardinalityInv.SetUint64(x).Inverse(&d.CardinalityInv)
+
+	var err error
+	if d.Shift, err = Generator(2 * x); err != nil {
+		panic(err)
+	}
+	if d.Generator, err = Generator(x / 2); err != nil {
+		panic(err)
+	}
+
+	d.preComputeTwiddles()
+	return d
+}
+
+func (d *Domain) preComputeTwiddles() {
+	n := bits.TrailingZeros64(d.Cardinality)
+	d.twiddles = make([][]mersenne31.Element, n)
+	d.twiddlesInv = make([][]mersenne31.Element, n)
+
+	// layer 0: y-coordinates of the half coset
+	half := d.Cardinality / 2
+	d.twiddles[0] = make([]mersenne31.Element, half)
+	p := d.Shift
+	for i := uint64(0); i < half; i++ {
+		d.twiddles[0][i] = p.Y
+		p.Add(&p, &d.Generator)
+	}
+
+	// layer l: x-coordinates of 2ˡ⁻¹(Shift + i⋅Generator)
+	shift, gen := d.Shift, d.Generator
+	for l := 1; l < n; l++ {
+		d.twiddles[l] = make([]mersenne31.Element, d.Cardinality>>(l+1))
+		p = shift
+		for i := range d.twiddles[l] {
+			d.twiddles[l][i] = p.X
+			p.Add(&p, &gen)
+		}
+		shift.Double(&shift)
+		gen.Double(&gen)
+	}
+
+	for l := range d.twiddles {
+		d.twiddlesInv[l] = mersenne31.BatchInvert(d.twiddles[l])
+	}
+}
+
+// Point returns the i-th point of the domain
+func (d *Domain) Point(i uint64) CirclePoint {
+	half := d.Cardinality / 2
+	var p CirclePoint
+	p.scalarMulUint64(&d.Generator, i%half).Add(&p, &d.Shift)
+	if i >= half {
+		p.Neg(&p)
+	}
+	return p
+}
+
+// FFT computes in place the evaluations on the domain of the circle polynomial
+// with coefficients a, in the basis described in the package documentation.
+func (d *Domain) FFT(a []mersenne31.Element) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("fft: len(a) must match the domain cardinality")
+	}
+	for l := len(d.twiddles) - 1; l >= 0; l-- {
+		m := len(a) >> l
+		h := m / 2
+		for start := 0; start < len(a); start += m {
+			butterflies(a[start:start+h], a[start+h:start+m], d.twiddles[l])
+		}
+	}
+}
+
+// FFTInverse computes in place the coefficients of the circle polynomial
+// that evaluates to a on the domain.
+func (d *Domain) FFTInverse(a []mersenne31.Element) {
+	if uint64(len(a)) != d.Cardinality {
+		panic("fft: len(a) must match the domain cardinality")
+	}
+	for l := range d.twiddlesInv {
+		m := len(a) >> l
+		h := m / 2
+		for start := 0; start < len(a); start += m {
+			inverseButterflies(a[start:start+h], a[start+h:start+m], d.twiddlesInv[l])
+		}
+	}
+	for i := range a {
+		a[i].Mul(&a[i], &d.CardinalityInv)
+	}
+}
+
+// butterflies computes (u, v) ← (u + t⋅v, u - t⋅v)
+func butterflies(u, v, t []mersenne31.Element) {
+	for i := range u {
+		v[i].Mul(&v[i], &t[i])
+		mersenne31.Butterfly(&u[i], &v[i])
+	}
+}
+
+// inverseButterflies computes (u, v) ← (u + v, (u - v)⋅t⁻¹)
+func inverseButterflies(u, v, tInv []mersenne31.Element) {
+	for i := range u {
+		mersenne31.Butterfly(&u[i], &v[i])
+		v[i].Mul(&v[i], &tInv[i])
+	}
+}
+
+// Evaluate returns the evaluation at p of the circle polynomial with coefficients c,
+// in the basis described in the package documentation. len(c) must be a power of two.
+func Evaluate(c []mersenne31.Element, p *CirclePoint) mersenne31.Element {
+	n := bits.TrailingZeros64(uint64(len(c)))
+	if len(c) == 0 || 1<<n != len(c) {
+		panic("fft: len(c) must be a power of two")
+	}
+	if n == 0 {
+		return c[0]
+	}
+
+	// variables of the basis, from the outermost (y) to the innermost
+	vars := make([]mersenne31.Element, n)
+	vars[0] = p.Y
+	if n > 1 {
+		vars[1] = p.X
+	}
+	for l := 2; l < n; l++ {
+		vars[l].Square(&vars[l-1]).Double(&vars[l])
+		vars[l].Sub(&vars[l], &one)
+	}
+
+	// fold the coefficients, innermost variable first
+	buf := make([]mersenne31.Element, len(c))
+	copy(buf, c)
+	for l := n - 1; l >= 0; l-- {
+		// bit l of the coefficient index selects vars[n-1-l]
+		h := 1 << l
+		v := &vars[n-1-l]
+		for start := 0; start < len(buf); start += 2 * h {
+			for i := 0; i < h; i++ {
+				var t mersenne31.Element
+				t.Mul(&buf[start+i+h], v)
+				buf[start/2+i].Add(&buf[start+i], &t)
+			}
+		}
+		buf = buf[:len(buf)/2]
+	}
+	return buf[0]
+}
+
+// WriteTo writes a binary representation of the domain (its cardinality) to w
+func (d *Domain) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], d.Cardinality)
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom decodes a domain from r
+func (d *Domain) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	cardinality := binary.BigEndian.Uint64(buf[:])
+	if cardinality < 2 || cardinality > MaxOrder/2 || cardinality&(cardinality-1) != 0 {
+		return int64(n), errors.New("invalid circle domain cardinality")
+	}
+	*d = *NewDomain(cardinality)
+	return int64(n), nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/field/mersenne31"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/gen"
+	"github.com/leanovate/gopter/prop"
+)
+
+func TestDomainPoints(t *testing.T) {
+	const size = 1 << 6
+	domain := NewDomain(size)
+
+	seen := make(map[CirclePoint]bool, size)
+	for i := uint64(0); i < size; i++ {
+		p := domain.Point(i)
+		if !p.IsOnCircle() {
+			t.Fatalf("point %d is not on the circle", i)
+		}
+		if seen[p] {
+			t.Fatalf("point %d appears twice in the domain", i)
+		}
+		seen[p] = true
+	}
+}
+
+func TestFFT(t *testing.T) {
+	const maxSize = 1 << 10
+
+	domain := NewDomain(maxSize)
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 5
+
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("FFT should be consistent with Evaluate", prop.ForAll(
+		func(i int) bool {
+			pol := make([]mersenne31.Element, maxSize)
+			backupPol := make([]mersenne31.Element, maxSize)
+			for j := range pol {
+				pol[j].SetRandom()
+			}
+			copy(backupPol, pol)
+
+			domain.FFT(pol)
+
+			p := domain.Point(uint64(i))
+			eval := Evaluate(backupPol, &p)
+			return eval.Equal(&pol[i])
+		},
+		gen.IntRange(0, maxSize-1),
+	))
+
+	properties.Property("FFTInverse(FFT(a)) == a", prop.ForAll(
+		func(logSize int) bool {
+			size := 1 << logSize
+			d := NewDomain(uint64(size))
+			pol := make([]mersenne31.Element, size)
+			backupPol := make([]mersenne31.Element, size)
+			for j := range pol {
+				pol[j].SetRandom()
+			}
+			copy(backupPol, pol)
+
+			d.FFT(pol)
+			d.FFTInverse(pol)
+
+			return reflect.DeepEqual(pol, backupPol)
+		},
+		gen.IntRange(1, 10),
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestDomainSerialization(t *testing.T) {
+
+	domain := NewDomain(1 << 6)
+	var reconstructed Domain
+
+	var buf bytes.Buffer
+	written, err := domain.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var read int64
+	read, err = reconstructed.ReadFrom(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if written != read {
+		t.Fatal("didn't read as many bytes as we wrote")
+	}
+	if !reflect.DeepEqual(domain, &reconstructed) {
+		t.Fatal("Domain.SetBytes(Bytes()) failed")
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const size = 1 << 20
+	domain := NewDomain(size)
+	pol := make([]mersenne31.Element, size)
+	for i := range pol {
+		pol[i].SetRandom()
+	}
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		domain.FFT(pol)
+	}
+}
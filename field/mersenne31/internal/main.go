@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/field/generator"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+//go:generate go run main.go
+func main() {
+	const modulus = "0x7fffffff"
+	mersenne31, err := config.NewFieldConfig("mersenne31", "Element", modulus, true)
+	if err != nil {
+		panic(err)
+	}
+	mersenne31.ParallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
+	if err := generator.GenerateFF(mersenne31, "../"); err != nil {
+		panic(err)
+	}
+
+	// p = 3 mod 4 so -1 is not a square: E2 = 𝔽p[i]/(i²+1) are the complex numbers over 𝔽p
+	// and E4 = E2[v]/(v²-(2+i)) is the usual "QM31" extension
+	e2 := config.NewTower(mersenne31, 2, -1)
+	e4 := config.NewQuadraticExtension(&e2, 2, 1)
+	if err := generator.GenerateExtensions("github.com/consensys/gnark-crypto/field/mersenne31", "../extensions", []config.Extension{e2}, e4); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated mersenne31 field")
+}
@@ -0,0 +1,250 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/consensys/gnark-crypto/utils"
+)
+
+// Polynomial represented by coefficients in the field.
+type Polynomial[T any, P Element[T]] []T
+
+// Degree returns len(p) - 1, the index of the leading coefficient (which may be zero).
+// The empty polynomial has degree 0.
+func (p *Polynomial[T, P]) Degree() uint64 {
+	if len(*p) == 0 {
+		return 0
+	}
+	return uint64(len(*p) - 1)
+}
+
+// Eval evaluates p at v; the empty polynomial evaluates to zero.
+func (p *Polynomial[T, P]) Eval(v *T) T {
+	var res T
+	if len(*p) == 0 {
+		return res
+	}
+
+	res = (*p)[len(*p)-1]
+	for i := len(*p) - 2; i >= 0; i-- {
+		P(&res).Mul(&res, v)
+		P(&res).Add(&res, &(*p)[i])
+	}
+
+	return res
+}
+
+// Clone returns a copy of the polynomial
+func (p *Polynomial[T, P]) Clone() Polynomial[T, P] {
+	_p := make(Polynomial[T, P], len(*p))
+	copy(_p, *p)
+	return _p
+}
+
+// Set to another polynomial
+func (p *Polynomial[T, P]) Set(p1 Polynomial[T, P]) {
+	if len(*p) != len(p1) {
+		*p = p1.Clone()
+		return
+	}
+
+	for i := 0; i < len(p1); i++ {
+		P(&(*p)[i]).Set(&p1[i])
+	}
+}
+
+// AddConstantInPlace adds a constant to the polynomial, modifying p
+func (p *Polynomial[T, P]) AddConstantInPlace(c *T) {
+	for i := 0; i < len(*p); i++ {
+		P(&(*p)[i]).Add(&(*p)[i], c)
+	}
+}
+
+// SubConstantInPlace subs a constant to the polynomial, modifying p
+func (p *Polynomial[T, P]) SubConstantInPlace(c *T) {
+	for i := 0; i < len(*p); i++ {
+		P(&(*p)[i]).Sub(&(*p)[i], c)
+	}
+}
+
+// ScaleInPlace multiplies p by v, modifying p
+func (p *Polynomial[T, P]) ScaleInPlace(c *T) {
+	for i := 0; i < len(*p); i++ {
+		P(&(*p)[i]).Mul(&(*p)[i], c)
+	}
+}
+
+// Scale multiplies p0 by v, storing the result in p
+func (p *Polynomial[T, P]) Scale(c *T, p0 Polynomial[T, P]) {
+	if len(*p) != len(p0) {
+		*p = make(Polynomial[T, P], len(p0))
+	}
+	for i := 0; i < len(p0); i++ {
+		P(&(*p)[i]).Mul(c, &p0[i])
+	}
+}
+
+// Add adds p1 to p2
+// This function allocates a new slice unless p == p1 or p == p2
+func (p *Polynomial[T, P]) Add(p1, p2 Polynomial[T, P]) *Polynomial[T, P] {
+
+	bigger := p1
+	smaller := p2
+	if len(bigger) < len(smaller) {
+		bigger, smaller = smaller, bigger
+	}
+
+	if len(bigger) == 0 {
+		*p = Polynomial[T, P]{}
+		return p
+	}
+
+	if len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
+		for i := 0; i < len(smaller); i++ {
+			P(&(*p)[i]).Add(&(*p)[i], &smaller[i])
+		}
+		return p
+	}
+
+	if len(*p) == len(smaller) && len(smaller) != 0 && (&(*p)[0] == &smaller[0]) {
+		for i := 0; i < len(smaller); i++ {
+			P(&(*p)[i]).Add(&(*p)[i], &bigger[i])
+		}
+		*p = append(*p, bigger[len(smaller):]...)
+		return p
+	}
+
+	res := make(Polynomial[T, P], len(bigger))
+	copy(res, bigger)
+	for i := 0; i < len(smaller); i++ {
+		P(&res[i]).Add(&res[i], &smaller[i])
+	}
+	*p = res
+	return p
+}
+
+// Sub subtracts p2 from p1
+func (p *Polynomial[T, P]) Sub(p1, p2 Polynomial[T, P]) *Polynomial[T, P] {
+	if len(p1) != len(p2) || len(p2) != len(*p) {
+		return nil
+	}
+	for i := 0; i < len(*p); i++ {
+		P(&(*p)[i]).Sub(&p1[i], &p2[i])
+	}
+	return p
+}
+
+// Mul sets p to the product p1 * p2, using schoolbook multiplication.
+// This function always allocates a new slice.
+func (p *Polynomial[T, P]) Mul(p1, p2 Polynomial[T, P]) *Polynomial[T, P] {
+	if len(p1) == 0 || len(p2) == 0 {
+		*p = Polynomial[T, P]{}
+		return p
+	}
+	res := make(Polynomial[T, P], len(p1)+len(p2)-1)
+	var tmp T
+	for i := 0; i < len(p1); i++ {
+		for j := 0; j < len(p2); j++ {
+			P(&tmp).Mul(&p1[i], &p2[j])
+			P(&res[i+j]).Add(&res[i+j], &tmp)
+		}
+	}
+	*p = res
+	return p
+}
+
+// Equal checks equality between two polynomials
+func (p *Polynomial[T, P]) Equal(p1 Polynomial[T, P]) bool {
+	if (*p == nil) != (p1 == nil) {
+		return false
+	}
+
+	if len(*p) != len(p1) {
+		return false
+	}
+
+	for i := range p1 {
+		if !P(&(*p)[i]).Equal(&p1[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SetZero sets all coefficients of p to zero
+func (p Polynomial[T, P]) SetZero() {
+	for i := 0; i < len(p); i++ {
+		P(&p[i]).SetZero()
+	}
+}
+
+// Text returns a human readable representation of p, with coefficients in the given base
+func (p Polynomial[T, P]) Text(base int) string {
+
+	var builder strings.Builder
+
+	first := true
+	for d := len(p) - 1; d >= 0; d-- {
+		if P(&p[d]).IsZero() {
+			continue
+		}
+
+		pD := p[d]
+		pDText := P(&pD).Text(base)
+
+		initialLen := builder.Len()
+
+		if pDText[0] == '-' {
+			pDText = pDText[1:]
+			if first {
+				builder.WriteString("-")
+			} else {
+				builder.WriteString(" - ")
+			}
+		} else if !first {
+			builder.WriteString(" + ")
+		}
+
+		first = false
+
+		if !P(&pD).IsOne() || d == 0 {
+			builder.WriteString(pDText)
+		}
+
+		if builder.Len()-initialLen > 10 {
+			builder.WriteString("×")
+		}
+
+		if d != 0 {
+			builder.WriteString("X")
+		}
+		if d > 1 {
+			builder.WriteString(
+				utils.ToSuperscript(strconv.Itoa(d)),
+			)
+		}
+
+	}
+
+	if first {
+		return "0"
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,113 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package field
+
+import "strings"
+
+// Vector represents a slice of field elements.
+type Vector[T any, P Element[T]] []T
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector[T, P]) Add(a, b Vector[T, P]) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		P(&(*vector)[i]).Add(&a[i], &b[i])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector[T, P]) Sub(a, b Vector[T, P]) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		P(&(*vector)[i]).Sub(&a[i], &b[i])
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector[T, P]) Mul(a, b Vector[T, P]) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		P(&(*vector)[i]).Mul(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector[T, P]) ScalarMul(a Vector[T, P], b *T) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	for i := 0; i < len(a); i++ {
+		P(&(*vector)[i]).Mul(&a[i], b)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector Vector[T, P]) Sum() (res T) {
+	for i := 0; i < len(vector); i++ {
+		P(&res).Add(&res, &vector[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector Vector[T, P]) InnerProduct(other Vector[T, P]) (res T) {
+	if len(vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var tmp T
+	for i := 0; i < len(vector); i++ {
+		P(&tmp).Mul(&vector[i], &other[i])
+		P(&res).Add(&res, &tmp)
+	}
+	return
+}
+
+// Equal returns true if both vectors have the same length and elements.
+func (vector Vector[T, P]) Equal(other Vector[T, P]) bool {
+	if len(vector) != len(other) {
+		return false
+	}
+	for i := 0; i < len(vector); i++ {
+		if !P(&vector[i]).Equal(&other[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements fmt.Stringer interface
+func (vector Vector[T, P]) String() string {
+	var sbb strings.Builder
+	sbb.WriteByte('[')
+	for i := 0; i < len(vector); i++ {
+		sbb.WriteString(P(&vector[i]).String())
+		if i != len(vector)-1 {
+			sbb.WriteByte(',')
+		}
+	}
+	sbb.WriteByte(']')
+	return sbb.String()
+}
@@ -0,0 +1,273 @@
+// Copyright 2020 ConsenSys Software Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// dudect is a statistical timing test of the constant-time field operations and scalar multiplications, following
+// "dude, is my code constant time?" (Reparaz, Balasch, Verbauwhede, https://eprint.iacr.org/2016/1123).
+//
+// Each operation is timed on two classes of inputs, a fixed special value and random values, in random order.
+// A Welch t-test on the timings (raw and cropped at several percentiles) then tells whether the two
+// distributions differ: |t| > 4.5 is considered a leak. The variable-time operations are measured too,
+// as a sanity check of the harness.
+//
+// It is meant to be run locally, on an otherwise idle machine:
+//
+//	go run ./internal/dudect -n 1000000 -op InverseCT
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"math"
+	"math/big"
+	mrand "math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/ed25519/twistededwards"
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// threshold above which the timings of the two classes are considered different
+const threshold = 4.5
+
+// number of cropped tests
+const nbPercentiles = 100
+
+// target is an operation to measure. prepare returns the operation on an input of the given class,
+// so that the input generation is not measured.
+type target struct {
+	name    string
+	prepare func(class int) func()
+}
+
+// element is the set of methods of the generated field elements used by the harness
+type element[T any] interface {
+	*T
+	SetRandom() (*T, error)
+	SetOne() *T
+	Square(*T) *T
+	Inverse(*T) *T
+	InverseCT(*T) *T
+	Sqrt(*T) *T
+	SqrtCT(*T) *T
+	Exp(T, *big.Int) *T
+	ExpCT(T, *big.Int) *T
+}
+
+// targets returns the operations of a field, with fixed inputs (class 0) for which the
+// variable-time algorithms are the fastest, except for the exponent
+func targets[T any, PT element[T]](field string, modulus *big.Int) []target {
+	randomElement := func(class int) T {
+		var x T
+		if class == 0 {
+			PT(&x).SetOne()
+		} else {
+			PT(&x).SetRandom()
+		}
+		return x
+	}
+	// the fixed exponent is not a degenerate value such as 1: on some CPUs the power consumption,
+	// and hence the frequency, depends on the Hamming weight of the processed data (see https://www.hertzbleed.com),
+	// which would be detected even with constant-time code
+	fixedExponent, _ := rand.Int(rand.Reader, modulus)
+	randomExponent := func(class int) *big.Int {
+		// both classes are allocated the same way, not to measure differences in memory layout
+		k, _ := rand.Int(rand.Reader, modulus)
+		if class == 0 {
+			k.Set(fixedExponent)
+		}
+		return k
+	}
+	randomSquare := func(class int) T {
+		x := randomElement(class)
+		PT(&x).Square(&x)
+		return x
+	}
+
+	var res T
+	return []target{
+		{field + "/Inverse", func(class int) func() {
+			x := randomElement(class)
+			return func() { PT(&res).Inverse(&x) }
+		}},
+		{field + "/InverseCT", func(class int) func() {
+			x := randomElement(class)
+			return func() { PT(&res).InverseCT(&x) }
+		}},
+		{field + "/Sqrt", func(class int) func() {
+			x := randomSquare(class)
+			return func() { PT(&res).Sqrt(&x) }
+		}},
+		{field + "/SqrtCT", func(class int) func() {
+			x := randomSquare(class)
+			return func() { PT(&res).SqrtCT(&x) }
+		}},
+		{field + "/Exp", func(class int) func() {
+			var x T
+			PT(&x).SetRandom()
+			k := randomExponent(class)
+			return func() { PT(&res).Exp(x, k) }
+		}},
+		{field + "/ExpCT", func(class int) func() {
+			var x T
+			PT(&x).SetRandom()
+			k := randomExponent(class)
+			return func() { PT(&res).ExpCT(x, k) }
+		}},
+	}
+}
+
+// scalarMulTargets returns the scalar multiplications on bn254 G1 and on ed25519, with a fixed scalar (class 0)
+func scalarMulTargets() []target {
+	_, _, g1, _ := bn254.Generators()
+	edwards := twistededwards.GetEdwardsCurve()
+	// scalars are sampled below the bn254 scalar field modulus, for both curves
+	order := ecc.BN254.ScalarField()
+	fixedScalar, _ := rand.Int(rand.Reader, order)
+	randomScalar := func(class int) *big.Int {
+		k, _ := rand.Int(rand.Reader, order)
+		if class == 0 {
+			k.Set(fixedScalar)
+		}
+		return k
+	}
+
+	var res bn254.G1Affine
+	var resEdwards twistededwards.PointAffine
+	return []target{
+		{"bn254/G1/ScalarMultiplication", func(class int) func() {
+			k := randomScalar(class)
+			return func() { res.ScalarMultiplication(&g1, k) }
+		}},
+		{"bn254/G1/ScalarMultiplicationCT", func(class int) func() {
+			k := randomScalar(class)
+			return func() { res.ScalarMultiplicationCT(&g1, k) }
+		}},
+		{"ed25519/ScalarMultiplication", func(class int) func() {
+			k := randomScalar(class)
+			return func() { resEdwards.ScalarMultiplication(&edwards.Base, k) }
+		}},
+		{"ed25519/ScalarMultiplicationCT", func(class int) func() {
+			k := randomScalar(class)
+			return func() { resEdwards.ScalarMultiplicationCT(&edwards.Base, k) }
+		}},
+	}
+}
+
+func main() {
+	nbMeasurements := flag.Int("n", 100000, "number of measurements per operation")
+	filter := flag.String("op", "", "only measure the operations whose name contains this string")
+	flag.Parse()
+
+	var all []target
+	all = append(all, targets[fp.Element]( /* p ≡ 3 mod 4 */ "bn254/fp", fp.Modulus())...)
+	all = append(all, targets[fr.Element]( /* Tonelli-Shanks */ "bls12-377/fr", fr.Modulus())...)
+	all = append(all, targets[goldilocks.Element]("goldilocks", goldilocks.Modulus())...)
+	all = append(all, scalarMulTargets()...)
+
+	for _, t := range all {
+		if !strings.Contains(t.name, *filter) {
+			continue
+		}
+		tMax := measure(t, *nbMeasurements)
+		verdict := "no leak detected"
+		if math.Abs(tMax) > threshold {
+			verdict = "LEAK"
+		}
+		fmt.Printf("%-32s max |t| = %8.2f  %s\n", t.name, math.Abs(tMax), verdict)
+	}
+}
+
+// measure times t on nbMeasurements inputs of random classes and returns the t statistic
+// of largest absolute value among the raw and cropped tests
+func measure(t target, nbMeasurements int) float64 {
+	classes := make([]int, nbMeasurements)
+	ops := make([]func(), nbMeasurements)
+	for i := range ops {
+		classes[i] = mrand.Intn(2) //#nosec G404 weak rng is fine here
+		ops[i] = t.prepare(classes[i])
+	}
+
+	// warm up
+	for i := 0; i < len(ops) && i < 1000; i++ {
+		ops[i]()
+	}
+
+	timings := make([]float64, nbMeasurements)
+	for i, op := range ops {
+		start := time.Now()
+		op()
+		timings[i] = float64(time.Since(start))
+	}
+
+	// cropping thresholds, to remove the long tail due to interrupts and scheduling
+	sorted := make([]float64, len(timings))
+	copy(sorted, timings)
+	sort.Float64s(sorted)
+	var percentiles [nbPercentiles]float64
+	for i := range percentiles {
+		p := 1 - math.Pow(0.5, 10*float64(i+1)/nbPercentiles)
+		percentiles[i] = sorted[int(p*float64(len(sorted)-1))]
+	}
+
+	var raw welch
+	var cropped [nbPercentiles]welch
+	for i, x := range timings {
+		raw.push(x, classes[i])
+		for j := range cropped {
+			if x < percentiles[j] {
+				cropped[j].push(x, classes[i])
+			}
+		}
+	}
+
+	tMax := raw.t()
+	for j := range cropped {
+		if c := cropped[j].t(); math.Abs(c) > math.Abs(tMax) {
+			tMax = c
+		}
+	}
+	return tMax
+}
+
+// welch computes online the Welch t statistic of two samples
+type welch struct {
+	n, mean, m2 [2]float64
+}
+
+func (w *welch) push(x float64, class int) {
+	w.n[class]++
+	delta := x - w.mean[class]
+	w.mean[class] += delta / w.n[class]
+	w.m2[class] += delta * (x - w.mean[class])
+}
+
+func (w *welch) t() float64 {
+	if w.n[0] < 2 || w.n[1] < 2 {
+		return 0
+	}
+	v0 := w.m2[0] / (w.n[0] - 1)
+	v1 := w.m2[1] / (w.n[1] - 1)
+	den := math.Sqrt(v0/w.n[0] + v1/w.n[1])
+	if den == 0 {
+		return 0
+	}
+	return (w.mean[0] - w.mean[1]) / den
+}
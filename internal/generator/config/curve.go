@@ -2,6 +2,8 @@ package config
 
 import (
 	"math/big"
+	"path"
+	"strings"
 
 	"github.com/consensys/gnark-crypto/field/generator/config"
 )
@@ -15,6 +17,12 @@ type Curve struct {
 	FpModulus    string
 	FrModulus    string
 
+	// FpImportPath and FrImportPath are set when the fields are borrowed from another
+	// curve instead of being generated in the curve package (e.g. grumpkin, which forms
+	// a cycle with bn254, uses bn254's fr as fp and bn254's fp as fr).
+	FpImportPath string
+	FrImportPath string
+
 	Fp           *config.FieldConfig
 	Fr           *config.FieldConfig
 	FpUnusedBits int
@@ -25,6 +33,14 @@ type Curve struct {
 
 	HashE1 HashSuite
 	HashE2 HashSuite
+
+	// Custom is set for the curves loaded from a configuration file (see LoadCurve), which
+	// are generated outside of gnark-crypto and follow the same code path as grumpkin.
+	Custom *CustomCurve
+
+	// TemplatesDir is the directory holding the templates of the generators (ecc/template,
+	// fft/template, ...); they are read relatively to the working directory if it is empty.
+	TemplatesDir string
 }
 
 type TwistedEdwardsCurve struct {
@@ -38,6 +54,23 @@ type TwistedEdwardsCurve struct {
 	HasEndomorphism bool
 	Endo0, Endo1    string
 	Lambda          string
+
+	// set if the curve is not a companion curve: the base field is then generated
+	// in <Name>/fp and the scalar field in <Name>/fr (e.g. ed25519)
+	FpModulus, FrModulus string
+}
+
+// IsCompanion returns true if the curve is defined on the scalar field of a pairing-friendly curve
+func (c TwistedEdwardsCurve) IsCompanion() bool {
+	return c.FpModulus == ""
+}
+
+// BaseField returns the name of the package of the field the curve is defined on
+func (c TwistedEdwardsCurve) BaseField() string {
+	if c.IsCompanion() {
+		return "fr"
+	}
+	return "fp"
 }
 
 type Field struct {
@@ -50,6 +83,65 @@ func (c Curve) Equal(other Curve) bool {
 	return c.Name == other.Name
 }
 
+// SharesFields returns true if fp and fr are imported from another curve package
+func (c Curve) SharesFields() bool {
+	return c.FpImportPath != "" || c.FrImportPath != ""
+}
+
+// CurveImport returns the import declaration of the curve package
+func (c Curve) CurveImport() string {
+	if c.Custom == nil {
+		return "\"github.com/consensys/gnark-crypto/ecc/" + c.Name + "\""
+	}
+	if path.Base(c.Custom.ImportPath) == c.CurvePackage {
+		return "\"" + c.Custom.ImportPath + "\""
+	}
+	return c.CurvePackage + " \"" + c.Custom.ImportPath + "\""
+}
+
+// IsExternal returns true if the curve is generated outside of gnark-crypto, and can't import
+// its internal packages.
+func (c Curve) IsExternal() bool {
+	return c.Custom != nil && !strings.HasPrefix(c.Custom.ImportPath, "github.com/consensys/gnark-crypto/")
+}
+
+// ParallelImport returns the import declaration of the parallel package. External curves
+// get their own copy in <ImportPath>/internal/parallel (see IsExternal).
+func (c Curve) ParallelImport() string {
+	if !c.IsExternal() {
+		return "\"github.com/consensys/gnark-crypto/internal/parallel\""
+	}
+	return "\"" + c.Custom.ImportPath + "/internal/parallel\""
+}
+
+// FptowerImport returns the import declaration of the extension tower package of a pairing-friendly curve
+func (c Curve) FptowerImport() string {
+	if c.Custom == nil {
+		return "\"github.com/consensys/gnark-crypto/ecc/" + c.Name + "/internal/fptower\""
+	}
+	return "\"" + c.Custom.ImportPath + "/internal/fptower\""
+}
+
+// FpImport returns the import declaration of the base field package, as package fp
+func (c Curve) FpImport() string {
+	return fieldImport(c.Name, "fp", c.FpImportPath)
+}
+
+// FrImport returns the import declaration of the scalar field package, as package fr
+func (c Curve) FrImport() string {
+	return fieldImport(c.Name, "fr", c.FrImportPath)
+}
+
+func fieldImport(curveName, pkg, importPath string) string {
+	if importPath == "" {
+		return "\"github.com/consensys/gnark-crypto/ecc/" + curveName + "/" + pkg + "\""
+	}
+	if strings.HasSuffix(importPath, "/"+pkg) {
+		return "\"" + importPath + "\""
+	}
+	return pkg + " \"" + importPath + "\""
+}
+
 type Point struct {
 	CoordType        string
 	CoordExtDegree   uint8 // value n, such that q = pⁿ
@@ -59,7 +151,7 @@ type Point struct {
 	CofactorCleaning bool     // flag telling if the Cofactor cleaning is available
 	CRange           []int    // multiexp bucket method: generate inner methods (with const arrays) for each c
 	Projective       bool     // generate projective coordinates
-	A                []string //A linear coefficient in Weierstrass form
+	A                []string //A linear coefficient in Weierstrass form, empty if zero
 	B                []string //B constant term in Weierstrass form
 }
 
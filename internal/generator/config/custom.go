@@ -0,0 +1,454 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+	"gopkg.in/yaml.v3"
+)
+
+// CustomCurve holds the parameters of a curve loaded from a configuration file with LoadCurve.
+// For the built-in curves, they are written by hand in the curve package.
+type CustomCurve struct {
+	ImportPath  string // import path of the generated curve package
+	Description string // one line description, for the package documentation
+
+	B        string // Y² = X³ + B
+	G1X, G1Y string // generator of G1
+
+	// GLV endomorphism (x, y) → (ωx, y), acting as [λ] on G1. Set if Curve.G1.GLV is true.
+	ThirdRootOne string
+	Lambda       string
+
+	// fft on the scalar field: FrMultiplicativeGen is a quadratic non-residue and
+	// FrRootOfUnity = FrMultiplicativeGen^((r-1)/2^FrMaxOrderRoot) a primitive 2^FrMaxOrderRoot-th root of unity
+	FrMultiplicativeGen uint64
+	FrRootOfUnity       string
+	FrMaxOrderRoot      int
+
+	MultiExpCMax int // largest window size of the multi-exponentiation
+
+	Pairing *CustomPairing // set for pairing-friendly curves
+}
+
+// curveFile is the schema of the configuration files read by LoadCurve.
+// Numbers are given as strings, in base 10 or with a 0x prefix.
+type curveFile struct {
+	Name        string `yaml:"name"`
+	Package     string `yaml:"package"`
+	ImportPath  string `yaml:"importPath"`
+	Description string `yaml:"description"`
+
+	Fp string `yaml:"fp"` // base field modulus
+	Fr string `yaml:"fr"` // order of the curve, must be prime
+	B  string `yaml:"b"`  // Y² = X³ + b
+
+	G1 struct {
+		X string `yaml:"x"`
+		Y string `yaml:"y"`
+	} `yaml:"g1"`
+
+	Endomorphism *struct {
+		ThirdRootOne string `yaml:"thirdRootOne"` // ω, primitive third root of unity in 𝔽p
+		Lambda       string `yaml:"lambda"`       // λ such that (ωx, y) = [λ](x, y) on G1
+	} `yaml:"endomorphism"`
+
+	MultiExpCRange []int `yaml:"multiExpCRange"`
+
+	HashToCurve *struct {
+		// SSWU on an isogenous curve Y² = X³ + A'X + B'
+		SSWU *struct {
+			A       string   `yaml:"a"`
+			B       string   `yaml:"b"`
+			Z       int      `yaml:"z"`
+			Isogeny *Isogeny `yaml:"isogeny"`
+		} `yaml:"sswu"`
+		// Shallue-van de Woestijne with the given Z, the constants are derived from it
+		SVDW *struct {
+			Z int64 `yaml:"z"`
+		} `yaml:"svdw"`
+	} `yaml:"hashToCurve"`
+
+	// pairing-friendly curves of the BLS12 and BN families; G1 is on the curve and G2 on its sextic twist
+	Pairing *struct {
+		Family string `yaml:"family"` // bls12 or bn
+		X      string `yaml:"x"`      // seed x₀: p and r must be the polynomials of the family at x₀
+	} `yaml:"pairing"`
+	// 𝔽p² = 𝔽p[u]/(u²-β), 𝔽p⁶ = 𝔽p²[v]/(v³-ξ) and 𝔽p¹² = 𝔽p⁶[w]/(w²-v); small β and ξ = k+u are found if not given
+	Tower *struct {
+		NonResidue      int64   `yaml:"nonResidue"`      // β
+		CubicNonResidue []int64 `yaml:"cubicNonResidue"` // ξ, as [ξ₀, ξ₁] for ξ₀ + ξ₁u
+	} `yaml:"tower"`
+	// generator of G2, with coordinates [x₀, x₁] for x₀ + x₁u, on the D-twist Y² = X³ + b/ξ or on the M-twist Y² = X³ + b⋅ξ
+	G2 *struct {
+		X []string `yaml:"x"`
+		Y []string `yaml:"y"`
+	} `yaml:"g2"`
+}
+
+// LoadCurve reads the description of a curve from a JSON or YAML file.
+//
+// Only short Weierstrass curves Y² = X³ + b (j-invariant 0), with at least 2 spare bits in the most significant
+// word of p, are supported. Without the pairing key, the curve must be of prime order: the generated package has
+// G1, multi-exponentiation, serialization, hash-to-curve and ECDSA, like grumpkin.
+// With the pairing key, the curve is a BLS12 or BN curve given by its seed, and the generated package also has
+// G2 on the sextic twist, the 𝔽p¹² tower, the optimal ate pairing and KZG, like bls12-381 and bn254. Their
+// built-in optimizations (GLV on G2, assembly, dedicated subgroup checks) are not generated.
+// If no hash-to-curve suite is given, SVDW is used with the Z of RFC 9380, appendix H.1; G2 always uses SVDW.
+func LoadCurve(path string) (Curve, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return Curve{}, err
+	}
+	var f curveFile
+	dec := yaml.NewDecoder(bytes.NewReader(buf))
+	dec.KnownFields(true)
+	if err := dec.Decode(&f); err != nil {
+		return Curve{}, fmt.Errorf("%s: %w", path, err)
+	}
+	c, err := f.curve()
+	if err != nil {
+		return Curve{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return c, nil
+}
+
+func (f *curveFile) curve() (Curve, error) {
+	if f.Name == "" || f.ImportPath == "" {
+		return Curve{}, errors.New("name and importPath are required")
+	}
+	if f.Pairing == nil && (f.G2 != nil || f.Tower != nil) {
+		return Curve{}, errors.New("g2 and tower are only used by pairing-friendly curves, described by the pairing key")
+	}
+	pkg := f.Package
+	if pkg == "" {
+		pkg = strings.ReplaceAll(f.Name, "-", "")
+	}
+
+	p, err := parseInt("fp", f.Fp)
+	if err != nil {
+		return Curve{}, err
+	}
+	r, err := parseInt("fr", f.Fr)
+	if err != nil {
+		return Curve{}, err
+	}
+	if !p.ProbablyPrime(32) || !r.ProbablyPrime(32) {
+		return Curve{}, errors.New("fp and fr must be prime")
+	}
+	b, err := parseInt("b", f.B)
+	if err != nil {
+		return Curve{}, err
+	}
+	b.Mod(b, p)
+	if b.BitLen() == 0 {
+		return Curve{}, errors.New("b must not be 0")
+	}
+
+	// Hasse: |p + 1 - r| ≤ 2√p, so that r is the order of E(𝔽p); pairing-friendly curves are checked from their family
+	var t, bound big.Int
+	t.Add(p, big.NewInt(1)).Sub(&t, r).Abs(&t)
+	bound.Sqrt(p).Lsh(&bound, 1)
+	if f.Pairing == nil && t.Cmp(&bound) > 0 {
+		return Curve{}, errors.New("fr is not the order of the curve")
+	}
+
+	// the serialization uses the two most significant bits of the encoding of x for the flags
+	if p.BitLen()%64 > 62 || p.BitLen()%64 == 0 {
+		return Curve{}, errors.New("fp must have at least 2 spare bits in its most significant word")
+	}
+
+	x, err := parseInt("g1.x", f.G1.X)
+	if err != nil {
+		return Curve{}, err
+	}
+	y, err := parseInt("g1.y", f.G1.Y)
+	if err != nil {
+		return Curve{}, err
+	}
+	var lhs, rhs big.Int
+	lhs.Mul(y, y).Mod(&lhs, p)
+	rhs.Exp(x, big.NewInt(3), p).Add(&rhs, b).Mod(&rhs, p)
+	if lhs.Cmp(&rhs) != 0 {
+		return Curve{}, errors.New("the generator is not on the curve")
+	}
+
+	custom := &CustomCurve{
+		ImportPath:  f.ImportPath,
+		Description: f.Description,
+		B:           b.String(),
+		G1X:         x.Mod(x, p).String(),
+		G1Y:         y.Mod(y, p).String(),
+	}
+
+	c := Curve{
+		Name:         f.Name,
+		CurvePackage: pkg,
+		FpModulus:    p.String(),
+		FrModulus:    r.String(),
+		FpImportPath: f.ImportPath + "/fp",
+		FrImportPath: f.ImportPath + "/fr",
+		Custom:       custom,
+		G1: Point{
+			CoordType:      "fp.Element",
+			CoordExtDegree: 1,
+			PointName:      "g1",
+			CRange:         f.MultiExpCRange,
+		},
+	}
+	// the last window of the multi-exponentiation must fit the digits (uint16) with the carry
+	lastWindowFits := func(w int) bool {
+		nbBits := r.BitLen()
+		nbChunks := (nbBits + w - 1) / w
+		return w+1-(nbChunks*w-nbBits) <= 16
+	}
+	if len(c.G1.CRange) == 0 {
+		for _, w := range defaultCRange() {
+			if lastWindowFits(w) {
+				c.G1.CRange = append(c.G1.CRange, w)
+			}
+		}
+	}
+	for _, w := range c.G1.CRange {
+		if w < 2 || w > 16 || !lastWindowFits(w) {
+			return Curve{}, fmt.Errorf("multiExpCRange: unsupported window size %d", w)
+		}
+		if w > custom.MultiExpCMax {
+			custom.MultiExpCMax = w
+		}
+	}
+
+	if e := f.Endomorphism; e != nil {
+		omega, err := parseInt("endomorphism.thirdRootOne", e.ThirdRootOne)
+		if err != nil {
+			return Curve{}, err
+		}
+		lambda, err := parseInt("endomorphism.lambda", e.Lambda)
+		if err != nil {
+			return Curve{}, err
+		}
+		// ω³ = 1, ω ≠ 1 and λ² + λ + 1 = 0 mod r
+		var o, l big.Int
+		omega.Mod(omega, p)
+		lambda.Mod(lambda, r)
+		o.Exp(omega, big.NewInt(3), p)
+		l.Mul(lambda, lambda).Add(&l, lambda).Add(&l, big.NewInt(1)).Mod(&l, r)
+		if o.Cmp(big.NewInt(1)) != 0 || omega.Cmp(big.NewInt(1)) == 0 || l.BitLen() != 0 {
+			return Curve{}, errors.New("invalid endomorphism: expected a primitive third root of unity ω and λ² + λ + 1 = 0 mod r")
+		}
+		c.G1.GLV = true
+		custom.ThirdRootOne = omega.String()
+		custom.Lambda = lambda.String()
+	}
+
+	if f.Pairing != nil {
+		if custom.Pairing, err = f.pairing(&c, p, r, b, x, y); err != nil {
+			return Curve{}, err
+		}
+	}
+
+	// hash to curve
+	switch {
+	case f.HashToCurve != nil && f.HashToCurve.SSWU != nil:
+		s := f.HashToCurve.SSWU
+		if s.Isogeny == nil {
+			return Curve{}, errors.New("the SSWU suite needs the isogeny to the curve")
+		}
+		c.HashE1 = &HashSuiteSswu{
+			A:       []string{s.A},
+			B:       []string{s.B},
+			Z:       []int{s.Z},
+			Isogeny: s.Isogeny,
+		}
+	default:
+		var z int64
+		if f.HashToCurve != nil && f.HashToCurve.SVDW != nil {
+			z = f.HashToCurve.SVDW.Z
+		}
+		fpConf, err := field.NewFieldConfig("fp", "Element", p.String(), false)
+		if err != nil {
+			return Curve{}, err
+		}
+		fp := field.NewTower(fpConf, 1, 0)
+		h, err := newHashSuiteSvdw(&fp, field.Element{*b}, z)
+		if err != nil {
+			return Curve{}, err
+		}
+		c.HashE1 = h
+	}
+
+	// fft on fr
+	var rMinusOne, e, g big.Int
+	rMinusOne.Sub(r, big.NewInt(1))
+	custom.FrMaxOrderRoot = int(rMinusOne.TrailingZeroBits())
+	for custom.FrMultiplicativeGen = 2; big.Jacobi(g.SetUint64(custom.FrMultiplicativeGen), r) != -1; custom.FrMultiplicativeGen++ {
+	}
+	e.Rsh(&rMinusOne, uint(custom.FrMaxOrderRoot))
+	custom.FrRootOfUnity = g.Exp(&g, &e, r).String()
+
+	c.FpInfo = newFieldInfo(c.FpModulus)
+	c.FrInfo = newFieldInfo(c.FrModulus)
+	return c, nil
+}
+
+// newHashSuiteSvdw returns the SVDW suite of RFC 9380, section 6.6.1, for Y² = X³ + b over f (𝔽p or 𝔽p²).
+// If z is 0, it is chosen following RFC 9380, appendix H.1.
+func newHashSuiteSvdw(f *field.Extension, b field.Element, z int64) (*HashSuiteSvdw, error) {
+	p := f.Base.ModulusBig
+	one := f.FromInt64(1)
+	var e big.Int
+	e.Sub(&f.Size, big.NewInt(1)).Rsh(&e, 1)
+	isSquare := func(x field.Element) bool { return f.IsZero(x) || f.Equal(f.Exp(x, &e), one) }
+	g := func(x field.Element) field.Element {
+		return f.Add(f.Mul(f.Mul(x, x), x), b)
+	}
+	// h(Z) = -(3Z²)/(4g(Z)), with A = 0
+	h := func(Z field.Element) field.Element {
+		den := f.MulScalar(big.NewInt(4), g(Z))
+		if f.IsZero(den) {
+			return f.FromInt64(0)
+		}
+		return f.Div(f.MulScalar(big.NewInt(-3), f.Mul(Z, Z)), den)
+	}
+	minusZOverTwo := func(Z field.Element) field.Element {
+		r := reduce(p, f.Neg(Z))
+		f.Halve(r)
+		return r
+	}
+	valid := func(Z field.Element) bool {
+		if f.IsZero(g(Z)) {
+			return false
+		}
+		hz := h(Z)
+		if f.IsZero(hz) || !isSquare(hz) {
+			return false
+		}
+		return isSquare(g(Z)) || isSquare(g(minusZOverTwo(Z)))
+	}
+
+	var Z field.Element
+	if z != 0 {
+		Z = reduce(p, f.FromInt64(z))
+		if !valid(Z) {
+			return nil, fmt.Errorf("Z = %d is not valid for SVDW", z)
+		}
+	} else {
+		for ctr := int64(1); ctr < 1<<16 && Z == nil; ctr++ {
+			for _, c := range []int64{ctr, -ctr} {
+				if cand := reduce(p, f.FromInt64(c)); valid(cand) {
+					Z = cand
+					break
+				}
+			}
+		}
+		if Z == nil {
+			return nil, errors.New("no Z found for SVDW")
+		}
+	}
+
+	// c1 = g(Z), c2 = -Z/2, c3 = sqrt(-g(Z)⋅3Z²) with sgn0(c3) = 0, c4 = -4g(Z)/(3Z²)
+	c1 := g(Z)
+	c2 := minusZOverTwo(Z)
+	threeZ2 := f.MulScalar(big.NewInt(3), f.Mul(Z, Z))
+	c3 := sqrt(f, reduce(p, f.Neg(f.Mul(c1, threeZ2))))
+	if c3 == nil {
+		return nil, errors.New("SVDW: -g(Z)⋅3Z² is not a square")
+	}
+	if sgn0(c3) == 1 {
+		c3 = reduce(p, f.Neg(c3))
+	}
+	c4 := f.MulScalar(big.NewInt(-4), f.Div(c1, threeZ2))
+
+	return &HashSuiteSvdw{
+		z:  elementStrings(Z),
+		c1: elementStrings(c1),
+		c2: elementStrings(c2),
+		c3: elementStrings(c3),
+		c4: elementStrings(c4),
+	}, nil
+}
+
+// sqrt returns a square root of x, or nil if x is not a square.
+// In 𝔽p², it doesn't use field.Extension.Sqrt, which takes x₀² + βx₁² for the norm of x.
+func sqrt(f *field.Extension, x field.Element) field.Element {
+	p := f.Base.ModulusBig
+	x = reduce(p, append(field.Element{}, x...))
+	switch f.Degree {
+	case 1:
+		var z big.Int
+		if z.ModSqrt(&x[0], p) == nil {
+			return nil
+		}
+		return field.Element{z}
+	case 2:
+	default:
+		return nil
+	}
+
+	// x = z², with z = z₀ + z₁u: z₀² + βz₁² = x₀, 2z₀z₁ = x₁ and z₀² - βz₁² = ±√(x₀² - βx₁²)
+	var beta, n, d big.Int
+	beta.SetInt64(f.RootOf).Mod(&beta, p)
+	if x[1].BitLen() == 0 {
+		// z = √x₀ or z = √(x₀/β)⋅u
+		var t big.Int
+		if t.ModSqrt(&x[0], p) != nil {
+			return field.Element{t, big.Int{}}
+		}
+		t.ModInverse(&beta, p).Mul(&t, &x[0]).Mod(&t, p)
+		if t.ModSqrt(&t, p) == nil {
+			return nil
+		}
+		return field.Element{big.Int{}, t}
+	}
+	n.Mul(&x[1], &x[1]).Mul(&n, &beta).Neg(&n)
+	n.Add(&n, new(big.Int).Mul(&x[0], &x[0])).Mod(&n, p)
+	if d.ModSqrt(&n, p) == nil {
+		return nil
+	}
+	for _, s := range []*big.Int{&d, new(big.Int).Neg(&d)} {
+		var z0, z1 big.Int
+		z0.Add(&x[0], s).Mul(&z0, new(big.Int).ModInverse(big.NewInt(2), p)).Mod(&z0, p)
+		if z0.ModSqrt(&z0, p) == nil || z0.BitLen() == 0 {
+			continue
+		}
+		z1.Lsh(&z0, 1).ModInverse(&z1, p).Mul(&z1, &x[1]).Mod(&z1, p)
+		z := field.Element{z0, z1}
+		if f.Equal(f.Mul(z, z), x) {
+			return z
+		}
+	}
+	return nil
+}
+
+// sgn0 is the sign of x, as in RFC 9380, section 4.1
+func sgn0(x field.Element) uint {
+	sign, zero := uint(0), uint(1)
+	for i := range x {
+		sign |= zero & x[i].Bit(0)
+		if x[i].BitLen() != 0 {
+			zero = 0
+		}
+	}
+	return sign
+}
+
+func elementStrings(x field.Element) []string {
+	res := make([]string, len(x))
+	for i := range x {
+		res[i] = x[i].String()
+	}
+	return res
+}
+
+func parseInt(name, s string) (*big.Int, error) {
+	var x big.Int
+	if _, ok := x.SetString(s, 0); !ok {
+		return nil, fmt.Errorf("invalid or missing %s: %q", name, s)
+	}
+	return &x, nil
+}
@@ -0,0 +1,288 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+// CustomPairing holds the parameters of a pairing-friendly curve loaded from a configuration file,
+// derived from the seed x₀ of its family. For the built-in curves, they are written by hand in the
+// curve package and its internal/fptower package.
+type CustomPairing struct {
+	Family string // "bls12" or "bn"
+	MTwist bool   // G2 is on the M-twist Y² = X³ + b⋅ξ, instead of the D-twist Y² = X³ + b/ξ
+
+	Seed         string // |x₀|
+	SeedNegative bool   // x₀ < 0
+	SeedNAF      []int  // NAF of |x₀|, little endian
+	LoopCounter  []int  // NAF of the Miller loop scalar, |x₀| (BLS12) or |6x₀+2| (BN), little endian
+	LoopNegative bool   // the Miller loop scalar is negative
+
+	// 𝔽p² = 𝔽p[u]/(u²-β), 𝔽p⁶ = 𝔽p²[v]/(v³-ξ) and 𝔽p¹² = 𝔽p⁶[w]/(w²-v)
+	NonResidue      int64     // β
+	CubicNonResidue [2]string // ξ
+
+	BTwist   [2]string // b' of the twist Y² = X³ + b'
+	G2X, G2Y [2]string // generator of G2
+
+	// ψ(x, y) = (x̄⋅EndoU, ȳ⋅EndoV) is the endomorphism of the twist acting as [p] on G2
+	EndoU, EndoV [2]string
+
+	// FrobeniusCoeffs[j-1][k-1] = ξ^(k(pʲ-1)/6), for j = 1, 2, 3 and k = 1, ..., 5; they are in 𝔽p for j = 2
+	FrobeniusCoeffs [3][5][2]string
+
+	GTLambda string // p mod r, the eigenvalue of the Frobenius on GT
+}
+
+// pairing checks that p and r are the moduli of the family at the seed, and derives the parameters of G2,
+// the extension tower and the pairing. It sets the G2 and HashE2 fields of c.
+func (f *curveFile) pairing(c *Curve, p, r, b, g1x, g1y *big.Int) (*CustomPairing, error) {
+	x0, err := parseInt("pairing.x", f.Pairing.X)
+	if err != nil {
+		return nil, err
+	}
+	res := &CustomPairing{Family: f.Pairing.Family}
+
+	one := big.NewInt(1)
+	var x2, wantP, wantR, loop big.Int
+	x2.Mul(x0, x0)
+	switch res.Family {
+	case "bls12":
+		// r = x⁴ - x² + 1, p = (x-1)²r/3 + x
+		var t, rem big.Int
+		wantR.Mul(&x2, &x2).Sub(&wantR, &x2).Add(&wantR, one)
+		t.Sub(x0, one)
+		t.Mul(&t, &t).Mul(&t, &wantR)
+		t.QuoRem(&t, big.NewInt(3), &rem)
+		wantP.Add(&t, x0)
+		if rem.BitLen() != 0 {
+			wantP.SetInt64(0)
+		}
+		loop.Set(x0)
+	case "bn":
+		// r = 36x⁴ + 36x³ + 18x² + 6x + 1, p = r + 6x²
+		var t big.Int
+		wantR.Mul(&x2, big.NewInt(36)).Add(&wantR, t.Mul(x0, big.NewInt(36))).Add(&wantR, big.NewInt(18))
+		wantR.Mul(&wantR, x0).Add(&wantR, big.NewInt(6)).Mul(&wantR, x0).Add(&wantR, one)
+		wantP.Mul(&x2, big.NewInt(6)).Add(&wantP, &wantR)
+		loop.Mul(x0, big.NewInt(6)).Add(&loop, big.NewInt(2))
+	default:
+		return nil, fmt.Errorf("unsupported pairing family %q: expected bls12 or bn", res.Family)
+	}
+	if wantP.Cmp(p) != 0 || wantR.Cmp(r) != 0 {
+		return nil, fmt.Errorf("fp and fr are not the moduli of the %s family at x = %s", res.Family, x0)
+	}
+	res.SeedNegative = x0.Sign() < 0
+	res.LoopNegative = loop.Sign() < 0
+	res.Seed = new(big.Int).Abs(x0).String()
+	res.SeedNAF = naf(new(big.Int).Abs(x0))
+	res.LoopCounter = naf(loop.Abs(&loop))
+	res.GTLambda = new(big.Int).Mod(p, r).String()
+
+	fpConf, err := field.NewFieldConfig("fp", "Element", p.String(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// 𝔽p²: E2.Sqrt implements algorithm 9 of https://eprint.iacr.org/2012/685.pdf, which needs u² = -1,
+	// when p ≡ 3 mod 4, and algorithm 10 otherwise, where -1 is a square and u isn't.
+	if f.Tower != nil {
+		res.NonResidue = f.Tower.NonResidue
+	}
+	if res.NonResidue == 0 {
+		if res.NonResidue, err = field.FindRootOf(fpConf, 2); err != nil {
+			return nil, err
+		}
+	}
+	var beta big.Int
+	beta.SetInt64(res.NonResidue).Mod(&beta, p)
+	if big.Jacobi(&beta, p) != -1 {
+		return nil, errors.New("tower.nonResidue must not be a square in fp")
+	}
+	if p.Bit(1) == 1 && res.NonResidue != -1 {
+		return nil, errors.New("tower.nonResidue must be -1 when p ≡ 3 mod 4")
+	}
+	e2 := field.NewTower(fpConf, 2, res.NonResidue)
+
+	// 𝔽p¹² = 𝔽p²[w]/(w⁶-ξ), ξ must be neither a square nor a cube
+	var qMinusOne, e, e3 big.Int
+	qMinusOne.Mul(p, p).Sub(&qMinusOne, one)
+	e.Rsh(&qMinusOne, 1)
+	e3.Div(&qMinusOne, big.NewInt(3))
+	isSextic := func(xi field.Element) bool {
+		o := e2.FromInt64(1)
+		return !e2.Equal(e2.Exp(xi, &e), o) && !e2.Equal(e2.Exp(xi, &e3), o)
+	}
+	var xi field.Element
+	if f.Tower != nil && len(f.Tower.CubicNonResidue) != 0 {
+		if len(f.Tower.CubicNonResidue) != 2 {
+			return nil, errors.New("tower.cubicNonResidue must have 2 coordinates")
+		}
+		xi = reduce(p, e2.FromInt64(f.Tower.CubicNonResidue...))
+		if !isSextic(xi) {
+			return nil, errors.New("tower.cubicNonResidue must be neither a square nor a cube in 𝔽p²")
+		}
+	} else {
+		for k := int64(0); xi == nil; k++ {
+			if k == 1<<16 {
+				return nil, errors.New("no small cubic non-residue found")
+			}
+			if c := e2.FromInt64(k, 1); isSextic(c) {
+				xi = c
+			}
+		}
+	}
+	res.CubicNonResidue = e2String(xi)
+
+	// G2, on the D-twist or on the M-twist
+	if f.G2 == nil || len(f.G2.X) != 2 || len(f.G2.Y) != 2 {
+		return nil, errors.New("g2 must have two coordinates x and y in 𝔽p², given as [x₀, x₁] for x₀ + x₁u")
+	}
+	g2x, g2y := make(field.Element, 2), make(field.Element, 2)
+	for i := 0; i < 2; i++ {
+		a, err := parseInt("g2.x", f.G2.X[i])
+		if err != nil {
+			return nil, err
+		}
+		g2x[i].Mod(a, p)
+		if a, err = parseInt("g2.y", f.G2.Y[i]); err != nil {
+			return nil, err
+		}
+		g2y[i].Mod(a, p)
+	}
+	bE2 := field.Element{*new(big.Int).Set(b), big.Int{}}
+	bTwist := e2.Div(bE2, xi)
+	if !onCurve(&e2, bTwist, g2x, g2y) {
+		res.MTwist = true
+		bTwist = e2.Mul(bE2, xi)
+		if !onCurve(&e2, bTwist, g2x, g2y) {
+			return nil, errors.New("the generator of G2 is neither on the D-twist Y² = X³ + b/ξ nor on the M-twist Y² = X³ + b⋅ξ")
+		}
+	}
+	res.BTwist = e2String(reduce(p, bTwist))
+	res.G2X, res.G2Y = e2String(g2x), e2String(g2y)
+
+	e1 := field.NewTower(fpConf, 1, 0)
+	if !scalarMul(&e1, field.Element{*g1x}, field.Element{*g1y}, r).inf {
+		return nil, errors.New("the generator of G1 is not of order r")
+	}
+	if !scalarMul(&e2, g2x, g2y, r).inf {
+		return nil, errors.New("the generator of G2 is not of order r")
+	}
+
+	// ψ = φ⁻¹∘π∘φ, where φ: (x, y) → (xw², yw³) (D-twist) or (x/w², y/w³) (M-twist) maps the twist to the curve
+	var pMinusOne big.Int
+	pMinusOne.Sub(p, one)
+	endoU := e2.Exp(xi, new(big.Int).Div(&pMinusOne, big.NewInt(3)))
+	endoV := e2.Exp(xi, new(big.Int).Rsh(&pMinusOne, 1))
+	if res.MTwist {
+		endoU, endoV = e2.Inverse(endoU), e2.Inverse(endoV)
+	}
+	res.EndoU, res.EndoV = e2String(reduce(p, endoU)), e2String(reduce(p, endoV))
+
+	var pj big.Int
+	pj.Set(one)
+	for j := 0; j < 3; j++ {
+		var ej big.Int
+		pj.Mul(&pj, p)
+		ej.Sub(&pj, one).Div(&ej, big.NewInt(6))
+		for k := 0; k < 5; k++ {
+			var ek big.Int
+			ek.Mul(&ej, big.NewInt(int64(k+1)))
+			res.FrobeniusCoeffs[j][k] = e2String(e2.Exp(xi, &ek))
+		}
+	}
+
+	c.G2 = Point{
+		CoordType:        "fptower.E2",
+		CoordExtDegree:   2,
+		CoordExtRoot:     res.NonResidue,
+		PointName:        "g2",
+		CofactorCleaning: true,
+		CRange:           append([]int(nil), c.G1.CRange...), // ecc.Generate extends the ranges of G1 and G2 in place
+		Projective:       true,
+	}
+	if c.HashE2, err = newHashSuiteSvdw(&e2, bTwist, 0); err != nil {
+		return nil, err
+	}
+	c.G1.CofactorCleaning = res.Family == "bls12"
+
+	return res, nil
+}
+
+// naf returns the non-adjacent form of x ≥ 0, little endian
+func naf(x *big.Int) []int {
+	var k big.Int
+	k.Set(x)
+	var res []int
+	for k.Sign() > 0 {
+		d := 0
+		if k.Bit(0) == 1 {
+			d = 2 - int(k.Bits()[0]&3)
+			k.Sub(&k, big.NewInt(int64(d)))
+		}
+		res = append(res, d)
+		k.Rsh(&k, 1)
+	}
+	return res
+}
+
+func reduce(p *big.Int, x field.Element) field.Element {
+	for i := range x {
+		x[i].Mod(&x[i], p)
+	}
+	return x
+}
+
+func e2String(x field.Element) [2]string {
+	return [2]string{x[0].String(), x[1].String()}
+}
+
+func onCurve(f *field.Extension, b, x, y field.Element) bool {
+	rhs := f.Add(f.Mul(f.Mul(x, x), x), b)
+	return f.Equal(f.Mul(y, y), rhs)
+}
+
+// affinePoint is a point of Y² = X³ + b, in affine coordinates
+type affinePoint struct {
+	x, y field.Element
+	inf  bool
+}
+
+func (p affinePoint) add(f *field.Extension, q affinePoint) affinePoint {
+	switch {
+	case p.inf:
+		return q
+	case q.inf:
+		return p
+	}
+	var lambda field.Element
+	if f.Equal(p.x, q.x) {
+		if !f.Equal(p.y, q.y) || f.IsZero(p.y) {
+			return affinePoint{inf: true}
+		}
+		// λ = 3x²/2y
+		lambda = f.Div(f.MulScalar(big.NewInt(3), f.Mul(p.x, p.x)), f.Add(p.y, p.y))
+	} else {
+		lambda = f.Div(f.Add(q.y, f.Neg(p.y)), f.Add(q.x, f.Neg(p.x)))
+	}
+	x := f.Add(f.Mul(lambda, lambda), f.Neg(f.Add(p.x, q.x)))
+	y := f.Add(f.Mul(lambda, f.Add(p.x, f.Neg(x))), f.Neg(p.y))
+	return affinePoint{x: x, y: y}
+}
+
+// scalarMul returns [s](x, y)
+func scalarMul(f *field.Extension, x, y field.Element, s *big.Int) affinePoint {
+	res := affinePoint{inf: true}
+	p := affinePoint{x: x, y: y}
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = res.add(f, res)
+		if s.Bit(i) == 1 {
+			res = res.add(f, p)
+		}
+	}
+	return res
+}
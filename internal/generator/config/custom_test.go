@@ -0,0 +1,206 @@
+package config
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	field "github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+func TestNewHashSuiteSvdw(t *testing.T) {
+	t.Parallel()
+
+	// the constants of the built-in SVDW suites must be recovered from the curve equation
+	for _, c := range []struct {
+		curve Curve
+		b     int64
+	}{
+		{BN254, 3},
+		{GRUMPKIN, -17},
+		{SECP256K1, 7},
+	} {
+		fpConf, err := field.NewFieldConfig("fp", "Element", c.curve.FpModulus, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fp := field.NewTower(fpConf, 1, 0)
+		b := big.NewInt(c.b)
+		b.Mod(b, fpConf.ModulusBig)
+
+		h, err := newHashSuiteSvdw(&fp, field.Element{*b}, 0)
+		if err != nil {
+			t.Fatal(c.curve.Name, err)
+		}
+		if !reflect.DeepEqual(h, c.curve.HashE1) {
+			t.Fatalf("%s: expected %v, got %v", c.curve.Name, c.curve.HashE1, h)
+		}
+	}
+
+	// and over 𝔽p², for the twist Y² = X³ + 3/(9+u) of bn254
+	fpConf, err := field.NewFieldConfig("fp", "Element", BN254.FpModulus, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e2 := field.NewTower(fpConf, 2, -1)
+	bTwist := reduce(fpConf.ModulusBig, e2.Div(e2.FromInt64(3), e2.FromInt64(9, 1)))
+	h, err := newHashSuiteSvdw(&e2, bTwist, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h, BN254.HashE2) {
+		t.Fatalf("bn254 G2: expected %v, got %v", BN254.HashE2, h)
+	}
+}
+
+func TestLoadCurve(t *testing.T) {
+	t.Parallel()
+
+	const grumpkin = `
+name: my-grumpkin
+importPath: example.com/curves/grumpkin
+fp: "21888242871839275222246405745257275088548364400416034343698204186575808495617"
+fr: "21888242871839275222246405745257275088696311157297823662689037894645226208583"
+b: "-17"
+g1:
+  x: "1"
+  y: "17631683881184975370165255887551781615748388533673675138860"
+endomorphism:
+  thirdRootOne: "4407920970296243842393367215006156084916469457145843978461"
+  lambda: "2203960485148121921418603742825762020974279258880205651966"
+`
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	c, err := LoadCurve(write("grumpkin.yaml", grumpkin))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.CurvePackage != "mygrumpkin" || c.FpImport() != `"example.com/curves/grumpkin/fp"` || c.CurveImport() != `mygrumpkin "example.com/curves/grumpkin"` {
+		t.Fatal("unexpected package names or import paths")
+	}
+	if !c.G1.GLV || !reflect.DeepEqual(c.HashE1, GRUMPKIN.HashE1) {
+		t.Fatal("unexpected GLV or hash to curve parameters")
+	}
+	if c.ParallelImport() != `"example.com/curves/grumpkin/internal/parallel"` {
+		t.Fatal("curves outside of gnark-crypto must use their own copy of the parallel package")
+	}
+
+	// the generator is not on the curve
+	if _, err := LoadCurve(write("wrong.yaml", strings.Replace(grumpkin, `b: "-17"`, `b: "-16"`, 1))); err == nil {
+		t.Fatal("expected an error for a generator not on the curve")
+	}
+	if _, err := LoadCurve(write("g2.yaml", grumpkin+"g2:\n  x: [\"1\", \"0\"]\n")); err == nil || !strings.Contains(err.Error(), "pairing-friendly") {
+		t.Fatal("expected an error for g2 without pairing")
+	}
+	if _, err := LoadCurve(write("unknown.yaml", grumpkin+"cofactor: 2\n")); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+}
+
+func TestLoadCurvePairing(t *testing.T) {
+	t.Parallel()
+
+	// the parameters derived from the seeds of bn254, bls12-381 and bls12-377 must be the ones of the built-in packages
+	load := func(curve Curve, config string) (Curve, error) {
+		config = "name: my-" + curve.Name + "\nimportPath: example.com/curves/pairing\nfp: \"" + curve.FpModulus + "\"\nfr: \"" + curve.FrModulus + "\"\n" + config
+		path := filepath.Join(t.TempDir(), "curve.yaml")
+		if err := os.WriteFile(path, []byte(config), 0600); err != nil {
+			t.Fatal(err)
+		}
+		return LoadCurve(path)
+	}
+
+	const bn254 = `
+pairing:
+  family: bn
+  x: "4965661367192848881"
+tower:
+  cubicNonResidue: [9, 1]
+b: "3"
+g1:
+  x: "1"
+  y: "2"
+g2:
+  x: ["10857046999023057135944570762232829481370756359578518086990519993285655852781", "11559732032986387107991004021392285783925812861821192530917403151452391805634"]
+  y: ["8495653923123431417604973247489272438418190587263600148770280649306958101930", "4082367875863433681332203403145435568316851327593401208105741076214120093531"]
+`
+	for _, c := range []struct {
+		curve        Curve
+		config       string
+		beta         int64
+		mTwist       bool
+		endoU, endoV [2]string
+	}{
+		{BN254, bn254, -1, false,
+			[2]string{"21575463638280843010398324269430826099269044274347216827212613867836435027261", "10307601595873709700152284273816112264069230130616436755625194854815875713954"},
+			[2]string{"2821565182194536844548159561693502659359617185244120367078079554186484126554", "3505843767911556378687030309984248845540243509899259641013678093033130930403"}},
+		{BLS12_381, `
+pairing:
+  family: bls12
+  x: "-0xd201000000010000"
+tower:
+  cubicNonResidue: [1, 1]
+b: "4"
+g1:
+  x: "3685416753713387016781088315183077757961620795782546409894578378688607592378376318836054947676345821548104185464507"
+  y: "1339506544944476473020471379941921221584933875938349620426543736416511423956333506472724655353366534992391756441569"
+g2:
+  x: ["352701069587466618187139116011060144890029952792775240219908644239793785735715026873347600343865175952761926303160", "3059144344244213709971259814753781636986470325476647558659373206291635324768958432433509563104347017837885763365758"]
+  y: ["1985150602287291935568054521177171638300868978215655730859378665066344726373823718423869104263333984641494340347905", "927553665492332455747201965776037880757740193453592970025027978793976877002675564980949289727957565575433344219582"]
+`, -1, true,
+			[2]string{"0", "4002409555221667392624310435006688643935503118305586438271171395842971157480381377015405980053539358417135540939437"},
+			[2]string{"2973677408986561043442465346520108879172042883009249989176415018091420807192182638567116318576472649347015917690530", "1028732146235106349975324479215795277384839936929757896155643118032610843298655225875571310552543014690878354869257"}},
+		{BLS12_377, `
+pairing:
+  family: bls12
+  x: "9586122913090633729"
+tower:
+  nonResidue: -5
+b: "1"
+g1:
+  x: "81937999373150964239938255573465948239988671502647976594219695644855304257327692006745978603320413799295628339695"
+  y: "241266749859715473739788878240585681733927191168601896383759122102112907357779751001206799952863815012735208165030"
+g2:
+  x: ["233578398248691099356572568220835526895379068987715365179118596935057653620464273615301663571204657964920925606294", "140913150380207355837477652521042157274541796891053068589147167627541651775299824604154852141315666357241556069118"]
+  y: ["63160294768292073209381361943935198908131692476676907196754037919244929611450776219210369229519898517858833747423", "149157405641012693445398062341192467754805999074082136895788947234480009303640899064710353187729182149407503257491"]
+`, -5, false,
+			[2]string{"80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946", "0"},
+			[2]string{"216465761340224619389371505802605247630151569547285782856803747159100223055385581585702401816380679166954762214499", "0"}},
+	} {
+		conf, err := load(c.curve, c.config)
+		if err != nil {
+			t.Fatal(c.curve.Name, err)
+		}
+		pairing := conf.Custom.Pairing
+		if pairing.MTwist != c.mTwist || pairing.EndoU != c.endoU || pairing.EndoV != c.endoV {
+			t.Fatalf("%s: unexpected twist or endomorphism", c.curve.Name)
+		}
+		if pairing.NonResidue != c.beta || conf.G1.CofactorCleaning != c.curve.G1.CofactorCleaning {
+			t.Fatalf("%s: unexpected tower or cofactor cleaning", c.curve.Name)
+		}
+		if _, svdw := c.curve.HashE2.(*HashSuiteSvdw); svdw && !reflect.DeepEqual(conf.HashE2, c.curve.HashE2) {
+			t.Fatalf("%s: unexpected hash to G2 parameters", c.curve.Name)
+		}
+	}
+
+	// wrong seed, generator of G2 not on the twist, twist not sextic
+	for _, config := range []string{
+		strings.Replace(bn254, `x: "4965661367192848881"`, `x: "4965661367192848882"`, 1),
+		strings.Replace(bn254, `y: ["8495`, `y: ["8496`, 1),
+		strings.Replace(bn254, `[9, 1]`, `[9, 0]`, 1),
+	} {
+		if _, err := load(BN254, config); err == nil {
+			t.Fatal("expected an error for a wrong pairing configuration")
+		}
+	}
+}
@@ -16,13 +16,13 @@ const (
 type Isogeny struct {
 
 	//Isogeny to original curve
-	XMap RationalPolynomial
-	YMap RationalPolynomial // The y map is also evaluated on x. The result is multiplied by y.
+	XMap RationalPolynomial `yaml:"xMap"`
+	YMap RationalPolynomial `yaml:"yMap"` // The y map is also evaluated on x. The result is multiplied by y.
 }
 
 type RationalPolynomial struct {
-	Num [][]string //Num is stored
-	Den [][]string //Den is stored. It is also monic. The leading coefficient (1) is omitted.
+	Num [][]string `yaml:"num"` //Num is stored
+	Den [][]string `yaml:"den"` //Den is stored. It is also monic. The leading coefficient (1) is omitted.
 }
 
 type HashSuite interface {
@@ -36,6 +36,8 @@ type HashSuiteSswu struct {
 
 	Z       []int // z (or zeta) is a quadratic non-residue with //TODO: some extra nice properties, refer to WB19
 	Isogeny *Isogeny
+
+	PastaHashToField bool // hash to the field as the Zcash pasta_curves crate (BLAKE2b-512, 64-byte elements) instead of with fp.Hash
 }
 
 func toBigIntSlice(z []int) []big.Int {
@@ -145,6 +147,7 @@ func (suite *HashSuiteSswu) GetInfo(baseField *field.FieldConfig, g *Point, name
 		Field:             &f,
 		FieldCoordName:    field.CoordNameForExtensionDegree(g.CoordExtDegree),
 		MappingAlgorithm:  SSWU,
+		PastaHashToField:  suite.PastaHashToField,
 	}
 }
 
@@ -193,10 +196,13 @@ type HashSuiteInfo struct {
 	Field             *field.Extension
 	FieldCoordName    string
 	Name              string
+	FpImport          string // import declaration of the base field package
+	FptowerImport     string // import declaration of the extension tower package
 	FieldSizeMod256   uint8
 	PrecomputedParams []field.Element // PrecomputedParams[0][n] correspond to integer cₙ₋₁ in std doc
 	// PrecomputedParams[n≥1] correspond to field element c_( len(PrecomputedParams[0]) + n - 1 ) in std doc
 	Z                []big.Int // z (or zeta) is a quadratic non-residue with //TODO: some extra nice properties, refer to WB19
 	CofactorClearing bool
 	MappingAlgorithm FieldElementToCurvePoint
+	PastaHashToField bool
 }
@@ -0,0 +1,33 @@
+package config
+
+// MNT4_298 and MNT6_298 form a 2-cycle: the scalar field of one is the base field
+// of the other. Their G1 groups have prime order; the G2 groups live on a quadratic
+// twist defined over 𝔽p² (resp. 𝔽p³).
+var MNT4_298 = Curve{
+	Name:         "mnt4-298",
+	CurvePackage: "mnt4298",
+	EnumID:       "MNT4_298",
+	FrModulus:    "475922286169261325753349249653048451545124878552823515553267735739164647307408490559963137",
+	FpModulus:    "475922286169261325753349249653048451545124879242694725395555128576210262817955800483758081",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              false,
+		CofactorCleaning: false,
+		CRange:           defaultCRange(),
+		A:                []string{"2"},
+	},
+	G2: Point{
+		CoordType:        "fptower.E2",
+		CoordExtDegree:   2,
+		PointName:        "g2",
+		GLV:              false,
+		CofactorCleaning: true,
+		CRange:           defaultCRange(),
+	},
+}
+
+func init() {
+	addCurve(&MNT4_298)
+}
@@ -0,0 +1,32 @@
+package config
+
+// MNT6_298 is the companion of MNT4_298: its base field is the scalar field of
+// MNT4_298 and vice versa. Its G2 group is defined over 𝔽p³.
+var MNT6_298 = Curve{
+	Name:         "mnt6-298",
+	CurvePackage: "mnt6298",
+	EnumID:       "MNT6_298",
+	FrModulus:    "475922286169261325753349249653048451545124879242694725395555128576210262817955800483758081",
+	FpModulus:    "475922286169261325753349249653048451545124878552823515553267735739164647307408490559963137",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              false,
+		CofactorCleaning: false,
+		CRange:           defaultCRange(),
+		A:                []string{"11"},
+	},
+	G2: Point{
+		CoordType:        "fptower.E3",
+		CoordExtDegree:   3,
+		PointName:        "g2",
+		GLV:              false,
+		CofactorCleaning: true,
+		CRange:           defaultCRange(),
+	},
+}
+
+func init() {
+	addCurve(&MNT6_298)
+}
@@ -0,0 +1,58 @@
+package config
+
+// PALLAS and VESTA form the Pasta cycle used by Halo 2: the base field of one is the scalar field of the other.
+// Pallas generates both fields, Vesta imports them.
+var PALLAS = Curve{
+	Name:         "pallas",
+	CurvePackage: "pallas",
+	EnumID:       "PALLAS",
+	FrModulus:    "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+	FpModulus:    "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              true,
+		CofactorCleaning: false,
+		CRange:           defaultCRange(),
+	},
+	// SSWU on the 3-isogenous curve y²=x³+A'x+1265, as in the Zcash pasta_curves crate
+	HashE1: &HashSuiteSswu{
+		A: []string{"0x18354a2eb0ea8c9c49be2d7258370742b74134581a27a59f92bb4b0b657a014b"},
+		B: []string{"1265"},
+		Z: []int{-13},
+
+		PastaHashToField: true,
+		Isogeny: &Isogeny{
+			XMap: RationalPolynomial{
+				Num: [][]string{
+					{"12865787693035132824841220556520878650383580658640693651535411895266652280192"},
+					{"10492611921771203378452795982353351666191589197598957448093274638589204800759"},
+					{"23989696149150192365340222745168215001509815558210986772351135915822265203574"},
+					{"6432893846517566412420610278260439325191790329320346825767705947633326140075"},
+				},
+				Den: [][]string{
+					{"22768321103861051515190775253992702316905399997697804654926324362758820947460"},
+					{"13271109177048389296812780941310096270046944650307955939477485891950613419807"},
+				},
+			},
+			YMap: RationalPolynomial{
+				Num: [][]string{
+					{"1072148974419594402070101713043406554198631721553391137627950991272221023311"},
+					{"28823569610051396102362669851238297121581474897215657071023781420043761726004"},
+					{"11994848074575096182670111372584107500754907779105493386175567957911132601787"},
+					{"11793638718615538422771118843477472096184948937087302513907460903994431256804"},
+				},
+				Den: [][]string{
+					{"28948022309329048855892746252171976963363056481941560715954676764349967629797"},
+					{"10408918692925056833786833257634153023990087029210292532869619559576527581706"},
+					{"5432652610908059517272798285879155923388888734491153551238890455750936314542"},
+				},
+			},
+		},
+	},
+}
+
+func init() {
+	addCurve(&PALLAS)
+}
@@ -0,0 +1,29 @@
+package config
+
+// SECP256R1 is the NIST P-256 curve (y²=x³-3x+b).
+var SECP256R1 = Curve{
+	Name:         "secp256r1",
+	CurvePackage: "secp256r1",
+	EnumID:       "SECP256R1",
+	FrModulus:    "115792089210356248762697446949407573529996955224135760342422259061068512044369",
+	FpModulus:    "115792089210356248762697446949407573530086143415290314195533631308867097853951",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              false,
+		CofactorCleaning: false,
+		CRange:           []int{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+		A:                []string{"-3"},
+	},
+	// P256_XMD:SHA-256_SSWU_RO_, https://datatracker.ietf.org/doc/html/rfc9380#section-8.2
+	HashE1: &HashSuiteSswu{
+		A: []string{"115792089210356248762697446949407573530086143415290314195533631308867097853948"},
+		B: []string{"0x5ac635d8aa3a93e7b3ebbd55769886bc651d06b0cc53b0f63bce3c3e27d2604b"},
+		Z: []int{-10},
+	},
+}
+
+func init() {
+	addCurve(&SECP256R1)
+}
@@ -0,0 +1,60 @@
+package config
+
+// VESTA is the companion of PALLAS in the Pasta cycle.
+// The fields are not generated again, the curve package imports pallas'.
+var VESTA = Curve{
+	Name:         "vesta",
+	CurvePackage: "vesta",
+	EnumID:       "VESTA",
+	FrModulus:    "28948022309329048855892746252171976963363056481941560715954676764349967630337",
+	FpModulus:    "28948022309329048855892746252171976963363056481941647379679742748393362948097",
+	FpImportPath: "github.com/consensys/gnark-crypto/ecc/pallas/fr",
+	FrImportPath: "github.com/consensys/gnark-crypto/ecc/pallas/fp",
+	G1: Point{
+		CoordType:        "fp.Element",
+		CoordExtDegree:   1,
+		PointName:        "g1",
+		GLV:              true,
+		CofactorCleaning: false,
+		CRange:           defaultCRange(),
+	},
+	// SSWU on the 3-isogenous curve y²=x³+A'x+1265, as in the Zcash pasta_curves crate
+	HashE1: &HashSuiteSswu{
+		A: []string{"0x267f9b2ee592271a81639c4d96f787739673928c7d01b212c515ad7242eaa6b1"},
+		B: []string{"1265"},
+		Z: []int{-13},
+
+		PastaHashToField: true,
+		Isogeny: &Isogeny{
+			XMap: RationalPolynomial{
+				Num: [][]string{
+					{"22515128462811482443472135973911537638171266152621281295306466582083726737451"},
+					{"11064082577423419940183149293632076317553812518550871517841037420579891210813"},
+					{"13377367003779316331268047403600734872799183885837485433911493934102207511749"},
+					{"25731575386070265649682441113041757300767161317281464337493104665238544842753"},
+				},
+				Den: [][]string{
+					{"9250006497141849826017568406346290940322373181457057184910582871723433210981"},
+					{"4604213796697651557841441623718706001740429044770779386484474413346415813353"},
+				},
+			},
+			YMap: RationalPolynomial{
+				Num: [][]string{
+					{"13937936667454727226911322269564285204582212380194126516142098360337545123123"},
+					{"11620280474556824258112134491145636201000922752744881519070727793732904824884"},
+					{"21162694656554182593580396827886355918081120183889566406795618341247785229923"},
+					{"8577191795356755216560813704347252433589053772427154779164368221746181614251"},
+				},
+				Den: [][]string{
+					{"28948022309329048855892746252171976963363056481941647379679742748393362947557"},
+					{"27750019491425549478052705219038872820967119544371171554731748615170299632943"},
+					{"21380331849711001764708535561664047484292171808126992769566582994216305194078"},
+				},
+			},
+		},
+	},
+}
+
+func init() {
+	addCurve(&VESTA)
+}
@@ -2,6 +2,7 @@ package ecc
 
 import (
 	"fmt"
+	"math/big"
 	"path/filepath"
 	"reflect"
 	"sort"
@@ -12,9 +13,23 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+// GenerateCT generates the constant-time scalar multiplication on G1
+func GenerateCT(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	packageName := strings.ReplaceAll(conf.Name, "-", "")
+	if conf.Custom != nil {
+		packageName = conf.CurvePackage
+	}
+	entry := bavard.Entry{File: filepath.Join(baseDir, "g1_ct.go"), Templates: []string{"point_ct.go.tmpl"}}
+	return bgen.Generate(pconf{conf, conf.G1}, packageName, filepath.Join(conf.TemplatesDir, "ecc", "template"), entry)
+}
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
+	if conf.Custom != nil {
+		packageName = conf.CurvePackage
+	}
+	tmplDir := filepath.Join(conf.TemplatesDir, "ecc", "template")
 
 	var entries []bavard.Entry
 
@@ -29,12 +44,18 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			{File: filepath.Join(baseDir, fmt.Sprintf("hash_to_%s_test.go", point.PointName)), Templates: []string{"tests/hash_to_curve.go.tmpl"}}}
 
 		hashConf := suite.GetInfo(conf.Fp, point, conf.Name)
+		hashConf.FpImport = conf.FpImport()
+		hashConf.FptowerImport = conf.FptowerImport()
 
 		funcs := make(template.FuncMap)
 		funcs["asElement"] = hashConf.Field.Base.WriteElement
+		funcs["abs"] = func(z big.Int) big.Int {
+			var res big.Int
+			return *res.Abs(&z)
+		}
 		bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
 
-		return bgen.GenerateWithOptions(hashConf, packageName, "./ecc/template", bavardOpts, entries...)
+		return bgen.GenerateWithOptions(hashConf, packageName, tmplDir, bavardOpts, entries...)
 	}
 
 	if err := genHashToCurve(&conf.G1, conf.HashE1); err != nil {
@@ -50,7 +71,10 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "g1_test.go"), Templates: []string{"tests/point.go.tmpl"}},
 	}
 	g1 := pconf{conf, conf.G1}
-	if err := bgen.Generate(g1, packageName, "./ecc/template", entries...); err != nil {
+	if err := bgen.Generate(g1, packageName, tmplDir, entries...); err != nil {
+		return err
+	}
+	if err := GenerateCT(conf, baseDir, bgen); err != nil {
 		return err
 	}
 
@@ -160,33 +184,65 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	}
 
 	bavardOpts := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", bavardOpts, entries...); err != nil {
+	if err := bgen.GenerateWithOptions(conf, packageName, tmplDir, bavardOpts, entries...); err != nil {
 		return err
 	}
 
-	// No G2 for secp256k1
-	if conf.Equal(config.SECP256K1) {
-		return nil
+	// the serialization stores flags in the 2 most significant bits of the encoding of x; for the
+	// curves without spare bits (secp256k1, secp256r1) or with a single one (the pasta curves,
+	// serialized as in Zcash), it is not generated
+	if spareBits := 64 - conf.Fp.NbBits%64; spareBits >= 2 && spareBits != 64 {
+		// marshal
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
+			{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+		}
+
+		marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
+		if err := bgen.GenerateWithOptions(conf, packageName, tmplDir, marshal, entries...); err != nil {
+			return err
+		}
 	}
 
-	// marshal
-	entries = []bavard.Entry{
-		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
-		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"tests/marshal.go.tmpl"}},
+	// No G2 for grumpkin, nor for the curves loaded from a configuration file without pairing
+	if conf.G2.PointName != "" {
+		// G2
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
+			{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+		}
+		g2 := pconf{conf, conf.G2}
+		if err := bgen.Generate(g2, packageName, tmplDir, entries...); err != nil {
+			return err
+		}
 	}
 
-	marshal := []func(*bavard.Bavard) error{bavard.Funcs(funcs)}
-	if err := bgen.GenerateWithOptions(conf, packageName, "./ecc/template", marshal, entries...); err != nil {
-		return err
+	// the parameters of the curves loaded from a configuration file, written by hand
+	// for the built-in curves, are generated
+	if conf.Custom != nil {
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+			{File: filepath.Join(baseDir, packageName+".go"), Templates: []string{"curve.go.tmpl"}},
+		}
+		if err := bgen.Generate(conf, packageName, filepath.Join(tmplDir, "custom"), entries...); err != nil {
+			return err
+		}
+		if !conf.IsExternal() {
+			return nil
+		}
+		entry := bavard.Entry{File: filepath.Join(baseDir, "internal", "parallel", "execute.go"), Templates: []string{"parallel.go.tmpl"}}
+		return bgen.Generate(conf, "parallel", filepath.Join(tmplDir, "custom"), entry)
+	}
+
+	if conf.G2.PointName == "" {
+		return nil
 	}
 
-	// G2
+	// generic curve handle (pairing-friendly curves only)
 	entries = []bavard.Entry{
-		{File: filepath.Join(baseDir, "g2.go"), Templates: []string{"point.go.tmpl"}},
-		{File: filepath.Join(baseDir, "g2_test.go"), Templates: []string{"tests/point.go.tmpl"}},
+		{File: filepath.Join(baseDir, "curve.go"), Templates: []string{"curve.go.tmpl"}},
 	}
-	g2 := pconf{conf, conf.G2}
-	return bgen.Generate(g2, packageName, "./ecc/template", entries...)
+	return bgen.Generate(conf, packageName, tmplDir, entries...)
 }
 
 type pconf struct {
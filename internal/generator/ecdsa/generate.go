@@ -19,6 +19,6 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal_test.go"), Templates: []string{"marshal.test.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./ecdsa/template", entries...)
+	return bgen.Generate(conf, conf.Package, filepath.Join(conf.TemplatesDir, "ecdsa", "template"), entries...)
 
 }
@@ -0,0 +1,50 @@
+package ecfft
+
+import "github.com/consensys/gnark-crypto/internal/generator/config"
+
+// Configs lists the fields without large 2-adic multiplicative subgroups on which the ECFFT is generated.
+// The base field of the Stark curve has a 2-adicity of 192 and uses the radix-2 FFT instead.
+var Configs = []Config{
+	{
+		FieldDependency: config.FieldDependency{
+			FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/secp256k1/fp",
+			FieldPackageName: "fp",
+			ElementType:      "fp.Element",
+		},
+		A:       "23914452626269617154846528609881440340238808243928032610110248743691442644670",
+		B:       "98018524674975341086781139432679586577523772095148057148117067871700070388771",
+		GX:      "23277673020301472448956363732103651495849913851235528303771960006618337115152",
+		GY:      "33292578629124968850531341981054078193595581145778134894398477283690140516403",
+		RX:      "5",
+		RY:      "24477426811118600082480480558633293635987410581703112223358016463410112637094",
+		LogSize: 16,
+	},
+	{
+		FieldDependency: config.FieldDependency{
+			FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/secp256k1/fr",
+			FieldPackageName: "fr",
+			ElementType:      "fr.Element",
+		},
+		A:       "82712327290172951690152317645194166644194553237239144941864538784197951338865",
+		B:       "92395300604583846389194448121487969714365226168370820037859183380518430229156",
+		GX:      "60301042430280373043225630653651748421565452224582676097949360991371252250545",
+		GY:      "12031080446910323583583057596888481986608759559756940294939969757527084654035",
+		RX:      "1",
+		RY:      "37990614653745996608145334162753194550073539995682611690261142095591706615668",
+		LogSize: 19,
+	},
+	{
+		FieldDependency: config.FieldDependency{
+			FieldPackagePath: "github.com/consensys/gnark-crypto/ecc/stark-curve/fr",
+			FieldPackageName: "fr",
+			ElementType:      "fr.Element",
+		},
+		A:       "1167427733026033804377247442535041079514820389088514316330140661050472611429",
+		B:       "2091620430510152083716188133382754178241059578580617229470772400417942482660",
+		GX:      "1423071592723019033537384226028939408224028146593551868987746653631235725369",
+		GY:      "134661915133594913091341193318534410616069615928422224538320580537965142990",
+		RX:      "6",
+		RY:      "329744519537405420502823861322808835677397827681257051101288175560910861182",
+		LogSize: 17,
+	},
+}
@@ -0,0 +1,29 @@
+package ecfft
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// Config of the ECFFT on a field: A, B, GX, GY, RX and RY are the decimal representations of the curve
+// y² = x³ + A⋅x + B, of a generator G of its 2-Sylow subgroup, which must be cyclic of order 2^LogSize,
+// and of a point R such that 2R ∉ ⟨G⟩.
+type Config struct {
+	config.FieldDependency
+	Package              string
+	A, B, GX, GY, RX, RY string
+	LogSize              int
+}
+
+func Generate(conf Config, baseDir string, bgen *bavard.BatchGenerator) error {
+	conf.Package = "ecfft"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "chain.go"), Templates: []string{"chain.go.tmpl"}},
+		{File: filepath.Join(baseDir, "domain.go"), Templates: []string{"domain.go.tmpl"}},
+		{File: filepath.Join(baseDir, "domain_test.go"), Templates: []string{"domain.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./ecfft/template/", entries...)
+}
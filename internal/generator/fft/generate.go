@@ -1,12 +1,26 @@
 package fft
 
 import (
+	"math/big"
 	"path/filepath"
 
 	"github.com/consensys/bavard"
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
+type fftConf struct {
+	config.Curve
+	MixedRadix mixedRadixConf
+}
+
+// mixedRadixConf holds the parameters of the mixed-radix domains: 2^MaxOrderRoot2, 3^MaxOrderRoot3
+// and 5^MaxOrderRoot5 are the largest powers of 2, 3 and 5 dividing r-1, and RootOfUnity is a
+// primitive 3^MaxOrderRoot3⋅5^MaxOrderRoot5-th root of unity.
+type mixedRadixConf struct {
+	MaxOrderRoot2, MaxOrderRoot3, MaxOrderRoot5 int
+	RootOfUnity                                 string
+}
+
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	conf.Package = "fft"
@@ -16,7 +30,59 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "domain.go"), Templates: []string{"domain.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fft_test.go"), Templates: []string{"tests/fft.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fft.go"), Templates: []string{"fft.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "batch.go"), Templates: []string{"batch.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fourstep.go"), Templates: []string{"fourstep.go.tmpl", "imports.go.tmpl"}},
+		{File: filepath.Join(baseDir, "mixed_radix.go"), Templates: []string{"mixed_radix.go.tmpl", "imports.go.tmpl"}},
 		{File: filepath.Join(baseDir, "options.go"), Templates: []string{"options.go.tmpl", "imports.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./fft/template/", entries...)
+	// the FFTs on the groups of the curve; the small fields have no curve
+	if conf.CurvePackage != "" {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "fft_ec.go"), Templates: []string{"fft_ec.go.tmpl", "imports.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "fft_ec_test.go"), Templates: []string{"tests/fft_ec.go.tmpl", "imports.go.tmpl"}},
+		)
+	}
+	data := fftConf{Curve: conf, MixedRadix: newMixedRadixConf(conf.FrModulus)}
+	return bgen.Generate(data, conf.Package, filepath.Join(conf.TemplatesDir, "fft", "template"), entries...)
+}
+
+// newMixedRadixConf computes the parameters of the mixed-radix domains on 𝔽r
+func newMixedRadixConf(modulus string) mixedRadixConf {
+	var r, rMinusOne big.Int
+	if _, ok := r.SetString(modulus, 0); !ok {
+		panic("invalid modulus " + modulus)
+	}
+	rMinusOne.Sub(&r, big.NewInt(1))
+
+	conf := mixedRadixConf{MaxOrderRoot2: int(rMinusOne.TrailingZeroBits())}
+
+	// root = ∏ x_q^((r-1)/q^k) where x_q is the smallest q-th non-residue
+	root := big.NewInt(1)
+	for _, q := range []int64{3, 5} {
+		var k int
+		var e, x, m big.Int
+		bq := big.NewInt(q)
+		e.Set(&rMinusOne)
+		for m.Mod(&e, bq).Sign() == 0 {
+			e.Div(&e, bq)
+			k++
+		}
+		if k == 0 {
+			continue
+		}
+		var c big.Int
+		c.Div(&rMinusOne, bq)
+		for x.SetInt64(2); m.Exp(&x, &c, &r).Cmp(big.NewInt(1)) == 0; x.Add(&x, big.NewInt(1)) {
+		}
+		m.Exp(&x, &e, &r)
+		root.Mul(root, &m).Mod(root, &r)
+		if q == 3 {
+			conf.MaxOrderRoot3 = k
+		} else {
+			conf.MaxOrderRoot5 = k
+		}
+	}
+	conf.RootOfUnity = root.String()
+
+	return conf
 }
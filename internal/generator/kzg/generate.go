@@ -17,6 +17,6 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 	}
-	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
+	return bgen.Generate(conf, conf.Package, filepath.Join(conf.TemplatesDir, "kzg", "template"), entries...)
 
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"github.com/consensys/bavard"
@@ -14,6 +15,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/crypto/hash/mimc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecc"
 	"github.com/consensys/gnark-crypto/internal/generator/ecdsa"
+	"github.com/consensys/gnark-crypto/internal/generator/ecfft"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards"
 	"github.com/consensys/gnark-crypto/internal/generator/edwards/eddsa"
 	"github.com/consensys/gnark-crypto/internal/generator/fft"
@@ -26,6 +28,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/permutation"
 	"github.com/consensys/gnark-crypto/internal/generator/plookup"
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
+	"github.com/consensys/gnark-crypto/internal/generator/reedsolomon"
 	"github.com/consensys/gnark-crypto/internal/generator/sumcheck"
 	"github.com/consensys/gnark-crypto/internal/generator/test_vector_utils"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
@@ -35,6 +38,9 @@ const (
 	copyrightHolder = "ConsenSys Software Inc."
 	copyrightYear   = 2020
 	baseDir         = "../../"
+
+	// the fields generated here import gnark-crypto's parallel package instead of embedding a copy
+	parallelImportPath = "github.com/consensys/gnark-crypto/internal/parallel"
 )
 
 var bgen = bavard.NewBatchGenerator(copyrightHolder, copyrightYear, "consensys/gnark-crypto")
@@ -57,23 +63,37 @@ func main() {
 
 			conf.Fr, err = field.NewFieldConfig("fr", "Element", conf.FrModulus, !conf.Equal(config.STARK_CURVE))
 			assertNoError(err)
+			conf.Fp.ParallelImportPath = parallelImportPath
+			conf.Fr.ParallelImportPath = parallelImportPath
 
 			conf.FpUnusedBits = 64 - (conf.Fp.NbBits % 64)
 
-			assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")))
-			assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")))
+			// curves forming a cycle reuse the fields of their companion
+			if !conf.SharesFields() {
+				assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr")))
+				assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp")))
+			}
 
 			// generate ecdsa
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
 
 			if conf.Equal(config.STARK_CURVE) {
+				// the G1 files are not generated, but ecdsa uses the constant-time scalar multiplication
+				assertNoError(ecc.GenerateCT(conf, curveDir, bgen))
 				return // TODO @yelhousni
 			}
 
 			// generate G1, G2, multiExp, ...
 			assertNoError(ecc.Generate(conf, curveDir, bgen))
 
-			if conf.Equal(config.SECP256K1) {
+			if conf.Equal(config.SECP256K1) || conf.Equal(config.SECP256R1) || conf.Equal(config.GRUMPKIN) || conf.Equal(config.PALLAS) || conf.Equal(config.VESTA) {
+				return
+			}
+
+			if conf.Equal(config.MNT4_298) || conf.Equal(config.MNT6_298) {
+				// the extension tower and the pairing are hand-written
+				assertNoError(fft.Generate(conf, filepath.Join(curveDir, "fr", "fft"), bgen))
+				assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "fr", "kzg"), bgen))
 				return
 			}
 
@@ -111,7 +131,10 @@ func main() {
 			}
 
 			// generate polynomial on fr
-			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, bgen))
+			assertNoError(polynomial.Generate(frInfo, filepath.Join(curveDir, "fr", "polynomial"), true, true, bgen))
+
+			// generate reed-solomon codes on fr
+			assertNoError(reedsolomon.Generate(conf, filepath.Join(curveDir, "fr", "reedsolomon"), bgen))
 
 			// generate sumcheck on fr
 			assertNoError(sumcheck.Generate(frInfo, filepath.Join(curveDir, "fr", "sumcheck"), bgen))
@@ -162,9 +185,26 @@ func main() {
 			defer wg.Done()
 
 			curveDir := filepath.Join(baseDir, "ecc", conf.Name, conf.Package)
+
+			if !conf.IsCompanion() {
+				// generate the base and scalar fields of standalone curves
+				fp, err := field.NewFieldConfig("fp", "Element", conf.FpModulus, true)
+				assertNoError(err)
+				fr, err := field.NewFieldConfig("fr", "Element", conf.FrModulus, true)
+				assertNoError(err)
+				fp.ParallelImportPath = parallelImportPath
+				fr.ParallelImportPath = parallelImportPath
+				assertNoError(generator.GenerateFF(fp, filepath.Join(baseDir, "ecc", conf.Name, "fp")))
+				assertNoError(generator.GenerateFF(fr, filepath.Join(baseDir, "ecc", conf.Name, "fr")))
+			}
+
 			// generate twisted edwards companion curves
 			assertNoError(edwards.Generate(conf, curveDir, bgen))
 
+			if !conf.IsCompanion() {
+				return // eddsa follows the curve's own specification (e.g. RFC 8032 for ed25519)
+			}
+
 			// generate eddsa on companion curves
 			assertNoError(eddsa.Generate(conf, curveDir, bgen))
 		}(conf)
@@ -176,6 +216,29 @@ func main() {
 		defer wg.Done()
 		assertNoError(test_vector_utils.GenerateRationals(bgen))
 	}()
+
+	// fft on the small fields with a large 2-adicity; the fields themselves are generated in field/<name>/internal
+	for name, modulus := range map[string]string{
+		"goldilocks": "0xffffffff00000001",
+		"babybear":   "0x78000001",
+		"koalabear":  "0x7f000001",
+	} {
+		wg.Add(1)
+		go func(name, modulus string) {
+			defer wg.Done()
+			assertNoError(fft.Generate(config.Curve{Name: name, FrModulus: modulus}, filepath.Join(baseDir, "field", name, "fft"), bgen))
+		}(name, modulus)
+	}
+
+	// ecfft on the fields without large 2-adic multiplicative subgroups
+	for _, conf := range ecfft.Configs {
+		wg.Add(1)
+		go func(conf ecfft.Config) {
+			defer wg.Done()
+			dir := strings.TrimPrefix(conf.FieldPackagePath, "github.com/consensys/gnark-crypto/")
+			assertNoError(ecfft.Generate(conf, filepath.Join(baseDir, dir, "ecfft"), bgen))
+		}(conf)
+	}
 	wg.Wait()
 
 	// format the whole directory
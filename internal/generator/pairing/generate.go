@@ -11,8 +11,24 @@ import (
 func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
 
 	packageName := strings.ReplaceAll(conf.Name, "-", "")
-	return bgen.Generate(conf, packageName, "./pairing/template", bavard.Entry{
+	if conf.Custom != nil {
+		packageName = conf.CurvePackage
+	}
+	tmplDir := filepath.Join(conf.TemplatesDir, "pairing", "template")
+
+	if err := bgen.Generate(conf, packageName, tmplDir, bavard.Entry{
 		File: filepath.Join(baseDir, "pairing_test.go"), Templates: []string{"tests/pairing.go.tmpl"},
+	}); err != nil {
+		return err
+	}
+
+	// the pairing of the curves loaded from a configuration file, written by hand
+	// for the built-in curves, is generated
+	if conf.Custom == nil {
+		return nil
+	}
+	return bgen.Generate(conf, packageName, filepath.Join(tmplDir, "custom"), bavard.Entry{
+		File: filepath.Join(baseDir, "pairing.go"), Templates: []string{"pairing.go.tmpl"},
 	})
 
 }
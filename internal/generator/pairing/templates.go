@@ -0,0 +1,9 @@
+package pairing
+
+import "embed"
+
+// Templates holds the templates of the generator, under template/, for the
+// generators run outside of internal/generator (see config.Curve.TemplatesDir).
+//
+//go:embed template
+var Templates embed.FS
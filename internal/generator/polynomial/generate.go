@@ -7,7 +7,9 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/config"
 )
 
-func Generate(conf config.FieldDependency, baseDir string, generateTests bool, bgen *bavard.BatchGenerator) error {
+// Generate generates the polynomial package; withFFT adds the arithmetic using the fft package
+// of the field (multiplication, division, multipoint evaluation and interpolation, GCD, roots...).
+func Generate(conf config.FieldDependency, baseDir string, generateTests, withFFT bool, bgen *bavard.BatchGenerator) error {
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
@@ -23,5 +25,22 @@ func Generate(conf config.FieldDependency, baseDir string, generateTests bool, b
 		)
 	}
 
+	if withFFT {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "arithmetic.go"), Templates: []string{"arithmetic.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "multipoint.go"), Templates: []string{"multipoint.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "gcd.go"), Templates: []string{"gcd.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "roots.go"), Templates: []string{"roots.go.tmpl"}},
+		)
+		if generateTests {
+			entries = append(entries,
+				bavard.Entry{File: filepath.Join(baseDir, "arithmetic_test.go"), Templates: []string{"arithmetic.test.go.tmpl"}},
+				bavard.Entry{File: filepath.Join(baseDir, "multipoint_test.go"), Templates: []string{"multipoint.test.go.tmpl"}},
+				bavard.Entry{File: filepath.Join(baseDir, "gcd_test.go"), Templates: []string{"gcd.test.go.tmpl"}},
+				bavard.Entry{File: filepath.Join(baseDir, "roots_test.go"), Templates: []string{"roots.test.go.tmpl"}},
+			)
+		}
+	}
+
 	return bgen.Generate(conf, "polynomial", "./polynomial/template/", entries...)
 }
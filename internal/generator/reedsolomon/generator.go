@@ -0,0 +1,21 @@
+package reedsolomon
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+
+	// Reed-Solomon codes
+	conf.Package = "reedsolomon"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(baseDir, "reedsolomon.go"), Templates: []string{"reedsolomon.go.tmpl"}},
+		{File: filepath.Join(baseDir, "reedsolomon_test.go"), Templates: []string{"reedsolomon.test.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./reedsolomon/template/", entries...)
+
+}
@@ -35,8 +35,8 @@ func (p *Polynomial) Degree() uint64 {
 // returns a small_rational.SmallRational
 func (p *Polynomial) Eval(v *small_rational.SmallRational) small_rational.SmallRational {
 
-	res := (*p)[len(*p)-1]
-	for i := len(*p) - 2; i >= 0; i-- {
+	var res small_rational.SmallRational
+	for i := len(*p) - 1; i >= 0; i-- {
 		res.Mul(&res, v)
 		res.Add(&res, &(*p)[i])
 	}
@@ -104,14 +104,14 @@ func (p *Polynomial) Add(p1, p2 Polynomial) *Polynomial {
 		bigger, smaller = smaller, bigger
 	}
 
-	if len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
+	if len(*p) != 0 && len(*p) == len(bigger) && (&(*p)[0] == &bigger[0]) {
 		for i := 0; i < len(smaller); i++ {
 			(*p)[i].Add(&(*p)[i], &smaller[i])
 		}
 		return p
 	}
 
-	if len(*p) == len(smaller) && (&(*p)[0] == &smaller[0]) {
+	if len(*p) != 0 && len(*p) == len(smaller) && (&(*p)[0] == &smaller[0]) {
 		for i := 0; i < len(smaller); i++ {
 			(*p)[i].Add(&(*p)[i], &bigger[i])
 		}
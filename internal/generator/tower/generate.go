@@ -16,14 +16,22 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		return nil
 	}
 
+	tmplDir := filepath.Join(conf.TemplatesDir, "tower", "template", "fq12over6over2")
+
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "e2_amd64.go"), Templates: []string{"amd64.fq2.go.tmpl"}},
 		{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}, BuildTag: "!amd64"},
 		{File: filepath.Join(baseDir, "asm.go"), Templates: []string{"asm.go.tmpl"}, BuildTag: "!noadx"},
 		{File: filepath.Join(baseDir, "asm_noadx.go"), Templates: []string{"asm_noadx.go.tmpl"}, BuildTag: "noadx"},
 	}
+	if conf.Custom != nil {
+		// no assembly for the curves loaded from a configuration file
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "e2_fallback.go"), Templates: []string{"fallback.fq2.go.tmpl"}},
+		}
+	}
 
-	if err := bgen.Generate(conf, "fptower", "./tower/template/fq12over6over2", entries...); err != nil {
+	if err := bgen.Generate(conf, "fptower", tmplDir, entries...); err != nil {
 		return err
 	}
 
@@ -64,11 +72,24 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 			},
 		}
 
-		if err := bgen.Generate(towerConf, "fptower", "./tower/template/fq12over6over2", entries...); err != nil {
+		if err := bgen.Generate(towerConf, "fptower", tmplDir, entries...); err != nil {
 			return err
 		}
 	}
 
+	// the arithmetic of 𝔽p², the Frobenius maps and the sparse multiplications of the
+	// pairing, written by hand for the built-in curves, are generated
+	if conf.Custom != nil {
+		entries = []bavard.Entry{
+			{File: filepath.Join(baseDir, "e2_"+conf.CurvePackage+".go"), Templates: []string{"e2.go.tmpl"}},
+			{File: filepath.Join(baseDir, "e12_pairing.go"), Templates: []string{"e12_pairing.go.tmpl"}},
+			{File: filepath.Join(baseDir, "frobenius.go"), Templates: []string{"frobenius.go.tmpl"}},
+			{File: filepath.Join(baseDir, "parameters.go"), Templates: []string{"parameters.go.tmpl"}},
+			{File: filepath.Join(baseDir, "generators_test.go"), Templates: []string{"generators_test.go.tmpl"}},
+		}
+		return bgen.Generate(conf, "fptower", filepath.Join(tmplDir, "custom"), entries...)
+	}
+
 	{
 		// fq2 assembly
 		fName := filepath.Join(baseDir, "e2_amd64.s")
@@ -29,8 +29,12 @@ import (
 	ecdsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/ecdsa"
 	ecdsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/ecdsa"
 	ecdsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/ecdsa"
+	ecdsa_grumpkin "github.com/consensys/gnark-crypto/ecc/grumpkin/ecdsa"
+	ecdsa_pallas "github.com/consensys/gnark-crypto/ecc/pallas/ecdsa"
 	ecdsa_secp256k1 "github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
+	ecdsa_secp256r1 "github.com/consensys/gnark-crypto/ecc/secp256r1/ecdsa"
 	ecdsa_starkcurve "github.com/consensys/gnark-crypto/ecc/stark-curve/ecdsa"
+	ecdsa_vesta "github.com/consensys/gnark-crypto/ecc/vesta/ecdsa"
 	"github.com/consensys/gnark-crypto/signature"
 )
 
@@ -59,6 +63,14 @@ func New(ss ecc.ID, r io.Reader) (signature.Signer, error) {
 		return ecdsa_secp256k1.GenerateKey(r)
 	case ecc.STARK_CURVE:
 		return ecdsa_starkcurve.GenerateKey(r)
+	case ecc.GRUMPKIN:
+		return ecdsa_grumpkin.GenerateKey(r)
+	case ecc.PALLAS:
+		return ecdsa_pallas.GenerateKey(r)
+	case ecc.VESTA:
+		return ecdsa_vesta.GenerateKey(r)
+	case ecc.SECP256R1:
+		return ecdsa_secp256r1.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
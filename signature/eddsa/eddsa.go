@@ -29,6 +29,7 @@ import (
 	eddsa_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/twistededwards/eddsa"
 	eddsa_bw6756 "github.com/consensys/gnark-crypto/ecc/bw6-756/twistededwards/eddsa"
 	eddsa_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/twistededwards/eddsa"
+	eddsa_ed25519 "github.com/consensys/gnark-crypto/ecc/ed25519/twistededwards/eddsa"
 	"github.com/consensys/gnark-crypto/ecc/twistededwards"
 	"github.com/consensys/gnark-crypto/signature"
 )
@@ -56,6 +57,8 @@ func New(ss twistededwards.ID, r io.Reader) (signature.Signer, error) {
 		return eddsa_bls24317.GenerateKey(r)
 	case twistededwards.BW6_633:
 		return eddsa_bw6633.GenerateKey(r)
+	case twistededwards.ED25519:
+		return eddsa_ed25519.GenerateKey(r)
 	default:
 		panic("not implemented")
 	}
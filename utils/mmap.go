@@ -0,0 +1,44 @@
+//go:build linux || darwin
+
+package utils
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// MmapSlice maps the file at path in memory, creating or extending it if needed, and returns
+// it as a slice of n elements of type T, along with a function to unmap it.
+// The writes to the slice go to the file, which allows working on vectors larger than the memory,
+// such as the inputs of a four-step FFT. T must not contain pointers (e.g. fr.Element).
+func MmapSlice[T any](path string, n int) ([]T, func() error, error) {
+	var t T
+	size := n * int(unsafe.Sizeof(t))
+	if size == 0 {
+		return nil, func() error { return nil }, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the mapping remains valid once the file is closed
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.Size() < int64(size) {
+		if err = f.Truncate(int64(size)); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	data, err := syscall.Mmap(int(f.Fd()), 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	return unsafe.Slice((*T)(unsafe.Pointer(&data[0])), n), func() error { return syscall.Munmap(data) }, nil
+}
@@ -0,0 +1,10 @@
+//go:build !linux && !darwin
+
+package utils
+
+import "errors"
+
+// MmapSlice is not supported on this platform, see the linux and darwin implementation
+func MmapSlice[T any](path string, n int) ([]T, func() error, error) {
+	return nil, nil, errors.New("memory-mapped slices are not supported on this platform")
+}